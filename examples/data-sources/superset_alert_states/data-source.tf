@@ -0,0 +1,10 @@
+data "superset_alert_states" "managed" {
+  ids = [superset_alert.example.id]
+}
+
+check "alerts_healthy" {
+  assert {
+    condition     = length(data.superset_alert_states.managed.error_ids) == 0
+    error_message = "Alerts in error state: ${join(", ", [for a in data.superset_alert_states.managed.alerts : a.name if a.last_state == "Error"])}"
+  }
+}
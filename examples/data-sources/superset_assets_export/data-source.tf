@@ -0,0 +1,13 @@
+provider "superset" {
+  alias           = "staging"
+  server_base_url = "https://superset.staging.example.com"
+}
+
+data "superset_assets_export" "staging" {
+  provider    = superset.staging
+  output_path = "${path.module}/staging-assets.zip"
+}
+
+output "exported_assets" {
+  value = data.superset_assets_export.staging.assets
+}
@@ -0,0 +1,21 @@
+data "superset_chart_viz_types" "this" {}
+
+# Validate the visualization type of a chart at plan time.
+resource "superset_chart" "example" {
+  slice_name    = "Orders map"
+  viz_type      = "my_custom_plugin"
+  dataset_name  = "orders"
+  database_name = "examples"
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.superset_chart_viz_types.this.viz_types, "my_custom_plugin")
+      error_message = "The my_custom_plugin visualization is not available on the server."
+    }
+  }
+}
+
+# Fail with a clear error when the server is missing required visualization types.
+data "superset_chart_viz_types" "required" {
+  required = ["echarts_timeseries_line", "my_custom_plugin"]
+}
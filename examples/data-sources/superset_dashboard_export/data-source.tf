@@ -0,0 +1,17 @@
+# Write the bundle to a file, e.g. to import it with superset_import_bundle.
+data "superset_dashboard_export" "sales_bundle" {
+  dashboard_ids = [1, 2]
+  output_path   = "${path.module}/sales-dashboards.zip"
+}
+
+data "superset_dashboard_export" "sales" {
+  dashboard_ids = [1, 2]
+}
+
+# Archive the YAML files of the dashboards next to the configuration.
+resource "local_file" "sales" {
+  for_each = data.superset_dashboard_export.sales.files
+
+  filename = "${path.module}/exports/sales/${each.key}"
+  content  = each.value
+}
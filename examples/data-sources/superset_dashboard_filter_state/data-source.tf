@@ -0,0 +1,16 @@
+data "superset_dashboard_filter_state" "template" {
+  dashboard_id = 12
+}
+
+# The names and columns of the filters of the template dashboard.
+output "template_filters" {
+  value = {
+    for f in data.superset_dashboard_filter_state.template.filters :
+    f.name => [for t in f.targets : t.column_name] if f.type == "NATIVE_FILTER"
+  }
+}
+
+# Copy the filters as a whole into the json_metadata of another dashboard.
+locals {
+  native_filter_configuration = jsondecode(data.superset_dashboard_filter_state.template.native_filter_configuration)
+}
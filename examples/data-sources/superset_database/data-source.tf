@@ -0,0 +1,19 @@
+data "superset_database" "warehouse" {
+  database_name = "warehouse"
+}
+
+resource "superset_alert" "stale_orders" {
+  name           = "Stale orders"
+  crontab        = "0 * * * *"
+  database_id    = data.superset_database.warehouse.id
+  sql            = "SELECT count(*) FROM orders WHERE status = 'pending' AND created_at < now() - interval '1 day'"
+  validator_type = "not null"
+  chart_id       = 34
+
+  recipients = [
+    {
+      type   = "Email"
+      target = "oncall@example.com"
+    },
+  ]
+}
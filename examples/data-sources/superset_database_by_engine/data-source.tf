@@ -0,0 +1,10 @@
+# The warehouse connection of the environment, whatever it is named there.
+data "superset_database_by_engine" "warehouse" {
+  backend = "trino"
+}
+
+resource "superset_dataset" "orders" {
+  table_name    = "orders"
+  schema        = "sales"
+  database_name = data.superset_database_by_engine.warehouse.database_name
+}
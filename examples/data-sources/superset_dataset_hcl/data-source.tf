@@ -0,0 +1,10 @@
+data "superset_dataset_hcl" "orders" {
+  table_name    = "orders"
+  schema        = "sales"
+  database_name = "warehouse"
+}
+
+# Review the generated configuration with `terraform output -raw orders_hcl`.
+output "orders_hcl" {
+  value = data.superset_dataset_hcl.orders.hcl
+}
@@ -0,0 +1,12 @@
+data "superset_feature_flags" "this" {}
+
+# Create the tag only when the tagging system is enabled on the server.
+resource "superset_tag" "example" {
+  count = lookup(data.superset_feature_flags.this.flags, "TAGGING_SYSTEM", false) ? 1 : 0
+  name  = "example"
+}
+
+# Fail with a clear error when the server is missing required flags.
+data "superset_feature_flags" "required" {
+  required = ["DASHBOARD_RBAC", "EMBEDDED_SUPERSET"]
+}
@@ -0,0 +1,10 @@
+data "superset_login_providers" "this" {}
+
+output "auth_type" {
+  value = data.superset_login_providers.this.auth_type
+}
+
+# Fail with a clear error when the API of the server does not accept LDAP logins.
+data "superset_login_providers" "required" {
+  required = ["ldap"]
+}
@@ -0,0 +1,13 @@
+data "superset_logs" "dashboard_views" {
+  username    = "alice"
+  action      = "DashboardRestApi.get"
+  since       = "2026-01-01T00:00:00Z"
+  max_results = 500
+}
+
+output "dashboard_views" {
+  value = [for l in data.superset_logs.dashboard_views.logs : {
+    dashboard_id = l.dashboard_id
+    at           = l.dttm
+  }]
+}
@@ -0,0 +1,5 @@
+data "superset_menu" "current" {}
+
+output "can_use_sql_lab" {
+  value = contains(data.superset_menu.current.names, "SQL Lab")
+}
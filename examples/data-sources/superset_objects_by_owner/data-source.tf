@@ -0,0 +1,15 @@
+data "superset_objects_by_owner" "alice" {
+  username = "alice"
+}
+
+output "alice_dashboards" {
+  value = [for d in data.superset_objects_by_owner.alice.dashboards : d.name]
+}
+
+# Dashboards for which alice is the only owner.
+output "alice_sole_owner_dashboards" {
+  value = [
+    for d in data.superset_objects_by_owner.alice.dashboards : d.name
+    if length(d.owner_ids) == 1
+  ]
+}
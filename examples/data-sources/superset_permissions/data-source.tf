@@ -0,0 +1,15 @@
+# All the datasets of the public schema of the examples database.
+data "superset_permissions" "public_datasets" {
+  permission_name = "datasource_access"
+  view_menu_name  = "[examples].[public].*"
+}
+
+resource "superset_role_permissions" "public_reader" {
+  role_name = "public_reader"
+  permissions = [
+    for p in data.superset_permissions.public_datasets.permissions : {
+      permission_name = p.permission_name
+      view_menu_name  = p.view_menu_name
+    }
+  ]
+}
@@ -0,0 +1,7 @@
+data "superset_role" "gamma" {
+  name = "Gamma"
+}
+
+output "gamma_role_id" {
+  value = data.superset_role.gamma.id
+}
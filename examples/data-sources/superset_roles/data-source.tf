@@ -0,0 +1,7 @@
+data "superset_roles" "sales" {
+  name_contains = "sales"
+}
+
+output "sales_role_ids" {
+  value = data.superset_roles.sales.ids
+}
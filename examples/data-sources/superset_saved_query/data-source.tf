@@ -0,0 +1,11 @@
+data "superset_saved_query" "active_customers" {
+  label         = "Active customers"
+  database_name = "PostgreSQL_DB"
+}
+
+resource "superset_dataset" "active_customers" {
+  table_name    = "active_customers"
+  database_name = data.superset_saved_query.active_customers.database_name
+  schema        = data.superset_saved_query.active_customers.schema
+  sql           = data.superset_saved_query.active_customers.sql
+}
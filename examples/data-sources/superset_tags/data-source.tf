@@ -0,0 +1,7 @@
+data "superset_tags" "team" {
+  name_contains = "team-"
+}
+
+output "team_tag_ids" {
+  value = data.superset_tags.team.ids
+}
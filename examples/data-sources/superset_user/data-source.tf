@@ -0,0 +1,10 @@
+data "superset_user" "alice" {
+  username = "alice"
+}
+
+resource "superset_dataset" "orders" {
+  table_name    = "orders"
+  schema        = "sales"
+  database_name = "PostgreSQL_DB"
+  owner_ids     = [data.superset_user.alice.id]
+}
@@ -0,0 +1,10 @@
+data "superset_user_permissions" "alice" {
+  username = "alice"
+}
+
+output "alice_access_review" {
+  value = {
+    roles       = data.superset_user_permissions.alice.effective_role_names
+    permissions = data.superset_user_permissions.alice.permission_names
+  }
+}
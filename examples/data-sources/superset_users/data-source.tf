@@ -0,0 +1,11 @@
+data "superset_users" "owners" {
+  usernames = ["alice", "bob"]
+  active    = true
+}
+
+resource "superset_dataset" "orders" {
+  table_name    = "orders"
+  schema        = "sales"
+  database_name = "PostgreSQL_DB"
+  owner_ids     = values(data.superset_users.owners.ids)
+}
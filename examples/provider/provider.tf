@@ -14,6 +14,10 @@ terraform {
 # export SUPERSET_SERVER_BASE_URL="http://localhost:8080"
 # export SUPERSET_USERNAME="username"
 # export SUPERSET_PASSWORD="password"
+# or, with a token issued by an external identity provider:
+# export SUPERSET_ACCESS_TOKEN="token"
+# and, for an ingress such as an oauth2-proxy that requires its own token in front of Superset:
+# export SUPERSET_INGRESS_BEARER_TOKEN="token"
 provider "superset" {
   server_base_url = "http://localhost:8080"
   username        = "username"
@@ -0,0 +1 @@
+terraform import superset_alert.example 7
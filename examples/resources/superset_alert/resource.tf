@@ -0,0 +1,28 @@
+resource "superset_alert" "example" {
+  name        = "Failed orders"
+  description = "Alert when orders fail in the last hour"
+  crontab     = "*/15 * * * *"
+  timezone    = "Asia/Tokyo"
+
+  database_id    = 1
+  sql            = "SELECT count(*) FROM orders WHERE status = 'failed' AND created_at > now() - interval '1 hour'"
+  validator_type = "operator"
+  condition = {
+    operator  = ">"
+    threshold = 10
+  }
+  grace_period = 3600
+
+  chart_id = superset_chart.example.id
+
+  recipients = [
+    {
+      type   = "Email"
+      target = "oncall@example.com"
+    },
+    {
+      type   = "SlackV2"
+      target = "C0123456789"
+    },
+  ]
+}
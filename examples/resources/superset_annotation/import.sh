@@ -0,0 +1,2 @@
+# The import ID is <layer_id>/<annotation_id>.
+terraform import superset_annotation.example 3/41
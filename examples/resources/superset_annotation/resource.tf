@@ -0,0 +1,7 @@
+resource "superset_annotation" "example" {
+  layer_id    = superset_annotation_layer.example.id
+  short_descr = "v2.4.0"
+  long_descr  = "New checkout flow"
+  start_dttm  = "2026-10-01T09:00:00"
+  end_dttm    = "2026-10-01T09:00:00"
+}
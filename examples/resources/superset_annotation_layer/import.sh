@@ -0,0 +1 @@
+terraform import superset_annotation_layer.example 3
@@ -0,0 +1,4 @@
+resource "superset_annotation_layer" "example" {
+  name  = "Releases"
+  descr = "Production releases of the web shop"
+}
@@ -0,0 +1,39 @@
+provider "superset" {
+  alias           = "staging"
+  server_base_url = "https://superset.staging.example.com"
+}
+
+provider "superset" {
+  alias           = "production"
+  server_base_url = "https://superset.example.com"
+}
+
+# Export the assets of the source environment.
+data "superset_assets_export" "staging" {
+  provider    = superset.staging
+  output_path = "${path.module}/staging-assets.zip"
+}
+
+# Import them into the target environment with the production database connection.
+resource "superset_asset_promotion" "production" {
+  provider    = superset.production
+  bundle_path = data.superset_assets_export.staging.output_path
+
+  uuid_mapping = {
+    # The examples database already exists in production with another UUID.
+    "a2dc77af-e654-49bb-b321-40f6b559a1ee" = "6b1b1e3c-3c5e-4f4e-9a55-7c9d5f4d2b10"
+  }
+
+  database_overrides = {
+    examples = {
+      database_name  = "warehouse"
+      sqlalchemy_uri = "postgresql://superset@warehouse.example.com/warehouse"
+      password       = var.warehouse_password
+    }
+  }
+}
+
+variable "warehouse_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,21 @@
+variable "etl_run_id" {
+  type        = string
+  description = "The ID of the latest run of the nightly refresh job."
+}
+
+resource "superset_cache_warmup" "nightly" {
+  dashboard_ids = [12]
+  chart_ids     = [superset_chart.example.id]
+
+  datasets = [
+    {
+      database_name = "PostgreSQL_DB"
+      table_name    = "orders"
+    },
+  ]
+
+  # Warm up the cache again after every refresh.
+  triggers = {
+    etl_run = var.etl_run_id
+  }
+}
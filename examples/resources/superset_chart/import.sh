@@ -0,0 +1 @@
+terraform import superset_chart.example 34
@@ -0,0 +1,23 @@
+resource "superset_chart" "example" {
+  slice_name    = "Orders per day"
+  viz_type      = "echarts_timeseries_line"
+  dataset_name  = "orders"
+  database_name = "examples"
+  description   = "Daily order count"
+  cache_timeout = 3600
+
+  params = jsonencode({
+    viz_type          = "echarts_timeseries_line"
+    x_axis            = "order_date"
+    time_grain_sqla   = "P1D"
+    metrics           = ["count"]
+    groupby           = []
+    adhoc_filters     = []
+    row_limit         = 10000
+    show_legend       = true
+    rich_tooltip      = true
+    tooltipTimeFormat = "smart_date"
+  })
+
+  dashboards = [12]
+}
@@ -0,0 +1 @@
+terraform import superset_css_template_binding.sales 12/Corporate
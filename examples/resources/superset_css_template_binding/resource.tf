@@ -0,0 +1,9 @@
+# The "Corporate" CSS template contains placeholders such as:
+#   .dashboard-header { background-color: {{ header_color }}; }
+resource "superset_css_template_binding" "sales" {
+  dashboard_id  = 12
+  template_name = "Corporate"
+  variables = {
+    header_color = "#1f3a5f"
+  }
+}
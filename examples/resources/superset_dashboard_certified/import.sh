@@ -0,0 +1 @@
+terraform import superset_dashboard_certified.example 12
@@ -0,0 +1,5 @@
+resource "superset_dashboard_certified" "example" {
+  dashboard_id          = 12
+  certified_by          = "Data Governance Team"
+  certification_details = "Reviewed for the quarterly business review"
+}
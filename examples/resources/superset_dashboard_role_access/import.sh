@@ -0,0 +1 @@
+terraform import superset_dashboard_role_access.example 12
@@ -0,0 +1,5 @@
+# Restrict the dashboard to the finance roles. Requires the DASHBOARD_RBAC feature flag.
+resource "superset_dashboard_role_access" "example" {
+  dashboard_id = 12
+  role_names   = ["Finance", "Finance Admin"]
+}
@@ -0,0 +1 @@
+terraform import superset_database.example 1
@@ -0,0 +1,61 @@
+# Configure the connection with the parameters of the engine, as the dynamic form of the UI does.
+resource "superset_database" "analytics" {
+  database_name = "analytics"
+  engine        = "postgresql"
+
+  parameters = {
+    host     = "db.example.com"
+    port     = "5432"
+    username = "superset"
+    password = var.analytics_password
+    database = "analytics"
+    query    = jsonencode({ sslmode = "require" })
+  }
+
+  allow_run_async = true
+}
+
+# Or with a SQLAlchemy URI.
+resource "superset_database" "warehouse" {
+  database_name  = "warehouse"
+  sqlalchemy_uri = "trino://superset@trino.example.com:8080/hive"
+}
+
+# Connect through an SSH tunnel, which requires the SSH_TUNNELING feature flag.
+resource "superset_database" "private" {
+  database_name  = "private"
+  sqlalchemy_uri = "postgresql://superset@10.0.0.5:5432/private"
+
+  ssh_tunnel = {
+    server_address = "bastion.example.com"
+    username       = "superset"
+    private_key    = var.bastion_private_key
+  }
+}
+
+# Let users authorize the connections with OAuth2.
+resource "superset_database" "snowflake" {
+  database_name  = "snowflake"
+  sqlalchemy_uri = "snowflake://example-account/analytics"
+
+  oauth2_client = {
+    client_id     = "superset"
+    client_secret = var.snowflake_oauth_secret
+    scope         = "refresh_token session:role:analyst"
+  }
+}
+
+variable "snowflake_oauth_secret" {
+  type      = string
+  sensitive = true
+}
+
+variable "bastion_private_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "analytics_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# The import ID is <role_name>/<database_name>.
+terraform import superset_database_catalog_permissions.analyst_trino Analyst/trino
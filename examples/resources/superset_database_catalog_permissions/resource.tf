@@ -0,0 +1,5 @@
+resource "superset_database_catalog_permissions" "analyst_trino" {
+  role_name     = "Analyst"
+  database_name = "trino"
+  catalogs      = ["hive", "iceberg"]
+}
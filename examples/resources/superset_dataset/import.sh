@@ -1 +1,6 @@
-terraform import superset_dataset.example group_name
+# Import a dataset by ID.
+terraform import superset_dataset.example 12
+
+# Import a dataset by database name, schema and table name. Leave the schema empty, e.g. examples//orders,
+# to match the table in any schema.
+terraform import superset_dataset.example examples/public/orders
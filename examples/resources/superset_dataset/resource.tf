@@ -4,3 +4,29 @@ resource "superset_dataset" "example" {
   is_managed_externally = true
   database_name         = "PostgreSQL_DB"
 }
+
+# Create the dataset together with a role that can access it, e.g. for group role bindings.
+resource "superset_dataset" "orders" {
+  table_name         = "orders"
+  schema             = "sales"
+  database_name      = "PostgreSQL_DB"
+  create_access_role = true
+}
+
+output "orders_access_role" {
+  value = superset_dataset.orders.access_role_name
+}
+
+# Use the SQL of a saved query, tracking its updates on every apply unless pin_revision is set.
+resource "superset_dataset" "revenue" {
+  table_name        = "revenue"
+  database_name     = "PostgreSQL_DB"
+  saved_query_label = "Monthly revenue"
+}
+
+# Create the dataset with another database, e.g. when the database of the dataset uses OAuth, and switch to it right after.
+resource "superset_dataset" "events" {
+  table_name             = "events"
+  database_name          = "Trino_OAuth"
+  creation_database_name = "Trino_Service"
+}
@@ -0,0 +1 @@
+terraform import superset_dataset_owners.example orders
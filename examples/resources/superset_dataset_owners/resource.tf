@@ -0,0 +1,4 @@
+resource "superset_dataset_owners" "example" {
+  dataset_name    = "orders"
+  owner_usernames = ["alice", "bob"]
+}
@@ -0,0 +1,26 @@
+variable "schema_version" {
+  type        = string
+  description = "The version of the migrations applied to the warehouse."
+}
+
+resource "superset_dataset_refresh" "orders" {
+  dataset_id = superset_dataset.orders.id
+
+  # Sync the columns again after every migration of the warehouse.
+  triggers = {
+    schema_version = var.schema_version
+  }
+}
+
+# Configure the columns once they are synced from the table.
+resource "superset_dataset_columns" "orders" {
+  dataset_name = superset_dataset.orders.table_name
+  columns = [
+    {
+      column_name = "order_date"
+      is_dttm     = true
+    },
+  ]
+
+  depends_on = [superset_dataset_refresh.orders]
+}
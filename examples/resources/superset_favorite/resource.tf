@@ -0,0 +1,19 @@
+# Superset only changes the favorites of the authenticated user, so use a provider authenticated as the user.
+provider "superset" {
+  alias    = "alice"
+  username = "alice"
+  password = var.alice_password
+}
+
+resource "superset_favorite" "alice" {
+  provider = superset.alice
+
+  username      = "alice"
+  dashboard_ids = [12, 15]
+  chart_ids     = [101]
+}
+
+variable "alice_password" {
+  type      = string
+  sensitive = true
+}
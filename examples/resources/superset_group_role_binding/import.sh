@@ -1 +1,3 @@
+# The roles are read from the group. Import by group name, or by group ID, as a numeric import ID is read as a group ID.
 terraform import superset_group_role_binding.example group_name
+terraform import superset_group_role_binding.example 12
@@ -0,0 +1,20 @@
+# Import all assets extracted from an export committed to source control.
+resource "superset_import_bundle" "assets" {
+  path = "${path.module}/superset/assets"
+
+  passwords = {
+    "databases/examples.yaml" = var.examples_password
+  }
+}
+
+# Import a dashboard export without overwriting changes made to existing objects.
+resource "superset_import_bundle" "sales" {
+  path        = "${path.module}/superset/sales_dashboard.zip"
+  object_type = "dashboard"
+  overwrite   = false
+}
+
+variable "examples_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,9 @@
+resource "superset_owner_transfer" "offboard_alice" {
+  from_username = "alice"
+  to_username   = "bob"
+
+  # Run the transfer again to pick up assets created since the last run.
+  triggers = {
+    run = "2026-10-01"
+  }
+}
@@ -0,0 +1 @@
+terraform import superset_public_role_permissions.example "Public"
@@ -0,0 +1,14 @@
+# Revoke all anonymous access.
+resource "superset_public_role_permissions" "locked_down" {
+  permissions = []
+}
+
+# Allow anonymous users to view dashboards.
+resource "superset_public_role_permissions" "example" {
+  role_name             = "Public"
+  confirm_public_access = true
+  permissions = [
+    { permission_name = "can_read", view_menu_name = "Dashboard" },
+    { permission_name = "can_read", view_menu_name = "Chart" },
+  ]
+}
@@ -0,0 +1 @@
+terraform import superset_report.example 8
@@ -0,0 +1,16 @@
+resource "superset_report" "example" {
+  name          = "Weekly sales"
+  crontab       = "0 9 * * 1"
+  timezone      = "Asia/Tokyo"
+  dashboard_id  = 12
+  report_format = "PDF"
+  email_subject = "Weekly sales dashboard"
+
+  recipients = [
+    {
+      type      = "Email"
+      target    = "sales@example.com"
+      cc_target = "managers@example.com"
+    },
+  ]
+}
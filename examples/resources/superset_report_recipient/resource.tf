@@ -0,0 +1,25 @@
+resource "superset_report_recipient" "sales" {
+  name      = "sales-team"
+  emails    = ["sales@example.com", "sales-leads@example.com"]
+  cc_emails = ["managers@example.com"]
+}
+
+resource "superset_report_recipient" "oncall" {
+  name           = "oncall"
+  emails         = ["oncall@example.com"]
+  slack_channels = ["C0123456789"]
+}
+
+resource "superset_report" "weekly_sales" {
+  name         = "Weekly sales"
+  crontab      = "0 9 * * 1"
+  dashboard_id = 12
+  recipients   = superset_report_recipient.sales.recipients
+}
+
+resource "superset_report" "daily_sales" {
+  name         = "Daily sales"
+  crontab      = "0 9 * * *"
+  dashboard_id = 12
+  recipients   = setunion(superset_report_recipient.sales.recipients, superset_report_recipient.oncall.recipients)
+}
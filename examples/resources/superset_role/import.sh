@@ -1 +1,5 @@
+# Import a role by ID.
 terraform import superset_role.example 2
+
+# Import a role by name.
+terraform import superset_role.example Analyst
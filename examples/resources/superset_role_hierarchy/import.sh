@@ -0,0 +1,2 @@
+# The permissions are read from the role, include_roles is taken from the configuration.
+terraform import superset_role_hierarchy.analyst "Analyst"
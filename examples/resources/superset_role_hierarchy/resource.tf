@@ -0,0 +1,9 @@
+resource "superset_role" "analyst" {
+  name = "Analyst"
+}
+
+# Analysts get the permissions of Gamma and sql_lab, and follow their changes.
+resource "superset_role_hierarchy" "analyst" {
+  role_name     = superset_role.analyst.name
+  include_roles = ["Gamma", "sql_lab"]
+}
@@ -1 +1,3 @@
+# The permissions are read from the role, with the dataset permissions named as on the server,
+# e.g. `[examples].[public].[orders](id:3)`.
 terraform import superset_role_permissions.example "Role1"
@@ -5,3 +5,28 @@ resource "superset_role_permissions" "example" {
     { permission_name = "can_get", view_menu_name = "Group" },
   ]
 }
+
+# Grant every chart and dashboard permission of the server except the write permissions.
+data "superset_permissions" "all" {}
+
+resource "superset_role_permissions" "viewer" {
+  role_name = "Viewer"
+  permissions = [
+    for p in data.superset_permissions.all.permissions : {
+      permission_name = p.permission_name
+      view_menu_name  = p.view_menu_name
+    } if contains(["Chart", "Dashboard"], p.view_menu_name)
+  ]
+  except_permissions = [
+    { permission_name = "can_write", view_menu_name = "*" },
+  ]
+}
+
+# Grant access to a dataset by name, so the configuration works on servers where its ID differs.
+resource "superset_role_permissions" "orders_reader" {
+  role_name           = "OrdersReader"
+  resolve_dataset_ids = true
+  permissions = [
+    { permission_name = "datasource_access", view_menu_name = "[examples].[public].[orders]" },
+  ]
+}
@@ -0,0 +1 @@
+terraform import superset_role_user_binding.example role_name
@@ -0,0 +1,14 @@
+resource "superset_role_user_binding" "analysts" {
+  role_name = "Analyst"
+  usernames = [
+    "alice",
+    "bob",
+  ]
+}
+
+# Only manages carol's membership; other users of the role are kept.
+resource "superset_role_user_binding" "carol_gamma" {
+  role_name     = "Gamma"
+  usernames     = ["carol"]
+  authoritative = false
+}
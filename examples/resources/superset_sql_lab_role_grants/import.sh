@@ -0,0 +1,2 @@
+# The import ID is <role_name>/<database_name>.
+terraform import superset_sql_lab_role_grants.analyst_warehouse Analyst/warehouse
@@ -0,0 +1,5 @@
+resource "superset_sql_lab_role_grants" "analyst_warehouse" {
+  role_name        = "Analyst"
+  database_name    = "warehouse"
+  allow_csv_export = true
+}
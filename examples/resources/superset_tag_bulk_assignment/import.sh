@@ -0,0 +1 @@
+terraform import superset_tag_bulk_assignment.finance domain:finance
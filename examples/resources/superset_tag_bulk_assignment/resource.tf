@@ -0,0 +1,15 @@
+resource "superset_tag_bulk_assignment" "finance" {
+  tag = "domain:finance"
+
+  dashboards = [
+    "Revenue Overview",
+    "Quarterly Forecast",
+  ]
+  charts = [
+    "Revenue by Region",
+  ]
+  datasets = [
+    "invoices",
+    "payments",
+  ]
+}
@@ -0,0 +1 @@
+terraform import superset_tagged_object.example dashboard/12
@@ -0,0 +1,9 @@
+resource "superset_tag" "finance" {
+  name = "domain:finance"
+}
+
+resource "superset_tagged_object" "example" {
+  object_type = "dashboard"
+  object_id   = 12
+  tags        = [superset_tag.finance.name, "tier:gold"]
+}
@@ -1 +1,5 @@
+# Import a user by ID.
 terraform import superset_user.example 111
+
+# Import a user by username.
+terraform import superset_user.example alice
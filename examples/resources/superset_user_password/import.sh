@@ -0,0 +1,3 @@
+# Import the password of a user by username. The password is not read, so the next apply only sets it
+# again when password_wo_version changes.
+terraform import superset_user_password.example example_user
@@ -0,0 +1,12 @@
+# The user is managed in another workspace, without a password.
+resource "superset_user_password" "example" {
+  username            = "example_user"
+  password_wo         = var.example_user_password
+  password_wo_version = 1
+}
+
+variable "example_user_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
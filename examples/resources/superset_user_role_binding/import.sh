@@ -0,0 +1 @@
+terraform import superset_user_role_binding.example username/role_name
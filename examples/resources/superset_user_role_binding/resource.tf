@@ -0,0 +1,23 @@
+# alice is provisioned by the SSO sync, which assigns her other roles.
+resource "superset_user_role_binding" "alice_sql_lab" {
+  username  = "alice"
+  role_name = "sql_lab"
+}
+
+# A user managed by Terraform, whose roles are assigned by bindings.
+resource "superset_user" "bob" {
+  username   = "bob"
+  first_name = "Bob"
+  last_name  = "Smith"
+  email      = "bob@example.com"
+  role_names = ["Gamma"]
+
+  lifecycle {
+    ignore_changes = [role_names]
+  }
+}
+
+resource "superset_user_role_binding" "bob_alpha" {
+  username  = superset_user.bob.username
+  role_name = "Alpha"
+}
@@ -0,0 +1,112 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// refreshToken represents an authentication refresh token.
+type refreshToken string
+
+// refreshFunc exchanges a refresh token for a new access token.
+type refreshFunc func(ctx context.Context, token refreshToken) (accessToken, error)
+
+// tokenTransport sends the access token with every request. When the server rejects a request with 401,
+// e.g. because the token expired during a long apply, it refreshes the access token and retries the
+// request once.
+type tokenTransport struct {
+	base    http.RoundTripper
+	refresh refreshFunc
+
+	mu           sync.Mutex
+	accessToken  accessToken
+	refreshToken refreshToken
+}
+
+func newTokenTransport(base http.RoundTripper, access accessToken, refresh refreshToken, fn refreshFunc) *tokenTransport {
+	return &tokenTransport{base: base, refresh: fn, accessToken: access, refreshToken: refresh}
+}
+
+func (t *tokenTransport) token() accessToken {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.accessToken
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token := t.token()
+	res, err := t.base.RoundTrip(withBearerToken(req, token))
+	if err != nil || res.StatusCode != http.StatusUnauthorized || t.refreshToken == "" {
+		return res, err
+	}
+	// The body of the request was consumed, so only requests whose body can be recreated are retried.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return res, nil
+	}
+
+	refreshed, err := t.renew(req.Context(), token)
+	if err != nil {
+		// Return the original 401, whose body explains the failure better than the refresh.
+		return res, nil
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	res.Body.Close()
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	}
+	return t.base.RoundTrip(withBearerToken(retry, refreshed))
+}
+
+// renew refreshes the access token, unless another request already replaced the rejected token.
+func (t *tokenTransport) renew(ctx context.Context, rejected accessToken) (accessToken, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.accessToken != rejected {
+		return t.accessToken, nil
+	}
+
+	token, err := t.refresh(ctx, t.refreshToken)
+	if err != nil {
+		return "", err
+	}
+	t.accessToken = token
+	return token, nil
+}
+
+// withBearerToken returns a copy of req authenticated with token, as a RoundTripper must not modify the
+// request it is given.
+func withBearerToken(req *http.Request, token accessToken) *http.Request {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return req
+}
+
+// refreshAccessToken exchanges token for a new access token.
+func refreshAccessToken(ctx context.Context, client *ClientWithResponses, token refreshToken) (accessToken, error) {
+	res, err := client.PostApiV1SecurityRefreshWithResponse(ctx, func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return "", fmt.Errorf("failed to refresh access token, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+
+	return accessToken(res.JSON200.AccessToken), nil
+}
@@ -0,0 +1,85 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestTokenTransportRefreshesOnUnauthorized(t *testing.T) {
+	var authorizations, bodies []string
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		authorizations = append(authorizations, req.Header.Get("Authorization"))
+		body, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(body))
+		if req.Header.Get("Authorization") == "Bearer expired" {
+			return &http.Response{StatusCode: http.StatusUnauthorized, Body: http.NoBody}, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+
+	refreshes := 0
+	transport := newTokenTransport(base, "expired", "refresh", func(ctx context.Context, token refreshToken) (accessToken, error) {
+		refreshes++
+		if token != "refresh" {
+			t.Errorf("expected the refresh token to be refresh, got %q", token)
+		}
+		return "fresh", nil
+	})
+
+	req, _ := http.NewRequest(http.MethodPut, "http://localhost/api/v1/dataset/1", bytes.NewReader([]byte(`{"sql":"select 1"}`)))
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("expected the retried request to succeed, got status code %d", res.StatusCode)
+	}
+	if refreshes != 1 {
+		t.Errorf("expected 1 refresh, got %d", refreshes)
+	}
+	if len(authorizations) != 2 || authorizations[1] != "Bearer fresh" {
+		t.Errorf("expected a retry with the fresh token, got %v", authorizations)
+	}
+	if len(bodies) != 2 || bodies[1] != `{"sql":"select 1"}` {
+		t.Errorf("expected the retry to resend the body, got %v", bodies)
+	}
+	if req.Header.Get("Authorization") != "" {
+		t.Error("expected the original request to be left unmodified")
+	}
+
+	// Later requests use the fresh token without refreshing again.
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if refreshes != 1 || authorizations[2] != "Bearer fresh" {
+		t.Errorf("expected the fresh token to be reused, got %d refreshes and %v", refreshes, authorizations)
+	}
+}
+
+func TestTokenTransportWithoutRefreshToken(t *testing.T) {
+	calls := 0
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{StatusCode: http.StatusUnauthorized, Body: http.NoBody}, nil
+	})
+
+	transport := newTokenTransport(base, "static", "", func(ctx context.Context, token refreshToken) (accessToken, error) {
+		t.Error("expected no refresh without a refresh token")
+		return "", nil
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/api/v1/dataset/1", nil)
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.StatusCode != http.StatusUnauthorized || calls != 1 {
+		t.Errorf("expected the 401 to be returned without retry, got status code %d after %d calls", res.StatusCode, calls)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// maxAuthErrorBody is the number of bytes of a 401 or 403 response read to explain it.
+const maxAuthErrorBody = 64 << 10
+
+// permissionViews maps the first segments of API paths to the views of their permissions.
+var permissionViews = map[string]string{
+	"annotation_layer": "Annotation",
+	"chart":            "Chart",
+	"css_template":     "CssTemplate",
+	"dashboard":        "Dashboard",
+	"database":         "Database",
+	"dataset":          "Dataset",
+	"log":              "Log",
+	"query":            "Query",
+	"report":           "ReportSchedule",
+	"saved_query":      "SavedQuery",
+	"tag":              "Tag",
+	"security/groups":  "Group",
+	"security/roles":   "Role",
+	"security/users":   "User",
+}
+
+// authErrorTransport replaces the bodies of 401 and 403 responses with a message telling an expired or
+// invalid authentication apart from a missing permission, as both otherwise surface as a status code and
+// a terse body such as {"message": "Forbidden"}. It wraps the tokenTransport, so a 401 it sees was not
+// resolved by refreshing the access token.
+type authErrorTransport struct {
+	base http.RoundTripper
+	// refreshable tells whether the access token is refreshed on 401, which it is not when it was
+	// issued outside Superset.
+	refreshable bool
+}
+
+func (t *authErrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.base.RoundTrip(req)
+	if err != nil || (res.StatusCode != http.StatusUnauthorized && res.StatusCode != http.StatusForbidden) {
+		return res, err
+	}
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxAuthErrorBody))
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var msg string
+	if res.StatusCode == http.StatusUnauthorized {
+		msg = unauthorizedMessage(apiErrorMessage(body), t.refreshable)
+	} else {
+		msg = forbiddenMessage(req.Method, req.URL.Path, apiErrorMessage(body))
+	}
+	res.Body = io.NopCloser(strings.NewReader(msg))
+	res.ContentLength = int64(len(msg))
+	if res.Header == nil {
+		res.Header = http.Header{}
+	}
+	res.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	res.Header.Set("Content-Length", strconv.Itoa(len(msg)))
+	return res, nil
+}
+
+// unauthorizedMessage returns the message of a 401 response whose server message is serverMsg.
+func unauthorizedMessage(serverMsg string, refreshable bool) string {
+	if !refreshable {
+		return fmt.Sprintf("authentication expired or invalid (%s): the access_token cannot be refreshed, so set a new one, or authenticate with username and password instead", serverMsg)
+	}
+	return fmt.Sprintf("authentication expired (%s) and could not be renewed by refreshing the access token; check that the user is still active and allowed to log in", serverMsg)
+}
+
+// forbiddenMessage returns the message of a 403 response to a method request on path whose server message
+// is serverMsg.
+func forbiddenMessage(method string, path string, serverMsg string) string {
+	endpoint := method + " " + path
+	if permission, ok := requiredPermission(method, path); ok {
+		return fmt.Sprintf("permission denied (%s): the service account lacks permission %s on endpoint %s; grant it to a role of the account", serverMsg, permission, endpoint)
+	}
+	return fmt.Sprintf("permission denied (%s): the service account lacks the permission of endpoint %s; grant it to a role of the account", serverMsg, endpoint)
+}
+
+// requiredPermission guesses the permission checked on a method request on path, e.g. "can_write on Dataset"
+// for PUT /api/v1/dataset/1. The security API checks a permission per method, and the other APIs a read
+// or write permission.
+func requiredPermission(method string, path string) (string, bool) {
+	_, rest, ok := strings.Cut(path, "/api/v1/")
+	if !ok {
+		return "", false
+	}
+	segments := strings.Split(strings.Trim(rest, "/"), "/")
+
+	if segments[0] == "security" && len(segments) > 1 {
+		view, ok := permissionViews["security/"+segments[1]]
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("can_%s on %s", strings.ToLower(method), view), true
+	}
+
+	view, ok := permissionViews[segments[0]]
+	if !ok {
+		return "", false
+	}
+	action := "can_write"
+	if method == http.MethodGet || method == http.MethodHead {
+		action = "can_read"
+	}
+	return fmt.Sprintf("%s on %s", action, view), true
+}
+
+// apiErrorMessage returns the message of an API error payload: {"message": ...} of Superset, {"msg": ...}
+// of the JWT authentication, or {"errors": [{"message": ...}]}. Other bodies are returned as is.
+func apiErrorMessage(body []byte) string {
+	var payload struct {
+		Message any    `json:"message"`
+		Msg     string `json:"msg"`
+		Errors  []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		if msg := strings.TrimSpace(string(body)); msg != "" {
+			return msg
+		}
+		return "no message"
+	}
+
+	switch {
+	case payload.Msg != "":
+		return payload.Msg
+	case len(payload.Errors) > 0 && payload.Errors[0].Message != "":
+		return payload.Errors[0].Message
+	}
+	switch m := payload.Message.(type) {
+	case string:
+		if m != "" {
+			return m
+		}
+	case nil:
+	default:
+		if b, err := json.Marshal(m); err == nil {
+			return string(b)
+		}
+	}
+	return strings.TrimSpace(string(body))
+}
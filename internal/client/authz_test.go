@@ -0,0 +1,66 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAuthErrorTransport(t *testing.T) {
+	var status int
+	var body string
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: status, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(body))}, nil
+	})
+
+	cases := []struct {
+		refreshable bool
+		method      string
+		path        string
+		status      int
+		body        string
+		want        string
+	}{
+		{
+			true, http.MethodGet, "/api/v1/dataset/1", http.StatusUnauthorized, `{"msg": "Token has expired"}`,
+			"authentication expired (Token has expired) and could not be renewed by refreshing the access token; ",
+		},
+		{
+			false, http.MethodGet, "/api/v1/dataset/1", http.StatusUnauthorized, `{"msg": "Signature verification failed"}`,
+			"authentication expired or invalid (Signature verification failed): the access_token cannot be refreshed, ",
+		},
+		{
+			true, http.MethodPut, "/api/v1/dataset/1", http.StatusForbidden, `{"message": "Forbidden"}`,
+			"permission denied (Forbidden): the service account lacks permission can_write on Dataset on endpoint PUT /api/v1/dataset/1; ",
+		},
+		{
+			true, http.MethodGet, "/analytics/api/v1/security/roles/search/", http.StatusForbidden, `{"errors": [{"message": "Access denied"}]}`,
+			"permission denied (Access denied): the service account lacks permission can_get on Role on endpoint GET /analytics/api/v1/security/roles/search/; ",
+		},
+		{
+			true, http.MethodPost, "/api/v1/sqllab/execute/", http.StatusForbidden, "",
+			"permission denied (no message): the service account lacks the permission of endpoint POST /api/v1/sqllab/execute/; ",
+		},
+		{true, http.MethodGet, "/api/v1/dataset/1", http.StatusNotFound, `{"message": "Not found"}`, `{"message": "Not found"}`},
+	}
+	for _, c := range cases {
+		status, body = c.status, c.body
+		transport := &authErrorTransport{base: base, refreshable: c.refreshable}
+		req, _ := http.NewRequest(c.method, "http://localhost"+c.path, nil)
+		res, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got, _ := io.ReadAll(res.Body)
+		if res.StatusCode != c.status {
+			t.Errorf("expected status code %d, got %d", c.status, res.StatusCode)
+		}
+		if !strings.HasPrefix(string(got), c.want) {
+			t.Errorf("%s %s %d: expected a body starting with %q, got %q", c.method, c.path, c.status, c.want, got)
+		}
+	}
+}
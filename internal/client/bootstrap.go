@@ -0,0 +1,173 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"regexp"
+	"sync"
+)
+
+// bootstrapPagePath is a page that is rendered without authentication and embeds the
+// common bootstrap payload of the Superset frontend.
+const bootstrapPagePath = "/login/"
+
+var bootstrapAttrPattern = regexp.MustCompile(`data-bootstrap="([^"]*)"`)
+
+// BootstrapData is the subset of the frontend bootstrap payload used by the provider.
+// Superset does not expose server capabilities such as feature flags through the REST API,
+// so they are read from the payload embedded in its HTML pages.
+type BootstrapData struct {
+	Common struct {
+		FeatureFlags map[string]json.RawMessage `json:"feature_flags"`
+		Conf         struct {
+			// AuthType is the AUTH_TYPE of the server, announced to the login page of recent versions.
+			AuthType *int `json:"AUTH_TYPE"`
+			// AuthProviders are the OAuth providers of the server, announced with AUTH_TYPE.
+			AuthProviders []struct {
+				Name string `json:"name"`
+			} `json:"AUTH_PROVIDERS"`
+		} `json:"conf"`
+	} `json:"common"`
+}
+
+// authTypeNames maps the AUTH_TYPE values of Flask-AppBuilder to the names used by the provider.
+var authTypeNames = map[int]string{
+	0: "oid",
+	1: "db",
+	2: "ldap",
+	3: "remote_user",
+	4: "oauth",
+}
+
+// authTypeLoginProviders maps the AUTH_TYPE values of Flask-AppBuilder to the login provider of the API
+// that authenticates against them. The API does not log in with the other types, such as OAuth.
+var authTypeLoginProviders = map[int]string{
+	1: "db",
+	2: "ldap",
+}
+
+// LoginProvider returns the login provider of the API that the server announces, and false when it
+// cannot be detected, e.g. on older versions or with a type the API does not log in with.
+func (data *BootstrapData) LoginProvider() (string, bool) {
+	if data.Common.Conf.AuthType == nil {
+		return "", false
+	}
+	provider, ok := authTypeLoginProviders[*data.Common.Conf.AuthType]
+	return provider, ok
+}
+
+// AuthType returns the authentication type the server announces, e.g. "db", "ldap" or "oauth", and false
+// when it cannot be detected.
+func (data *BootstrapData) AuthType() (string, bool) {
+	if data.Common.Conf.AuthType == nil {
+		return "", false
+	}
+	name, ok := authTypeNames[*data.Common.Conf.AuthType]
+	return name, ok
+}
+
+// OAuthProviders returns the names of the OAuth providers the server announces for logging in to the UI.
+func (data *BootstrapData) OAuthProviders() []string {
+	names := make([]string, 0, len(data.Common.Conf.AuthProviders))
+	for _, p := range data.Common.Conf.AuthProviders {
+		if p.Name != "" {
+			names = append(names, p.Name)
+		}
+	}
+	return names
+}
+
+type bootstrapCache struct {
+	mu   sync.Mutex
+	data *BootstrapData
+}
+
+// GetBootstrapData fetches the frontend bootstrap payload. The result is cached for the
+// lifetime of the client since it only changes when the server is reconfigured.
+func (cw *ClientWrapper) GetBootstrapData(ctx context.Context) (*BootstrapData, error) {
+	cw.bootstrap.mu.Lock()
+	defer cw.bootstrap.mu.Unlock()
+
+	if cw.bootstrap.data != nil {
+		return cw.bootstrap.data, nil
+	}
+
+	data, err := fetchBootstrapData(ctx, cw.httpClient, cw.url(bootstrapPagePath), cw.customHeaders)
+	if err != nil {
+		return nil, err
+	}
+
+	cw.bootstrap.data = data
+	return data, nil
+}
+
+// fetchBootstrapData fetches the bootstrap payload of the page at pageUrl with httpClient, which does not
+// need to be authenticated.
+func fetchBootstrapData(ctx context.Context, httpClient *http.Client, pageUrl string, editor RequestEditorFn) (*BootstrapData, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := editor(ctx, req); err != nil {
+		return nil, err
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get bootstrap data, status code: %d, body: %s", res.StatusCode, string(body))
+	}
+
+	return parseBootstrapData(body)
+}
+
+func parseBootstrapData(page []byte) (*BootstrapData, error) {
+	m := bootstrapAttrPattern.FindSubmatch(page)
+	if m == nil {
+		return nil, errors.New("failed to find bootstrap data in page")
+	}
+
+	var data BootstrapData
+	if err := json.Unmarshal([]byte(html.UnescapeString(string(m[1]))), &data); err != nil {
+		return nil, fmt.Errorf("failed to parse bootstrap data: %w", err)
+	}
+
+	return &data, nil
+}
+
+// GetFeatureFlags returns the boolean feature flags of the server. Flags with non-boolean values are omitted.
+func (cw *ClientWrapper) GetFeatureFlags(ctx context.Context) (map[string]bool, error) {
+	data, err := cw.GetBootstrapData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	flags := make(map[string]bool, len(data.Common.FeatureFlags))
+	for name, raw := range data.Common.FeatureFlags {
+		var enabled bool
+		if err := json.Unmarshal(raw, &enabled); err != nil {
+			continue
+		}
+		flags[name] = enabled
+	}
+
+	return flags, nil
+}
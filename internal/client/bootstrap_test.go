@@ -0,0 +1,79 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseBootstrapData(t *testing.T) {
+	page := []byte(`<html><body><div id="app" data-bootstrap="{&#34;common&#34;: {&#34;feature_flags&#34;: {&#34;DASHBOARD_RBAC&#34;: true, &#34;TAGGING_SYSTEM&#34;: false, &#34;GLOBAL_ASYNC_QUERIES_TRANSPORT&#34;: &#34;polling&#34;}}}"></div></body></html>`)
+
+	data, err := parseBootstrapData(page)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var enabled bool
+	if err := json.Unmarshal(data.Common.FeatureFlags["DASHBOARD_RBAC"], &enabled); err != nil || !enabled {
+		t.Fatalf("expected DASHBOARD_RBAC to be enabled, got %s", string(data.Common.FeatureFlags["DASHBOARD_RBAC"]))
+	}
+	if len(data.Common.FeatureFlags) != 3 {
+		t.Fatalf("unexpected feature flags: %v", data.Common.FeatureFlags)
+	}
+
+	if _, err := parseBootstrapData([]byte("<html></html>")); err == nil {
+		t.Fatal("expected an error for a page without bootstrap data")
+	}
+}
+
+func TestBootstrapLoginProvider(t *testing.T) {
+	cases := []struct {
+		page   string
+		want   string
+		wantOk bool
+	}{
+		{`<div data-bootstrap="{&#34;common&#34;: {&#34;conf&#34;: {&#34;AUTH_TYPE&#34;: 2}}}"></div>`, "ldap", true},
+		{`<div data-bootstrap="{&#34;common&#34;: {&#34;conf&#34;: {&#34;AUTH_TYPE&#34;: 1}}}"></div>`, "db", true},
+		{`<div data-bootstrap="{&#34;common&#34;: {&#34;conf&#34;: {&#34;AUTH_TYPE&#34;: 4}}}"></div>`, "", false},
+		{`<div data-bootstrap="{&#34;common&#34;: {}}"></div>`, "", false},
+	}
+
+	for _, c := range cases {
+		data, err := parseBootstrapData([]byte(c.page))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got, ok := data.LoginProvider()
+		if got != c.want || ok != c.wantOk {
+			t.Errorf("LoginProvider() of %s = %q, %v, want %q, %v", c.page, got, ok, c.want, c.wantOk)
+		}
+	}
+}
+
+func TestBootstrapAuthType(t *testing.T) {
+	data, err := parseBootstrapData([]byte(`<div data-bootstrap="{&#34;common&#34;: {&#34;conf&#34;: {&#34;AUTH_TYPE&#34;: 4, &#34;AUTH_PROVIDERS&#34;: [{&#34;name&#34;: &#34;google&#34;, &#34;icon&#34;: &#34;fa-google&#34;}, {&#34;name&#34;: &#34;okta&#34;}]}}}"></div>`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, ok := data.AuthType(); got != "oauth" || !ok {
+		t.Errorf("AuthType() = %q, %v, want %q, true", got, ok, "oauth")
+	}
+	if got := data.OAuthProviders(); len(got) != 2 || got[0] != "google" || got[1] != "okta" {
+		t.Errorf("OAuthProviders() = %v, want [google okta]", got)
+	}
+
+	data, err = parseBootstrapData([]byte(`<div data-bootstrap="{&#34;common&#34;: {}}"></div>`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := data.AuthType(); ok {
+		t.Error("expected no auth type for a page without AUTH_TYPE")
+	}
+	if got := data.OAuthProviders(); len(got) != 0 {
+		t.Errorf("OAuthProviders() = %v, want none", got)
+	}
+}
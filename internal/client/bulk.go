@@ -0,0 +1,42 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// BulkMode reports whether the client was configured for bulk provisioning.
+func (cw *ClientWrapper) BulkMode() bool {
+	return cw.bulkMode
+}
+
+// CreateUserWithoutRead creates a new user and returns its ID without reading the user back.
+func (cw *ClientWrapper) CreateUserWithoutRead(ctx context.Context, user SupersetUserApiPost) (int, error) {
+	defer cw.names.invalidate()
+	res, err := cw.PostApiV1SecurityUsers(ctx, user)
+	if err != nil {
+		return 0, err
+	}
+	if res.StatusCode != http.StatusCreated {
+		defer func() { res.Body.Close() }()
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return 0, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return 0, fmt.Errorf("failed to create user, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+
+	userRes, err := ParsePostApiV1SecurityUsersResponse(res)
+	if err != nil {
+		return 0, err
+	}
+
+	return userRes.JSON201.Id, nil
+}
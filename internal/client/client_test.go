@@ -39,7 +39,7 @@ func TestAuthenticate(t *testing.T) {
 		Provider: defaultLoginProvider,
 	}
 
-	token, err := authenticate(ctx, client, body)
+	token, _, err := authenticate(ctx, client, body)
 	if err != nil {
 		t.Fatalf("failed to authenticate: %v", err)
 	}
@@ -4,23 +4,52 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"mime/multipart"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oapi-codegen/nullable"
 )
 
-const defaultLoginProviderName string = "db"
+// DefaultLoginProvider is the login provider used when no other is configured, the Superset user database.
+const DefaultLoginProvider string = "db"
 const DefaultPageSize int = 4096
 
-var defaultLoginProvider = PostApiV1SecurityLoginJSONBodyProvider(defaultLoginProviderName)
+var defaultLoginProvider = PostApiV1SecurityLoginJSONBodyProvider(DefaultLoginProvider)
 
 // ClientWrapper wraps the generated ClientWithResponses to add authentication handling.
 type ClientWrapper struct {
 	*ClientWithResponses
-	pageSize      int
-	serverBaseUrl string
+	pageSize int
+	// pageConcurrency is the number of pages of a list fetched at the same time.
+	pageConcurrency int
+	serverBaseUrl   string
+	failOnConflict  bool
+	writes          *writeTracker
+	httpClient      *http.Client
+	bootstrap       *bootstrapCache
+	lookups         *lookupCache
+	bulkMode        bool
+	names           *nameCache
+	// customHeaders adds the custom headers of the options to requests the generated client does not send.
+	customHeaders RequestEditorFn
+	// timeoutDefaults are the default timeouts of operations on resources, by resource type.
+	timeoutDefaults map[string]time.Duration
+	// userRoles serializes the changes of the roles of users by ChangeUserRoles.
+	userRoles sync.Mutex
+	// datasets serializes the writes to the same dataset, see LockDataset.
+	datasets keyedMutex
 }
 
 // accessToken represents an authentication access token.
@@ -28,13 +57,39 @@ type accessToken string
 
 // ClientOptions holds options for creating a ClientWrapper.
 type ClientOptions struct {
-	PageSize int
+	PageSize              int
+	PageConcurrency       int
+	MaxResponseSize       int64
+	FailOnConflict        bool
+	BasePath              string
+	BulkMode              bool
+	LookupCacheTTL        time.Duration
+	MaxConcurrentRequests int
+	Tenant                string
+	TenantRouting         string
+	TenantHeader          string
+	AuthProvider          string
+	MaxRetries            int
+	RequestsPerSecond     float64
+	Burst                 int
+	CACertPEM             string
+	InsecureSkipVerify    bool
+	HTTPProxy             string
+	HTTPSProxy            string
+	NoProxy               string
+	CustomHeaders         map[string]string
+	IngressAuth           IngressAuth
+	RetryMinDelay         time.Duration
+	RetryMaxDelay         time.Duration
+	TimeoutDefaults       map[string]time.Duration
 }
 
-// ClientCredentials holds the username and password for authentication.
+// ClientCredentials holds the username and password, or the access token, for authentication.
 type ClientCredentials struct {
 	Username string
 	Password string
+	// AccessToken is a bearer token issued outside Superset. When set, the client does not log in.
+	AccessToken string
 }
 
 type clientOptionFn func(*ClientOptions)
@@ -45,17 +100,161 @@ func WithPageSize(pageSize int) clientOptionFn {
 	}
 }
 
+// WithPageConcurrency sets the number of pages of a list fetched at the same time. One fetches them one
+// after the other.
+func WithPageConcurrency(pageConcurrency int) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.PageConcurrency = pageConcurrency
+	}
+}
+
+// WithFailOnConflict makes resources fail instead of warn when an object was modified outside Terraform.
+func WithFailOnConflict(failOnConflict bool) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.FailOnConflict = failOnConflict
+	}
+}
+
+// WithMaxConcurrentRequests caps the number of simultaneous requests to the server. Zero disables the limit.
+func WithMaxConcurrentRequests(maxConcurrentRequests int) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.MaxConcurrentRequests = maxConcurrentRequests
+	}
+}
+
+// WithBulkMode enables the fast path for provisioning many objects at once, which caches the role, group
+// and permission lists for the lifetime of the client.
+func WithBulkMode(bulkMode bool) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.BulkMode = bulkMode
+	}
+}
+
+// WithLookupCacheTTL sets the time the role, group and permission lists are cached. Zero disables the cache
+// outside bulk mode.
+func WithLookupCacheTTL(ttl time.Duration) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.LookupCacheTTL = ttl
+	}
+}
+
+// WithTimeoutDefaults sets the default timeouts of operations on resources, by resource type without
+// the provider prefix, e.g. "dataset".
+func WithTimeoutDefaults(defaults map[string]time.Duration) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.TimeoutDefaults = defaults
+	}
+}
+
+// WithBasePath sets the path prefix under which Superset is hosted, e.g. "/analytics".
+func WithBasePath(basePath string) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.BasePath = basePath
+	}
+}
+
+// WithTenant selects the tenant, or workspace, of a multi-tenant distribution. routing is TenantRoutingHeader
+// or TenantRoutingPath, and header the name of the header carrying the tenant with header routing.
+func WithTenant(tenant string, routing string, header string) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.Tenant = tenant
+		opts.TenantRouting = routing
+		opts.TenantHeader = header
+	}
+}
+
+// WithAuthProvider sets the authentication provider used to log in, e.g. "db" or "ldap". Empty uses
+// DefaultLoginProvider.
+func WithAuthProvider(authProvider string) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.AuthProvider = authProvider
+	}
+}
+
+// WithTLS makes the client trust the PEM encoded CA certificates of caCertPEM in addition to the system
+// ones, e.g. for a server with a certificate of an internal CA, or skip the verification of the server
+// certificate altogether.
+func WithTLS(caCertPEM string, insecureSkipVerify bool) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.CACertPEM = caCertPEM
+		opts.InsecureSkipVerify = insecureSkipVerify
+	}
+}
+
+// WithProxy sends the requests to the server through the proxies httpProxy and httpsProxy, except for the
+// hosts of noProxy, a comma separated list of host names, domains, IP addresses and CIDR ranges. Options
+// that are empty fall back to the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+func WithProxy(httpProxy string, httpsProxy string, noProxy string) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.HTTPProxy = httpProxy
+		opts.HTTPSProxy = httpsProxy
+		opts.NoProxy = noProxy
+	}
+}
+
+// WithCustomHeaders adds headers to every request to the server, e.g. the service token headers of an
+// access proxy such as Cloudflare Access in front of Superset.
+func WithCustomHeaders(headers map[string]string) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.CustomHeaders = headers
+	}
+}
+
+// WithIngressAuth sends the credentials of auth with every request to the server, for an ingress in front
+// of Superset requiring its own authentication.
+func WithIngressAuth(auth IngressAuth) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.IngressAuth = auth
+	}
+}
+
+// WithRateLimit limits the requests to the server to requestsPerSecond on average, with bursts of up to burst
+// requests. Zero requestsPerSecond disables the limit.
+func WithRateLimit(requestsPerSecond float64, burst int) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.RequestsPerSecond = requestsPerSecond
+		opts.Burst = burst
+	}
+}
+
+// WithRetryPolicy retries requests failing with 429 or 5xx up to maxRetries times, waiting an exponential
+// backoff between minDelay and maxDelay. Zero maxRetries disables retries.
+func WithRetryPolicy(maxRetries int, minDelay time.Duration, maxDelay time.Duration) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.MaxRetries = maxRetries
+		opts.RetryMinDelay = minDelay
+		opts.RetryMaxDelay = maxDelay
+	}
+}
+
+// WithMaxResponseSize sets the maximum size in bytes of a single response body. Zero disables the limit.
+func WithMaxResponseSize(maxResponseSize int64) clientOptionFn {
+	return func(opts *ClientOptions) {
+		opts.MaxResponseSize = maxResponseSize
+	}
+}
+
 // NotFoundError represents 404 from API.
 type NotFoundError struct {
 	Resource string
 	ID       any
+	// Suggestions are the names close to ID, when the object was looked up by name.
+	Suggestions []string
 }
 
 func (e *NotFoundError) Error() string {
+	msg := fmt.Sprintf("%s not found", e.Resource)
 	if e.ID != nil {
-		return fmt.Sprintf("%s not found (id=%v)", e.Resource, e.ID)
+		msg = fmt.Sprintf("%s not found (id=%v)", e.Resource, e.ID)
+	}
+	if len(e.Suggestions) > 0 {
+		quoted := make([]string, 0, len(e.Suggestions))
+		for _, name := range e.Suggestions {
+			quoted = append(quoted, strconv.Quote(name))
+		}
+		msg += fmt.Sprintf(", did you mean %s?", strings.Join(quoted, ", "))
 	}
-	return fmt.Sprintf("%s not found", e.Resource)
+	return msg
 }
 
 // IsNotFound checks if the error is a NotFoundError.
@@ -66,61 +265,164 @@ func IsNotFound(err error) bool {
 
 // NewClientWrapper creates a new ClientWrapper with authentication.
 func NewClientWrapper(ctx context.Context, serverBaseUrl string, credentials ClientCredentials, optionFns ...clientOptionFn) (*ClientWrapper, error) {
-	// Create initial client without authentication to perform login
-	client, err := NewClientWithResponses(serverBaseUrl)
+	clientOptions := &ClientOptions{
+		PageSize:        DefaultPageSize,
+		PageConcurrency: DefaultPageConcurrency,
+		MaxResponseSize: DefaultMaxResponseSize,
+		LookupCacheTTL:  DefaultLookupCacheTTL,
+	}
+	for _, fn := range optionFns {
+		fn(clientOptions)
+	}
+
+	basePath, err := tenantBasePath(clientOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	body := PostApiV1SecurityLoginJSONRequestBody{
-		Username: credentials.Username,
-		Password: credentials.Password,
-		Provider: defaultLoginProvider,
+	serverBaseUrl, err = buildServerBaseUrl(serverBaseUrl, basePath)
+	if err != nil {
+		return nil, err
 	}
 
-	accessToken, err := authenticate(ctx, client, body)
+	httpClient, err := newHTTPClient(clientOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	client, err = NewClientWithResponses(serverBaseUrl, WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
-		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", accessToken))
-		return nil
-	}))
+	customHeaders := customHeadersRequestEditor(clientOptions.CustomHeaders)
+
+	// Create initial client without authentication to perform login and token refresh
+	authClient, err := NewClientWithResponses(serverBaseUrl, WithHTTPClient(httpClient), WithRequestEditorFn(customHeaders))
 	if err != nil {
 		return nil, err
 	}
 
-	clientOptions := &ClientOptions{
-		PageSize: DefaultPageSize,
+	// An access token issued outside Superset comes without a refresh token, so it is not refreshed.
+	access, refresh := accessToken(credentials.AccessToken), refreshToken("")
+	if access == "" {
+		access, refresh, err = login(ctx, authClient, credentials, clientOptions, func(ctx context.Context) (string, bool) {
+			data, err := fetchBootstrapData(ctx, httpClient, serverBaseUrl+bootstrapPagePath, customHeaders)
+			if err != nil {
+				return "", false
+			}
+			return data.LoginProvider()
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
-	for _, fn := range optionFns {
-		fn(clientOptions)
+
+	transport := newTokenTransport(httpClient.Transport, access, refresh, func(ctx context.Context, token refreshToken) (accessToken, error) {
+		return refreshAccessToken(ctx, authClient, token)
+	})
+	client, err := NewClientWithResponses(serverBaseUrl, WithHTTPClient(&http.Client{Transport: &authErrorTransport{base: transport, refreshable: refresh != ""}}), WithRequestEditorFn(customHeaders))
+	if err != nil {
+		return nil, err
 	}
 
 	cw := &ClientWrapper{
-		client,
-		clientOptions.PageSize,
-		serverBaseUrl,
+		ClientWithResponses: client,
+		pageSize:            clientOptions.PageSize,
+		pageConcurrency:     clientOptions.PageConcurrency,
+		serverBaseUrl:       serverBaseUrl,
+		failOnConflict:      clientOptions.FailOnConflict,
+		writes:              newWriteTracker(),
+		httpClient:          httpClient,
+		bootstrap:           &bootstrapCache{},
+		lookups:             newLookupCache(clientOptions.LookupCacheTTL, clientOptions.BulkMode),
+		bulkMode:            clientOptions.BulkMode,
+		names:               &nameCache{},
+		customHeaders:       customHeaders,
+		timeoutDefaults:     clientOptions.TimeoutDefaults,
 	}
 
 	return cw, nil
 }
 
-// authenticate performs authentication and returns the access token.
-func authenticate(ctx context.Context, client *ClientWithResponses, body PostApiV1SecurityLoginJSONRequestBody) (accessToken, error) {
+// TimeoutDefault returns the default timeout of operations on resources of resourceType, when one is
+// configured.
+func (cw *ClientWrapper) TimeoutDefault(resourceType string) (time.Duration, bool) {
+	d, ok := cw.timeoutDefaults[resourceType]
+	return d, ok
+}
+
+// customHeadersRequestEditor returns a request editor setting headers on every request.
+func customHeadersRequestEditor(headers map[string]string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		return nil
+	}
+}
+
+// login logs in with the username and password of credentials and returns the access and refresh tokens.
+// When the login fails, announcedProvider is asked for the login provider the server announces, to tell
+// whether the configured one is wrong.
+func login(ctx context.Context, client *ClientWithResponses, credentials ClientCredentials, opts *ClientOptions, announcedProvider func(ctx context.Context) (string, bool)) (accessToken, refreshToken, error) {
+	body := PostApiV1SecurityLoginJSONRequestBody{
+		Username: credentials.Username,
+		Password: credentials.Password,
+		Provider: defaultLoginProvider,
+		Refresh:  true,
+	}
+	if opts.AuthProvider != "" {
+		body.Provider = PostApiV1SecurityLoginJSONBodyProvider(opts.AuthProvider)
+	}
+
+	access, refresh, err := authenticate(ctx, client, body)
+	if err != nil {
+		if announced, ok := announcedProvider(ctx); ok && announced != string(body.Provider) {
+			return "", "", fmt.Errorf("%w (the server uses the %q login provider, but %q is configured, check auth_provider)", err, announced, body.Provider)
+		}
+		// Multi-tenant distributions reject logins to unknown tenants like bad credentials.
+		if opts.Tenant != "" {
+			return "", "", fmt.Errorf("%w (tenant %q, check the tenant and its routing)", err, opts.Tenant)
+		}
+		return "", "", err
+	}
+	return access, refresh, nil
+}
+
+// newHTTPClient builds the HTTP client shared by all API calls of a ClientWrapper.
+func newHTTPClient(opts *ClientOptions) (*http.Client, error) {
+	transport, err := newTLSTransport(opts)
+	if err != nil {
+		return nil, err
+	}
+	transport, err = newProxyTransport(transport, opts)
+	if err != nil {
+		return nil, err
+	}
+	transport = &limitedBodyTransport{base: transport, maxSize: opts.MaxResponseSize}
+	transport = &htmlErrorTransport{base: transport}
+	transport = newConcurrencyLimitTransport(transport, opts.MaxConcurrentRequests)
+	// Requests wait for the rate limit before taking a concurrency slot, and every retry waits again.
+	transport = newRateLimitTransport(transport, opts.RequestsPerSecond, opts.Burst)
+	// Retries wait outside the concurrency limit, so that other requests can proceed meanwhile.
+	transport = newRetryTransport(transport, opts)
+	transport = newTenantTransport(transport, opts)
+	transport = newIngressAuthTransport(transport, opts)
+
+	return &http.Client{Transport: transport}, nil
+}
+
+// authenticate performs authentication and returns the access token, and the refresh token when body
+// requests one.
+func authenticate(ctx context.Context, client *ClientWithResponses, body PostApiV1SecurityLoginJSONRequestBody) (accessToken, refreshToken, error) {
 	res, err := client.PostApiV1SecurityLoginWithResponse(ctx, body)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
 	if res.StatusCode() != http.StatusOK {
 		errMsg := string(res.Body)
 
-		return "", fmt.Errorf("authentication failed with status code: %d, message: %s", res.StatusCode(), errMsg)
+		return "", "", fmt.Errorf("authentication failed with status code: %d, message: %s", res.StatusCode(), errMsg)
 	}
 
-	return accessToken(res.JSON200.AccessToken), nil
+	return accessToken(res.JSON200.AccessToken), refreshToken(res.JSON200.RefreshToken), nil
 }
 
 func (cw *ClientWrapper) createCsrfTokenRequestEditor() (RequestEditorFn, error) {
@@ -129,7 +431,7 @@ func (cw *ClientWrapper) createCsrfTokenRequestEditor() (RequestEditorFn, error)
 		return nil, err
 	}
 
-	csrf_token_url := fmt.Sprintf("%s/api/v1/security/csrf_token/", cw.serverBaseUrl)
+	csrf_token_url := cw.url("/api/v1/security/csrf_token/")
 
 	return func(ctx context.Context, req *http.Request) error {
 		req.Header.Add("x-csrftoken", csrfToken)
@@ -156,23 +458,16 @@ func (cw *ClientWrapper) GetCsrfTokenAndCookies(ctx context.Context) (string, []
 
 // ListUsers retrieves the list of users.
 func (cw *ClientWrapper) ListUsers(ctx context.Context) ([]SupersetUserApiGetList, error) {
-	pageNumber := 0
-	var allUsers []SupersetUserApiGetList
-	for {
-		users, err := cw._ListUsers(ctx, pageNumber)
-		if err != nil {
-			return nil, err
-		}
-		allUsers = append(allUsers, users...)
-		if len(users) < cw.pageSize {
-			break
-		}
-		pageNumber++
+	p := newProgress(ctx, "Listing users")
+	defer p.done()
+	allUsers, err := listPages(ctx, p, cw.pageSize, cw.pageConcurrency, cw._ListUsers)
+	if err != nil {
+		return nil, err
 	}
 	return allUsers, nil
 }
 
-func (cw *ClientWrapper) _ListUsers(ctx context.Context, pageNumber int) ([]SupersetUserApiGetList, error) {
+func (cw *ClientWrapper) _ListUsers(ctx context.Context, pageNumber int) ([]SupersetUserApiGetList, int, error) {
 	res, err := cw.GetApiV1SecurityUsersWithResponse(ctx, &GetApiV1SecurityUsersParams{
 		Q: GetListSchema{
 			Page:     pageNumber,
@@ -180,18 +475,20 @@ func (cw *ClientWrapper) _ListUsers(ctx context.Context, pageNumber int) ([]Supe
 		},
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to get users, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+		return nil, 0, fmt.Errorf("failed to get users, status code: %d, body: %s", res.StatusCode(), string(res.Body))
 	}
 
-	return res.JSON200.Result, nil
+	return res.JSON200.Result, int(res.JSON200.Count), nil
 }
 
 // CreateUser creates a new user with the given user data.
 func (cw *ClientWrapper) CreateUser(ctx context.Context, user SupersetUserApiPost) (*SupersetUserApiGet, error) {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
 	res, err := cw.PostApiV1SecurityUsers(ctx, user)
 	if err != nil {
 		return nil, err
@@ -221,6 +518,8 @@ func (cw *ClientWrapper) CreateUser(ctx context.Context, user SupersetUserApiPos
 		return nil, fmt.Errorf("failed to get created user, status code: %d, body: %s", cwUser.StatusCode(), string(cwUser.Body))
 	}
 
+	cw.writes.record(ObjectKindUser, cwUser.JSON200.Result.Id, stringOrEmpty(cwUser.JSON200.Result.ChangedOn))
+
 	return &cwUser.JSON200.Result, nil
 }
 
@@ -231,58 +530,93 @@ func (cw *ClientWrapper) GetUser(ctx context.Context, userID int) (*SupersetUser
 		return nil, err
 	}
 
-	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to get user, status code: %d, body: %s", res.StatusCode(), string(res.Body))
-	}
-
 	if res.StatusCode() == http.StatusNotFound {
 		return nil, &NotFoundError{Resource: "User", ID: userID}
 	}
 
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get user, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+
 	return &res.JSON200.Result, nil
 }
 
-// FindUser finds a user by username.
-func (cw *ClientWrapper) FindUser(ctx context.Context, userName string) (*SupersetUserApiGetList, error) {
-	var v GetListSchema_Filters_Value
-	err := v.FromGetListSchemaFiltersValue1(userName)
-	if err != nil {
-		return nil, err
+// UserName returns the username of the user with the given userID. While the lookup cache is enabled,
+// it is resolved from the cached user list rather than with one request per user.
+func (cw *ClientWrapper) UserName(ctx context.Context, userID int) (string, error) {
+	if !cw.lookups.enabled() {
+		u, err := cw.GetUser(ctx, userID)
+		if err != nil {
+			return "", err
+		}
+		return u.Username, nil
 	}
 
-	res, err := cw.GetApiV1SecurityUsersWithResponse(ctx, &GetApiV1SecurityUsersParams{
-		Q: GetListSchema{
-			Filters: []struct {
-				Col   string                      `json:"col"`
-				Opr   string                      `json:"opr"`
-				Value GetListSchema_Filters_Value `json:"value"`
-			}{
-				{Col: "username", Opr: "eq", Value: v},
-			},
-		},
-	})
-
-	if err != nil {
-		return nil, err
+	users, ok := cw.lookups.cachedUsers()
+	if !ok {
+		var err error
+		if users, err = cw.ListUsers(ctx); err != nil {
+			return "", err
+		}
+		cw.lookups.storeUsers(users)
 	}
-
-	if res.StatusCode() == http.StatusNotFound {
-		return nil, &NotFoundError{Resource: "User", ID: userName}
+	for _, u := range users {
+		if u.Id == userID {
+			return u.Username, nil
+		}
 	}
+	return "", &NotFoundError{Resource: "User", ID: userID}
+}
 
-	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to find user, status code: %d, body: %s", res.StatusCode(), string(res.Body))
-	}
+// FindUser finds a user by username.
+func (cw *ClientWrapper) FindUser(ctx context.Context, userName string) (*SupersetUserApiGetList, error) {
+	return cw.findUser(ctx, "username", userName)
+}
 
-	if len(res.JSON200.Result) == 0 {
-		return nil, &NotFoundError{Resource: "User", ID: userName}
-	}
+// FindUserByEmail finds a user by email address.
+func (cw *ClientWrapper) FindUserByEmail(ctx context.Context, email string) (*SupersetUserApiGetList, error) {
+	return cw.findUser(ctx, "email", email)
+}
 
-	return &res.JSON200.Result[0], nil
+func (cw *ClientWrapper) findUser(ctx context.Context, col string, value string) (*SupersetUserApiGetList, error) {
+	return resolveName(ctx, cw.names, nameSpec[SupersetUserApiGetList]{
+		Resource: "User",
+		CacheKey: col,
+		Search: func(ctx context.Context, name string) ([]SupersetUserApiGetList, error) {
+			q, err := nameFilter(col, name)
+			if err != nil {
+				return nil, err
+			}
+
+			res, err := cw.GetApiV1SecurityUsersWithResponse(ctx, &GetApiV1SecurityUsersParams{Q: q})
+			if err != nil {
+				return nil, err
+			}
+
+			if res.StatusCode() == http.StatusNotFound {
+				return nil, nil
+			}
+
+			if res.StatusCode() != http.StatusOK {
+				return nil, fmt.Errorf("failed to find user, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+			}
+			return res.JSON200.Result, nil
+		},
+		List: cw.ListUsers,
+		Name: func(u SupersetUserApiGetList) string {
+			if col == "email" {
+				return u.Email
+			}
+			return u.Username
+		},
+		Describe: func(u SupersetUserApiGetList) string { return fmt.Sprintf("%s (id=%d)", u.Username, u.Id) },
+	}, value)
 }
 
 // DeleteUser deletes the user with the given userID.
 func (cw *ClientWrapper) DeleteUser(ctx context.Context, userID int) error {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
 	res, err := cw.DeleteApiV1SecurityUsersPk(ctx, userID)
 	if err != nil {
 		return err
@@ -303,7 +637,8 @@ func (cw *ClientWrapper) DeleteUser(ctx context.Context, userID int) error {
 
 // UpdateUser updates the user with the given userID using the provided user data.
 func (cw *ClientWrapper) UpdateUser(ctx context.Context, userID int, user SupersetUserApiPut) (*SupersetUserApiGet, error) {
-	fmt.Printf("Updating user ID %d with data: %+v\n", userID, user)
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
 	res, err := cw.PutApiV1SecurityUsersPk(ctx, userID, user)
 	if err != nil {
 		return nil, err
@@ -330,28 +665,107 @@ func (cw *ClientWrapper) UpdateUser(ctx context.Context, userID int, user Supers
 		return nil, fmt.Errorf("failed to get user, status code: %d, body: %s", u.StatusCode(), string(u.Body))
 	}
 
+	cw.writes.record(ObjectKindUser, userID, stringOrEmpty(u.JSON200.Result.ChangedOn))
+
 	return &u.JSON200.Result, nil
 }
 
-// ListRoles retrieves the list of roles.
-func (cw *ClientWrapper) ListRoles(ctx context.Context) ([]SupersetRoleApiGetList, error) {
-	pageNumber := 0
-	var allRoles []SupersetRoleApiGetList
-	for {
-		roles, err := cw._ListRoles(ctx, pageNumber)
+// SetUserPassword sets the password of the user with the ID userID, keeping the other attributes of the user.
+func (cw *ClientWrapper) SetUserPassword(ctx context.Context, userID int, password string) error {
+	// Only the password is sent, as the generated body would also reset the groups and the active flag.
+	reqBody, err := json.Marshal(map[string]string{"password": password})
+	if err != nil {
+		return err
+	}
+	res, err := cw.PutApiV1SecurityUsersPkWithBody(ctx, userID, "application/json", bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode == http.StatusNotFound {
+		return &NotFoundError{Resource: "User", ID: userID}
+	}
+	if res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+
 		if err != nil {
-			return nil, err
+			return fmt.Errorf("failed to read response body: %w", err)
 		}
-		allRoles = append(allRoles, roles...)
-		if len(roles) < cw.pageSize {
-			break
+
+		return fmt.Errorf("failed to set user password, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+	return nil
+}
+
+// ChangeUserRoles adds the roles with the IDs add to the user with the ID userID and removes the ones with
+// the IDs remove, keeping the other roles and the attributes of the user. Changes are made one at a time,
+// so that changes of the roles of a user made in parallel do not overwrite each other.
+func (cw *ClientWrapper) ChangeUserRoles(ctx context.Context, userID int, add []int, remove []int) error {
+	cw.userRoles.Lock()
+	defer cw.userRoles.Unlock()
+	defer cw.names.invalidate()
+
+	user, err := cw.GetApiV1SecurityUsersPkWithResponse(ctx, userID, nil)
+	if err != nil {
+		return err
+	}
+	if user.StatusCode() == http.StatusNotFound {
+		return &NotFoundError{Resource: "User", ID: userID}
+	}
+	if user.StatusCode() != http.StatusOK {
+		return fmt.Errorf("failed to get user, status code: %d, body: %s", user.StatusCode(), string(user.Body))
+	}
+
+	current := make([]int, 0, len(user.JSON200.Result.Roles))
+	for _, r := range user.JSON200.Result.Roles {
+		current = append(current, r.Id)
+	}
+	roles := changedIds(current, add, remove)
+	if slices.Equal(roles, current) {
+		return nil
+	}
+
+	// Only the roles are sent, as the generated body would also reset the groups and the active flag.
+	reqBody, err := json.Marshal(map[string][]int{"roles": roles})
+	if err != nil {
+		return err
+	}
+	res, err := cw.PutApiV1SecurityUsersPkWithBody(ctx, userID, "application/json", bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
 		}
-		pageNumber++
+
+		return fmt.Errorf("failed to update user roles, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+	return nil
+}
+
+// ListRoles retrieves the list of roles.
+func (cw *ClientWrapper) ListRoles(ctx context.Context) ([]SupersetRoleApiGetList, error) {
+	if cached, ok := cw.lookups.cachedRoles(); ok {
+		return cached, nil
+	}
+
+	p := newProgress(ctx, "Listing roles")
+	defer p.done()
+	allRoles, err := listPages(ctx, p, cw.pageSize, cw.pageConcurrency, cw._ListRoles)
+	if err != nil {
+		return nil, err
 	}
+	cw.lookups.storeRoles(allRoles)
 	return allRoles, nil
 }
 
-func (cw *ClientWrapper) _ListRoles(ctx context.Context, pageNumber int) ([]SupersetRoleApiGetList, error) {
+func (cw *ClientWrapper) _ListRoles(ctx context.Context, pageNumber int) ([]SupersetRoleApiGetList, int, error) {
 	res, err := cw.GetApiV1SecurityRolesWithResponse(ctx, &GetApiV1SecurityRolesParams{
 		Q: GetListSchema{
 			Page:     pageNumber,
@@ -359,64 +773,59 @@ func (cw *ClientWrapper) _ListRoles(ctx context.Context, pageNumber int) ([]Supe
 		},
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to get roles, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+		return nil, 0, fmt.Errorf("failed to get roles, status code: %d, body: %s", res.StatusCode(), string(res.Body))
 	}
 
-	return res.JSON200.Result, nil
+	return res.JSON200.Result, int(res.JSON200.Count), nil
 }
 
 // FindRole finds a role by role name.
 func (cw *ClientWrapper) FindRole(ctx context.Context, roleName string) (*SupersetRoleApiGetList, error) {
-	var v GetListSchema_Filters_Value
-	err := v.FromGetListSchemaFiltersValue1(roleName)
+	return resolveName(ctx, cw.names, nameSpec[SupersetRoleApiGetList]{
+		Resource: "Role",
+		CacheKey: "name",
+		Search: func(ctx context.Context, name string) ([]SupersetRoleApiGetList, error) {
+			q, err := nameFilter("name", name)
+			if err != nil {
+				return nil, err
+			}
+
+			res, err := cw.GetApiV1SecurityRolesWithResponse(ctx, &GetApiV1SecurityRolesParams{Q: q})
+			if err != nil {
+				return nil, err
+			}
+
+			if res.StatusCode() == http.StatusNotFound {
+				return nil, nil
+			}
+
+			if res.StatusCode() != http.StatusOK {
+				return nil, fmt.Errorf("failed to find role, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+			}
+			return res.JSON200.Result, nil
+		},
+		List:     cw.ListRoles,
+		Name:     func(r SupersetRoleApiGetList) string { return r.Name },
+		Describe: func(r SupersetRoleApiGetList) string { return fmt.Sprintf("%s (id=%d)", r.Name, r.Id) },
+	}, roleName)
+}
+
+// CreateRole creates a new role with the given role data.
+func (cw *ClientWrapper) CreateRole(ctx context.Context, role SupersetRoleApiPost) (*SupersetRoleApiGet, error) {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
+
+	res, err := cw.PostApiV1SecurityRoles(ctx, role)
 	if err != nil {
 		return nil, err
 	}
-
-	res, err := cw.GetApiV1SecurityRolesWithResponse(ctx, &GetApiV1SecurityRolesParams{
-		Q: GetListSchema{
-			Filters: []struct {
-				Col   string                      `json:"col"`
-				Opr   string                      `json:"opr"`
-				Value GetListSchema_Filters_Value `json:"value"`
-			}{
-				{Col: "name", Opr: "eq", Value: v},
-			},
-		},
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	if res.StatusCode() == http.StatusNotFound {
-		return nil, &NotFoundError{Resource: "Role", ID: roleName}
-	}
-
-	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to find role, status code: %d, body: %s", res.StatusCode(), string(res.Body))
-	}
-
-	if len(res.JSON200.Result) == 0 {
-		return nil, &NotFoundError{Resource: "Role", ID: roleName}
-	}
-
-	return &res.JSON200.Result[0], nil
-}
-
-// CreateRole creates a new role with the given role data.
-func (cw *ClientWrapper) CreateRole(ctx context.Context, role SupersetRoleApiPost) (*SupersetRoleApiGet, error) {
-	res, err := cw.PostApiV1SecurityRoles(ctx, role)
-	if err != nil {
-		return nil, err
-	}
-	if res.StatusCode != http.StatusCreated {
-		defer func() { res.Body.Close() }()
-		msg, err := io.ReadAll(res.Body)
+	if res.StatusCode != http.StatusCreated {
+		defer func() { res.Body.Close() }()
+		msg, err := io.ReadAll(res.Body)
 
 		if err != nil {
 			return nil, fmt.Errorf("failed to read response body: %w", err)
@@ -460,6 +869,9 @@ func (cw *ClientWrapper) GetRole(ctx context.Context, roleID int) (*SupersetRole
 
 // DeleteRole deletes the role with the given roleID.
 func (cw *ClientWrapper) DeleteRole(ctx context.Context, roleID int) error {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
+
 	res, err := cw.DeleteApiV1SecurityRolesPk(ctx, roleID)
 	if err != nil {
 		return err
@@ -480,6 +892,9 @@ func (cw *ClientWrapper) DeleteRole(ctx context.Context, roleID int) error {
 
 // UpdateRole updates the role with the given roleID using the provided role data.
 func (cw *ClientWrapper) UpdateRole(ctx context.Context, roleID int, role SupersetRoleApiPut) (*SupersetRoleApiGet, error) {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
+
 	res, err := cw.PutApiV1SecurityRolesPk(ctx, roleID, role)
 	if err != nil {
 		return nil, err
@@ -513,23 +928,21 @@ func (cw *ClientWrapper) UpdateRole(ctx context.Context, roleID int, role Supers
 type SupersetGroupApiGetList = GroupApiGetList
 
 func (cw *ClientWrapper) ListGroups(ctx context.Context) ([]SupersetGroupApiGetList, error) {
-	pageNumber := 0
-	var allGroups []SupersetGroupApiGetList
-	for {
-		groups, err := cw._ListGroups(ctx, pageNumber)
-		if err != nil {
-			return nil, err
-		}
-		allGroups = append(allGroups, groups...)
-		if len(groups) < cw.pageSize {
-			break
-		}
-		pageNumber++
+	if cached, ok := cw.lookups.cachedGroups(); ok {
+		return cached, nil
+	}
+
+	p := newProgress(ctx, "Listing groups")
+	defer p.done()
+	allGroups, err := listPages(ctx, p, cw.pageSize, cw.pageConcurrency, cw._ListGroups)
+	if err != nil {
+		return nil, err
 	}
+	cw.lookups.storeGroups(allGroups)
 	return allGroups, nil
 }
 
-func (cw *ClientWrapper) _ListGroups(ctx context.Context, pageNumber int) ([]SupersetGroupApiGetList, error) {
+func (cw *ClientWrapper) _ListGroups(ctx context.Context, pageNumber int) ([]SupersetGroupApiGetList, int, error) {
 	res, err := cw.GetApiV1SecurityGroupsWithResponse(ctx, &GetApiV1SecurityGroupsParams{
 		Q: GetListSchema{
 			Page:     pageNumber,
@@ -537,14 +950,14 @@ func (cw *ClientWrapper) _ListGroups(ctx context.Context, pageNumber int) ([]Sup
 		},
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to get groups, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+		return nil, 0, fmt.Errorf("failed to get groups, status code: %d, body: %s", res.StatusCode(), string(res.Body))
 	}
 
-	return res.JSON200.Result, nil
+	return res.JSON200.Result, int(res.JSON200.Count), nil
 }
 
 // GetGroup retrieves the group with the given groupID.
@@ -566,41 +979,33 @@ func (cw *ClientWrapper) GetGroup(ctx context.Context, groupID int) (*SupersetGr
 
 // FindGroup finds a group by group name.
 func (cw *ClientWrapper) FindGroup(ctx context.Context, groupName string) (*SupersetGroupApiGetList, error) {
-	var v GetListSchema_Filters_Value
-	err := v.FromGetListSchemaFiltersValue1(groupName)
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := cw.GetApiV1SecurityGroupsWithResponse(ctx, &GetApiV1SecurityGroupsParams{
-		Q: GetListSchema{
-			Filters: []struct {
-				Col   string                      `json:"col"`
-				Opr   string                      `json:"opr"`
-				Value GetListSchema_Filters_Value `json:"value"`
-			}{
-				{Col: "name", Opr: "eq", Value: v},
-			},
+	return resolveName(ctx, cw.names, nameSpec[SupersetGroupApiGetList]{
+		Resource: "Group",
+		CacheKey: "name",
+		Search: func(ctx context.Context, name string) ([]SupersetGroupApiGetList, error) {
+			q, err := nameFilter("name", name)
+			if err != nil {
+				return nil, err
+			}
+
+			res, err := cw.GetApiV1SecurityGroupsWithResponse(ctx, &GetApiV1SecurityGroupsParams{Q: q})
+			if err != nil {
+				return nil, err
+			}
+
+			if res.StatusCode() == http.StatusNotFound {
+				return nil, nil
+			}
+
+			if res.StatusCode() != http.StatusOK {
+				return nil, fmt.Errorf("failed to find group, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+			}
+			return res.JSON200.Result, nil
 		},
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	if res.StatusCode() == http.StatusNotFound {
-		return nil, &NotFoundError{Resource: "Group", ID: groupName}
-	}
-
-	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to find group, status code: %d, body: %s", res.StatusCode(), string(res.Body))
-	}
-
-	if len(res.JSON200.Result) == 0 {
-		return nil, &NotFoundError{Resource: "Group", ID: groupName}
-	}
-
-	return &res.JSON200.Result[0], nil
+		List:     cw.ListGroups,
+		Name:     func(g SupersetGroupApiGetList) string { return g.Name },
+		Describe: func(g SupersetGroupApiGetList) string { return fmt.Sprintf("%s (id=%d)", g.Name, g.Id) },
+	}, groupName)
 }
 
 type SupersetGroupApiPost = PostApiV1SecurityGroupsJSONRequestBody
@@ -608,6 +1013,9 @@ type SupersetGroupApiGet = GroupApiGet
 
 // CreateGroup creates a new group with the given group data.
 func (cw *ClientWrapper) CreateGroup(ctx context.Context, group SupersetGroupApiPost) (*SupersetGroupApiGet, error) {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
+
 	res, err := cw.PostApiV1SecurityGroups(ctx, group)
 	if err != nil {
 		return nil, err
@@ -642,6 +1050,9 @@ func (cw *ClientWrapper) CreateGroup(ctx context.Context, group SupersetGroupApi
 
 // DeleteGroup deletes the group with the given groupID.
 func (cw *ClientWrapper) DeleteGroup(ctx context.Context, groupID int) error {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
+
 	res, err := cw.DeleteApiV1SecurityGroupsPk(ctx, groupID)
 	if err != nil {
 		return err
@@ -663,6 +1074,9 @@ type SupersetGroupApiPut = PutApiV1SecurityGroupsPkJSONRequestBody
 
 // UpdateGroup updates the group with the given groupID using the provided group data.
 func (cw *ClientWrapper) UpdateGroup(ctx context.Context, groupID int, group SupersetGroupApiPut) (*SupersetGroupApiGet, error) {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
+
 	res, err := cw.PutApiV1SecurityGroupsPk(ctx, groupID, group)
 	if err != nil {
 		return nil, err
@@ -694,25 +1108,21 @@ type SupersetPermissionApiGetList = PermissionViewMenuApiGetList
 
 // ListPermissions retrieves the list of permissions.
 func (cw *ClientWrapper) ListPermissions(ctx context.Context) ([]SupersetPermissionApiGetList, error) {
-	pageNumber := 0
-	var allPermissions []SupersetPermissionApiGetList
-	for {
-		permissions, err := cw._ListPermissions(ctx, pageNumber)
-		if err != nil {
-			return nil, err
-		}
-
-		allPermissions = append(allPermissions, permissions...)
+	if cached, ok := cw.lookups.cachedPermissions(); ok {
+		return cached, nil
+	}
 
-		if len(permissions) == 0 {
-			break
-		}
-		pageNumber++
+	p := newProgress(ctx, "Listing permissions")
+	defer p.done()
+	allPermissions, err := listPages(ctx, p, cw.pageSize, cw.pageConcurrency, cw._ListPermissions)
+	if err != nil {
+		return nil, err
 	}
+	cw.lookups.storePermissions(allPermissions)
 	return allPermissions, nil
 }
 
-func (cw *ClientWrapper) _ListPermissions(ctx context.Context, pageNumber int) ([]SupersetPermissionApiGetList, error) {
+func (cw *ClientWrapper) _ListPermissions(ctx context.Context, pageNumber int) ([]SupersetPermissionApiGetList, int, error) {
 	res, err := cw.GetApiV1SecurityPermissionsResourcesWithResponse(ctx, &GetApiV1SecurityPermissionsResourcesParams{
 		Q: GetListSchema{
 			Page:     pageNumber,
@@ -720,14 +1130,14 @@ func (cw *ClientWrapper) _ListPermissions(ctx context.Context, pageNumber int) (
 		},
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to get permissions, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+		return nil, 0, fmt.Errorf("failed to get permissions, status code: %d, body: %s", res.StatusCode(), string(res.Body))
 	}
 
-	return res.JSON200.Result, nil
+	return res.JSON200.Result, int(res.JSON200.Count), nil
 }
 
 // Role Permissions
@@ -758,6 +1168,12 @@ func (cw *ClientWrapper) ListRolePermissions(ctx context.Context, roleId int) ([
 
 // AssignPermissionsToRole assigns the given permission IDs to the specified role ID.
 func (cw *ClientWrapper) AssignPermissionsToRole(ctx context.Context, roleId int, permissionIds []int) error {
+	stop := newProgress(ctx, "Assigning permissions to role").waitForServer(map[string]interface{}{
+		"role_id":     roleId,
+		"permissions": len(permissionIds),
+	})
+	defer stop()
+
 	res, err := cw.PostApiV1SecurityRolesRoleIdPermissions(ctx, roleId, RolePermissionPostSchema{
 		PermissionViewMenuIds: permissionIds,
 	})
@@ -776,11 +1192,62 @@ func (cw *ClientWrapper) AssignPermissionsToRole(ctx context.Context, roleId int
 
 		return fmt.Errorf("failed to add role permissions, status code: %d, body: %s", res.StatusCode, string(msg))
 	}
+
+	cw.writes.record(ObjectKindRolePermissions, roleId, PermissionsFingerprint(permissionIds))
 	return nil
 }
 
+// ChangeRolePermissions grants the permissions with the IDs grant to the role with the ID roleId and
+// revokes the ones with the IDs revoke, given current, the IDs of the permissions of the role. Superset
+// only replaces the whole list of permissions of a role, so this is a read-modify-write of the whole
+// list: the permissions of the role are read again just before the changed list is written, and when
+// they differ from current, the changes are applied to the permissions read, or an error is returned
+// with fail on conflict. Changes made between that read and the write are still overwritten. Nothing is
+// written when there is no change.
+func (cw *ClientWrapper) ChangeRolePermissions(ctx context.Context, roleId int, current []int, grant []int, revoke []int) error {
+	if len(grant) == 0 && len(revoke) == 0 {
+		return nil
+	}
+
+	permissions, err := cw.ListRolePermissions(ctx, roleId)
+	if err != nil && !IsNotFound(err) {
+		return err
+	}
+	latest := make([]int, 0, len(permissions))
+	for _, p := range permissions {
+		latest = append(latest, p.Id)
+	}
+	if PermissionsFingerprint(latest) != PermissionsFingerprint(current) {
+		if cw.failOnConflict {
+			return fmt.Errorf("the permissions of role ID %d were modified while they were updated", roleId)
+		}
+		current = latest
+	}
+
+	return cw.AssignPermissionsToRole(ctx, roleId, changedIds(current, grant, revoke))
+}
+
+// changedIds returns current without the IDs of revoke and with the ones of grant, in the order of
+// current, then of grant, without duplicates.
+func changedIds(current []int, grant []int, revoke []int) []int {
+	seen := make(map[int]bool, len(current)+len(grant))
+	for _, id := range revoke {
+		seen[id] = true
+	}
+
+	ids := make([]int, 0, len(current)+len(grant))
+	for _, id := range slices.Concat(current, grant) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
 // AssignRolesToGroup assigns the given role IDs to the specified group ID.
 func (cw *ClientWrapper) AssignRolesToGroup(ctx context.Context, groupId int, roleIds []int) error {
+	defer cw.names.invalidate()
 	body := SupersetGroupApiPut{
 		Roles: roleIds,
 	}
@@ -795,6 +1262,7 @@ func (cw *ClientWrapper) AssignRolesToGroup(ctx context.Context, groupId int, ro
 
 // AssignUsersToGroup assigns the given user IDs to the specified group ID.
 func (cw *ClientWrapper) AssignUsersToGroup(ctx context.Context, groupId int, userIds []int) error {
+	defer cw.names.invalidate()
 	body := SupersetGroupApiPut{
 		Users: userIds,
 	}
@@ -809,6 +1277,7 @@ func (cw *ClientWrapper) AssignUsersToGroup(ctx context.Context, groupId int, us
 
 // AssignUsersToRole assigns the given user IDs to the specified role ID.
 func (cw *ClientWrapper) AssignUsersToRole(ctx context.Context, roleId int, userIds []int) error {
+	defer cw.names.invalidate()
 	res, err := cw.PutApiV1SecurityRolesRoleIdUsers(ctx, roleId, RoleUserPutSchema{
 		UserIds: userIds,
 	})
@@ -833,23 +1302,16 @@ func (cw *ClientWrapper) AssignUsersToRole(ctx context.Context, roleId int, user
 type SupersetDatabaseApiGetList = DatabaseRestApiGetList
 
 func (cw *ClientWrapper) ListDatabases(ctx context.Context) ([]SupersetDatabaseApiGetList, error) {
-	pageNumber := 0
-	var allDatabases []SupersetDatabaseApiGetList
-	for {
-		databases, err := cw._ListDatabases(ctx, pageNumber)
-		if err != nil {
-			return nil, err
-		}
-		allDatabases = append(allDatabases, databases...)
-		if len(databases) < cw.pageSize {
-			break
-		}
-		pageNumber++
+	p := newProgress(ctx, "Listing databases")
+	defer p.done()
+	allDatabases, err := listPages(ctx, p, cw.pageSize, cw.pageConcurrency, cw._ListDatabases)
+	if err != nil {
+		return nil, err
 	}
 	return allDatabases, nil
 }
 
-func (cw *ClientWrapper) _ListDatabases(ctx context.Context, pageNumber int) ([]SupersetDatabaseApiGetList, error) {
+func (cw *ClientWrapper) _ListDatabases(ctx context.Context, pageNumber int) ([]SupersetDatabaseApiGetList, int, error) {
 	res, err := cw.GetApiV1DatabaseWithResponse(ctx, &GetApiV1DatabaseParams{
 		Q: GetListSchema{
 			Page:     pageNumber,
@@ -857,57 +1319,51 @@ func (cw *ClientWrapper) _ListDatabases(ctx context.Context, pageNumber int) ([]
 		},
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to get databases, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+		return nil, 0, fmt.Errorf("failed to get databases, status code: %d, body: %s", res.StatusCode(), string(res.Body))
 	}
 
-	return res.JSON200.Result, nil
+	return res.JSON200.Result, int(res.JSON200.Count), nil
 }
 
 type SupersetDatabaseApiGet = SupersetDatabaseApiGetList
 
 // FindDatabase finds a database by database name.
 func (cw *ClientWrapper) FindDatabase(ctx context.Context, databaseName string) (*SupersetDatabaseApiGetList, error) {
-	var v GetListSchema_Filters_Value
-	err := v.FromGetListSchemaFiltersValue1(databaseName)
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := cw.GetApiV1DatabaseWithResponse(ctx, &GetApiV1DatabaseParams{
-		Q: GetListSchema{
-			Filters: []struct {
-				Col   string                      `json:"col"`
-				Opr   string                      `json:"opr"`
-				Value GetListSchema_Filters_Value `json:"value"`
-			}{
-				{Col: "database_name", Opr: "eq", Value: v},
-			},
+	return resolveName(ctx, cw.names, nameSpec[SupersetDatabaseApiGetList]{
+		Resource: "Database",
+		CacheKey: "database_name",
+		Search: func(ctx context.Context, name string) ([]SupersetDatabaseApiGetList, error) {
+			q, err := nameFilter("database_name", name)
+			if err != nil {
+				return nil, err
+			}
+
+			res, err := cw.GetApiV1DatabaseWithResponse(ctx, &GetApiV1DatabaseParams{Q: q})
+			if err != nil {
+				return nil, err
+			}
+
+			if res.StatusCode() != http.StatusOK {
+				return nil, fmt.Errorf("failed to find database, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+			}
+			return res.JSON200.Result, nil
 		},
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to find database, status code: %d, body: %s", res.StatusCode(), string(res.Body))
-	}
-
-	if len(res.JSON200.Result) == 0 {
-		return nil, fmt.Errorf("database not found: %s", databaseName)
-	}
-
-	return &res.JSON200.Result[0], nil
+		List:     cw.ListDatabases,
+		Name:     func(d SupersetDatabaseApiGetList) string { return d.DatabaseName },
+		Describe: func(d SupersetDatabaseApiGetList) string { return fmt.Sprintf("%s (id=%d)", d.DatabaseName, d.Id) },
+	}, databaseName)
 }
 
 // CreateDatabase creates a new database with the given database data.
 type SupersetDatabaseApiPost = DatabaseRestApiPost
 
 func (cw *ClientWrapper) CreateDatabase(ctx context.Context, database SupersetDatabaseApiPost) (*DatabaseRestApiGetList, error) {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
 	reqEditor, err := cw.createCsrfTokenRequestEditor()
 	if err != nil {
 		return nil, err
@@ -973,8 +1429,41 @@ func (cw *ClientWrapper) GetDatabase(ctx context.Context, databaseID int) (*Data
 
 }
 
+// GetDatabaseConnection retrieves the connection details of the database with the given databaseID.
+// The password in the SQLAlchemy URI is masked by the server.
+func (cw *ClientWrapper) GetDatabaseConnection(ctx context.Context, databaseID int) (*DatabaseConnectionSchema, error) {
+	res, err := cw.GetApiV1DatabasePkConnection(ctx, databaseID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "Database", ID: databaseID}
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get database connection, status code: %d, body: %s", res.StatusCode, string(body))
+	}
+
+	var parsed struct {
+		Result DatabaseConnectionSchema `json:"result"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse database connection response: %w", err)
+	}
+
+	return &parsed.Result, nil
+}
+
 // DeleteDatabase deletes the database with the given databaseID.
 func (cw *ClientWrapper) DeleteDatabase(ctx context.Context, databaseID int) error {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
 	reqEditor, err := cw.createCsrfTokenRequestEditor()
 	if err != nil {
 		return err
@@ -1000,6 +1489,8 @@ func (cw *ClientWrapper) DeleteDatabase(ctx context.Context, databaseID int) err
 
 // UpdateDatabase updates the database with the given databaseID using the provided database data.
 func (cw *ClientWrapper) UpdateDatabase(ctx context.Context, databaseID int, database DatabaseRestApiPut) error {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
 	reqEditor, err := cw.createCsrfTokenRequestEditor()
 	if err != nil {
 		return err
@@ -1048,8 +1539,154 @@ func (cw *ClientWrapper) ExecuteTestDatabaseConnection(ctx context.Context, body
 	return nil
 }
 
+// DatabaseRequest is the body of POST /api/v1/database/ and PUT /api/v1/database/{pk}. The generated
+// request types omit false booleans, so the body is encoded here. A database is configured either with
+// SqlalchemyUri, or with Engine and Parameters from which the server composes the URI, as the dynamic
+// form of the UI does. A null SshTunnel removes the SSH tunnel of the database.
+type DatabaseRequest struct {
+	DatabaseName         string                               `json:"database_name"`
+	SqlalchemyUri        string                               `json:"sqlalchemy_uri,omitempty"`
+	Engine               string                               `json:"engine,omitempty"`
+	Driver               string                               `json:"driver,omitempty"`
+	Parameters           map[string]interface{}               `json:"parameters,omitempty"`
+	ConfigurationMethod  string                               `json:"configuration_method"`
+	ExposeInSqllab       bool                                 `json:"expose_in_sqllab"`
+	AllowCtas            bool                                 `json:"allow_ctas"`
+	AllowCvas            bool                                 `json:"allow_cvas"`
+	AllowDml             bool                                 `json:"allow_dml"`
+	AllowFileUpload      bool                                 `json:"allow_file_upload"`
+	AllowRunAsync        bool                                 `json:"allow_run_async"`
+	CacheTimeout         nullable.Nullable[int]               `json:"cache_timeout,omitempty"`
+	Extra                string                               `json:"extra,omitempty"`
+	ForceCtasSchema      nullable.Nullable[string]            `json:"force_ctas_schema,omitempty"`
+	SshTunnel            nullable.Nullable[DatabaseSSHTunnel] `json:"ssh_tunnel,omitempty"`
+	MaskedEncryptedExtra string                               `json:"masked_encrypted_extra,omitempty"`
+}
+
+// Configuration methods of a database.
+const (
+	DatabaseConfigurationSqlalchemyForm = "sqlalchemy_form"
+	DatabaseConfigurationDynamicForm    = "dynamic_form"
+)
+
+// CreateDatabaseConnection creates a new database and returns its connection details.
+func (cw *ClientWrapper) CreateDatabaseConnection(ctx context.Context, database DatabaseRequest) (*DatabaseConnectionSchema, error) {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody, err := json.Marshal(database)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cw.PostApiV1DatabaseWithBody(ctx, "application/json", bytes.NewReader(reqBody), reqEditor)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("failed to create database, status code: %d, body: %s", res.StatusCode, string(body))
+	}
+
+	var created struct {
+		Id int `json:"id"`
+	}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse create database response: %w", err)
+	}
+
+	return cw.GetDatabaseConnection(ctx, created.Id)
+}
+
+// UpdateDatabaseConnection updates the database with the given databaseID and returns its connection details.
+func (cw *ClientWrapper) UpdateDatabaseConnection(ctx context.Context, databaseID int, database DatabaseRequest) (*DatabaseConnectionSchema, error) {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody, err := json.Marshal(database)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cw.PutApiV1DatabasePkWithBody(ctx, databaseID, "application/json", bytes.NewReader(reqBody), reqEditor)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "Database", ID: databaseID}
+	}
+	if res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return nil, fmt.Errorf("failed to update database, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+
+	return cw.GetDatabaseConnection(ctx, databaseID)
+}
+
+// AvailableDatabaseEngine is an engine the server has a driver installed for, as returned by
+// GET /api/v1/database/available/. Parameters is the JSON schema of the parameters of the dynamic form,
+// and is empty for engines that can only be configured with a SQLAlchemy URI.
+type AvailableDatabaseEngine struct {
+	Name                     string                 `json:"name"`
+	Engine                   string                 `json:"engine"`
+	DefaultDriver            string                 `json:"default_driver"`
+	AvailableDrivers         []string               `json:"available_drivers"`
+	Parameters               map[string]interface{} `json:"parameters"`
+	SqlalchemyUriPlaceholder string                 `json:"sqlalchemy_uri_placeholder"`
+}
+
+// ListAvailableDatabaseEngines lists the database engines the server can connect to. The server wraps
+// the list in a "databases" key, which the generated response type does not expect.
+func (cw *ClientWrapper) ListAvailableDatabaseEngines(ctx context.Context) ([]AvailableDatabaseEngine, error) {
+	res, err := cw.GetApiV1DatabaseAvailable(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to list available database engines, status code: %d, body: %s", res.StatusCode, string(body))
+	}
+
+	var parsed struct {
+		Databases []AvailableDatabaseEngine `json:"databases"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse available database engines response: %w", err)
+	}
+
+	return parsed.Databases, nil
+}
+
 // CreateTag creates a new tag with the given tag data.
 func (cw *ClientWrapper) CreateTag(ctx context.Context, tag TagRestApiPost) (*TagRestApiGetList, error) {
+	defer cw.names.invalidate()
 	reqEditor, err := cw.createCsrfTokenRequestEditor()
 	if err != nil {
 		return nil, err
@@ -1127,6 +1764,7 @@ func (cw *ClientWrapper) GetTag(ctx context.Context, tagID int) (*TagRestApiGet,
 
 // DeleteTag deletes the tag with the given tagID.
 func (cw *ClientWrapper) DeleteTag(ctx context.Context, tagID int) error {
+	defer cw.names.invalidate()
 	reqEditor, err := cw.createCsrfTokenRequestEditor()
 	if err != nil {
 		return err
@@ -1151,6 +1789,7 @@ func (cw *ClientWrapper) DeleteTag(ctx context.Context, tagID int) error {
 
 // UpdateTag updates the tag with the given tagID using the provided tag data.
 func (cw *ClientWrapper) UpdateTag(ctx context.Context, tagID int, tag TagRestApiPut) (*TagRestApiGet, error) {
+	defer cw.names.invalidate()
 	reqEditor, err := cw.createCsrfTokenRequestEditor()
 	if err != nil {
 		return nil, err
@@ -1185,45 +1824,294 @@ func (cw *ClientWrapper) UpdateTag(ctx context.Context, tagID int, tag TagRestAp
 
 // FindTag finds a tag by tag name.
 func (cw *ClientWrapper) FindTag(ctx context.Context, tagName string) (*TagRestApiGetList, error) {
-	var v GetListSchema_Filters_Value
-	err := v.FromGetListSchemaFiltersValue1(tagName)
+	return resolveName(ctx, cw.names, nameSpec[TagRestApiGetList]{
+		Resource: "Tag",
+		CacheKey: "name",
+		Search: func(ctx context.Context, name string) ([]TagRestApiGetList, error) {
+			q, err := nameFilter("name", name)
+			if err != nil {
+				return nil, err
+			}
+
+			res, err := cw.GetApiV1TagWithResponse(ctx, &GetApiV1TagParams{Q: q})
+			if err != nil {
+				return nil, err
+			}
+
+			if res.StatusCode() == http.StatusNotFound {
+				return nil, nil
+			}
+
+			if res.StatusCode() != http.StatusOK {
+				return nil, fmt.Errorf("failed to find tag, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+			}
+			return res.JSON200.Result, nil
+		},
+		List:     cw.ListTags,
+		Name:     func(t TagRestApiGetList) string { return t.Name },
+		Describe: func(t TagRestApiGetList) string { return fmt.Sprintf("%s (id=%d)", t.Name, t.Id) },
+	}, tagName)
+}
+
+// Types of the objects a tag is assigned to.
+const (
+	TaggedObjectTypeChart     = "chart"
+	TaggedObjectTypeDashboard = "dashboard"
+	TaggedObjectTypeDataset   = "dataset"
+)
+
+// taggedObjectTypeIds maps the types of tagged objects to the IDs the API uses in paths.
+var taggedObjectTypeIds = map[string]int{
+	"query":                   1,
+	TaggedObjectTypeChart:     2,
+	TaggedObjectTypeDashboard: 3,
+	TaggedObjectTypeDataset:   4,
+}
+
+// TaggedObject is an object a tag is assigned to.
+type TaggedObject struct {
+	Type string
+	Id   int
+	Name string
+}
+
+// TagObjects assigns the tag named tagName to objects, creating the tag when it does not exist, in a
+// single request.
+func (cw *ClientWrapper) TagObjects(ctx context.Context, tagName string, objects []TaggedObject) error {
+	defer cw.names.invalidate()
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	res, err := cw.GetApiV1TagWithResponse(ctx, &GetApiV1TagParams{
-		Q: GetListSchema{
-			Filters: []struct {
-				Col   string                      `json:"col"`
-				Opr   string                      `json:"opr"`
-				Value GetListSchema_Filters_Value `json:"value"`
-			}{
-				{Col: "name", Opr: "eq", Value: v},
-			},
+	objectsToTag := make([]interface{}, 0, len(objects))
+	for _, o := range objects {
+		objectsToTag = append(objectsToTag, []interface{}{o.Type, o.Id})
+	}
+	res, err := cw.PostApiV1TagBulkCreateWithResponse(ctx, TagPostBulkSchema{
+		Tags: []TagObject{{Name: tagName, ObjectsToTag: objectsToTag}},
+	}, reqEditor)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK && res.StatusCode() != http.StatusCreated {
+		return fmt.Errorf("failed to tag objects, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+
+	if res.JSON200 != nil && len(res.JSON200.Result.ObjectsSkipped) > 0 {
+		return fmt.Errorf("failed to tag objects, the server skipped %v, e.g. because they are not owned by the provider account", res.JSON200.Result.ObjectsSkipped)
+	}
+	return nil
+}
+
+// UntagObject removes the tag named tagName from object.
+func (cw *ClientWrapper) UntagObject(ctx context.Context, tagName string, object TaggedObject) error {
+	defer cw.names.invalidate()
+	objectType, ok := taggedObjectTypeIds[object.Type]
+	if !ok {
+		return fmt.Errorf("unknown type of tagged object %q", object.Type)
+	}
+
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return err
+	}
+	res, err := cw.DeleteApiV1TagObjectTypeObjectIdTagWithResponse(ctx, objectType, object.Id, tagName, reqEditor)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() == http.StatusNotFound {
+		return &NotFoundError{Resource: "TaggedObject", ID: fmt.Sprintf("%s/%d/%s", object.Type, object.Id, tagName)}
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return fmt.Errorf("failed to untag object, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+	return nil
+}
+
+// ListTaggedObjects retrieves the objects the tag with the ID tagID is assigned to.
+func (cw *ClientWrapper) ListTaggedObjects(ctx context.Context, tagID int) ([]TaggedObject, error) {
+	res, err := cw.GetApiV1TagGetObjectsWithResponse(ctx, &GetApiV1TagGetObjectsParams{TagIds: strconv.Itoa(tagID)})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get tagged objects, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+
+	objects := make([]TaggedObject, 0, len(res.JSON200.Result))
+	for _, o := range res.JSON200.Result {
+		objects = append(objects, TaggedObject{Type: o.Type, Id: o.Id, Name: o.Name})
+	}
+	return objects, nil
+}
+
+// systemTagPrefixes are the prefixes of the names of the tags Superset assigns itself, to the owners,
+// types and favorites of objects.
+var systemTagPrefixes = []string{"owner:", "type:", "favorited_by:"}
+
+// TagObject assigns the tags named tagNames to object, creating the tags that do not exist.
+func (cw *ClientWrapper) TagObject(ctx context.Context, object TaggedObject, tagNames []string) error {
+	defer cw.names.invalidate()
+	objectType, ok := taggedObjectTypeIds[object.Type]
+	if !ok {
+		return fmt.Errorf("unknown type of tagged object %q", object.Type)
+	}
+
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return err
+	}
+
+	// The endpoint reads the tags from a properties object, which the generated body lacks.
+	reqBody, err := json.Marshal(map[string]map[string][]string{"properties": {"tags": tagNames}})
+	if err != nil {
+		return err
+	}
+	res, err := cw.PostApiV1TagObjectTypeObjectIdWithBody(ctx, objectType, object.Id, "application/json", bytes.NewReader(reqBody), reqEditor)
+	if err != nil {
+		return err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return fmt.Errorf("failed to tag object, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+	return nil
+}
+
+// ListObjectTags retrieves the names of the tags assigned to object, except the tags Superset assigns itself.
+func (cw *ClientWrapper) ListObjectTags(ctx context.Context, object TaggedObject) ([]string, error) {
+	res, err := cw.GetApiV1TagGetObjectsWithResponse(ctx, &GetApiV1TagGetObjectsParams{Types: object.Type})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get tagged objects, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+
+	names := []string{}
+	for _, o := range res.JSON200.Result {
+		if o.Type != object.Type || o.Id != object.Id {
+			continue
+		}
+		for _, t := range o.Tags {
+			if !slices.ContainsFunc(systemTagPrefixes, func(prefix string) bool { return strings.HasPrefix(t.Name, prefix) }) {
+				names = append(names, t.Name)
+			}
+		}
+	}
+	return names, nil
+}
+
+// chartNameColumns are the columns of the chart list needed to look charts up by name.
+var chartNameColumns = []string{"id", "slice_name"}
+
+// ListCharts retrieves the IDs and names of all charts.
+func (cw *ClientWrapper) ListCharts(ctx context.Context) ([]ChartRestApiGetList, error) {
+	p := newProgress(ctx, "Listing charts")
+	defer p.done()
+	return listPages(ctx, p, cw.pageSize, cw.pageConcurrency, cw._ListCharts)
+}
+
+func (cw *ClientWrapper) _ListCharts(ctx context.Context, pageNumber int) ([]ChartRestApiGetList, int, error) {
+	res, err := cw.GetApiV1ChartWithResponse(ctx, &GetApiV1ChartParams{
+		Q: GetListSchema{
+			Columns:  chartNameColumns,
+			Page:     pageNumber,
+			PageSize: cw.pageSize,
 		},
 	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, 0, fmt.Errorf("failed to get charts, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+
+	return res.JSON200.Result, int(res.JSON200.Count), nil
+}
+
+// CountChartVizTypes returns the number of charts of each visualization type.
+func (cw *ClientWrapper) CountChartVizTypes(ctx context.Context) (map[string]int, error) {
+	p := newProgress(ctx, "Listing chart visualization types")
+	defer p.done()
+	charts, err := listPages(ctx, p, cw.pageSize, cw.pageConcurrency, func(ctx context.Context, pageNumber int) ([]ChartRestApiGetList, int, error) {
+		res, err := cw.GetApiV1ChartWithResponse(ctx, &GetApiV1ChartParams{
+			Q: GetListSchema{
+				Columns:  []string{"id", "viz_type"},
+				Page:     pageNumber,
+				PageSize: cw.pageSize,
+			},
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if res.StatusCode() != http.StatusOK {
+			return nil, 0, fmt.Errorf("failed to get charts, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+		}
 
+		return res.JSON200.Result, int(res.JSON200.Count), nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if res.StatusCode() == http.StatusNotFound {
-		return nil, &NotFoundError{Resource: "Tag", ID: tagName}
+	counts := make(map[string]int)
+	for _, c := range charts {
+		if vizType, err := c.VizType.Get(); err == nil && vizType != "" {
+			counts[vizType]++
+		}
 	}
+	return counts, nil
+}
 
-	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to find tag, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+// dashboardNameColumns are the columns of the dashboard list needed to look dashboards up by title.
+var dashboardNameColumns = []string{"id", "dashboard_title"}
+
+// ListDashboards retrieves the IDs and titles of all dashboards.
+func (cw *ClientWrapper) ListDashboards(ctx context.Context) ([]DashboardRestApiGetList, error) {
+	p := newProgress(ctx, "Listing dashboards")
+	defer p.done()
+	return listPages(ctx, p, cw.pageSize, cw.pageConcurrency, cw._ListDashboards)
+}
+
+func (cw *ClientWrapper) _ListDashboards(ctx context.Context, pageNumber int) ([]DashboardRestApiGetList, int, error) {
+	res, err := cw.GetApiV1DashboardWithResponse(ctx, &GetApiV1DashboardParams{
+		Q: GetListSchema{
+			Columns:  dashboardNameColumns,
+			Page:     pageNumber,
+			PageSize: cw.pageSize,
+		},
+	})
+	if err != nil {
+		return nil, 0, err
 	}
 
-	if len(res.JSON200.Result) == 0 {
-		return nil, &NotFoundError{Resource: "Tag", ID: tagName}
+	if res.StatusCode() != http.StatusOK {
+		return nil, 0, fmt.Errorf("failed to get dashboards, status code: %d, body: %s", res.StatusCode(), string(res.Body))
 	}
 
-	return &res.JSON200.Result[0], nil
+	return res.JSON200.Result, int(res.JSON200.Count), nil
 }
 
 // CreateDataset creates a new dataset with the given dataset data.
 func (cw *ClientWrapper) CreateDataset(ctx context.Context, dataset DatasetRestApiPost) (*DatasetRestApiGet, error) {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
 	reqEditor, err := cw.createCsrfTokenRequestEditor()
 	if err != nil {
 		return nil, err
@@ -1254,28 +2142,23 @@ func (cw *ClientWrapper) CreateDataset(ctx context.Context, dataset DatasetRestA
 		return nil, err
 	}
 
+	cw.writes.record(ObjectKindDataset, createdDatasetRes.Id, stringOrEmpty(createdDatasetRes.ChangedOn))
+
 	return createdDatasetRes, nil
 }
 
 // ListDatasets retrieves the list of datasets.
 func (cw *ClientWrapper) ListDatasets(ctx context.Context) ([]DatasetRestApiGetList, error) {
-	pageNumber := 0
-	var allDatasets []DatasetRestApiGetList
-	for {
-		datasets, err := cw._ListDatasets(ctx, pageNumber)
-		if err != nil {
-			return nil, err
-		}
-		allDatasets = append(allDatasets, datasets...)
-		if len(datasets) < cw.pageSize {
-			break
-		}
-		pageNumber++
+	p := newProgress(ctx, "Listing datasets")
+	defer p.done()
+	allDatasets, err := listPages(ctx, p, cw.pageSize, cw.pageConcurrency, cw._ListDatasets)
+	if err != nil {
+		return nil, err
 	}
 	return allDatasets, nil
 }
 
-func (cw *ClientWrapper) _ListDatasets(ctx context.Context, pageNumber int) ([]DatasetRestApiGetList, error) {
+func (cw *ClientWrapper) _ListDatasets(ctx context.Context, pageNumber int) ([]DatasetRestApiGetList, int, error) {
 	res, err := cw.GetApiV1DatasetWithResponse(ctx, &GetApiV1DatasetParams{
 		Q: GetListSchema{
 			Page:     pageNumber,
@@ -1283,49 +2166,128 @@ func (cw *ClientWrapper) _ListDatasets(ctx context.Context, pageNumber int) ([]D
 		},
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to get datasets, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+		return nil, 0, fmt.Errorf("failed to get datasets, status code: %d, body: %s", res.StatusCode(), string(res.Body))
 	}
 
-	return res.JSON200.Result, nil
+	return res.JSON200.Result, int(res.JSON200.Count), nil
 }
 
-// FindDataset finds a dataset by dataset name.
+// FindDataset finds a dataset by dataset name. It fails when several datasets share the name, use
+// FindQualifiedDataset to select one of them.
 func (cw *ClientWrapper) FindDataset(ctx context.Context, datasetName string) (*DatasetRestApiGetList, error) {
-	var v GetListSchema_Filters_Value
-	err := v.FromGetListSchemaFiltersValue1(datasetName)
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := cw.GetApiV1DatasetWithResponse(ctx, &GetApiV1DatasetParams{
-		Q: GetListSchema{
-			Filters: []struct {
-				Col   string                      `json:"col"`
-				Opr   string                      `json:"opr"`
-				Value GetListSchema_Filters_Value `json:"value"`
-			}{
-				{Col: "table_name", Opr: "eq", Value: v},
-			},
+	return resolveName(ctx, cw.names, nameSpec[DatasetRestApiGetList]{
+		Resource: "Dataset",
+		CacheKey: "table_name",
+		Search: func(ctx context.Context, name string) ([]DatasetRestApiGetList, error) {
+			q, err := nameFilter("table_name", name)
+			if err != nil {
+				return nil, err
+			}
+			q.PageSize = cw.pageSize
+
+			res, err := cw.GetApiV1DatasetWithResponse(ctx, &GetApiV1DatasetParams{Q: q})
+			if err != nil {
+				return nil, err
+			}
+
+			if res.StatusCode() != http.StatusOK {
+				return nil, fmt.Errorf("failed to find dataset, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+			}
+			return res.JSON200.Result, nil
 		},
-	})
+		List: cw.ListDatasets,
+		Name: func(d DatasetRestApiGetList) string { return d.TableName },
+		Describe: func(d DatasetRestApiGetList) string {
+			return DatasetQualifier{TableName: d.TableName, Schema: stringOrEmpty(d.Schema), DatabaseName: d.Database.DatabaseName}.String()
+		},
+	}, datasetName)
+}
 
-	if err != nil {
-		return nil, err
-	}
+// DatasetQualifier identifies a dataset by name. Schema and DatabaseName are optional and
+// narrow down the match when the same table name exists in several schemas or databases.
+type DatasetQualifier struct {
+	TableName    string
+	Schema       string
+	DatabaseName string
+}
 
-	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to find dataset, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+func (q DatasetQualifier) String() string {
+	s := q.TableName
+	if q.Schema != "" {
+		s = q.Schema + "." + s
 	}
-
-	if len(res.JSON200.Result) == 0 {
-		return nil, &NotFoundError{Resource: "Dataset", ID: datasetName}
+	if q.DatabaseName != "" {
+		s = q.DatabaseName + "/" + s
 	}
+	return s
+}
 
-	return &res.JSON200.Result[0], nil
+// FindQualifiedDataset finds the single dataset matching the qualifier. Unlike FindDataset, it can
+// select one of the datasets sharing a table name by schema and database name.
+func (cw *ClientWrapper) FindQualifiedDataset(ctx context.Context, qualifier DatasetQualifier) (*DatasetRestApiGetList, error) {
+	// A dataset is named by the parts of its qualifier that are set, so that it matches the qualifier.
+	name := func(d DatasetRestApiGetList) string {
+		q := DatasetQualifier{TableName: d.TableName}
+		if qualifier.Schema != "" {
+			q.Schema = stringOrEmpty(d.Schema)
+		}
+		if qualifier.DatabaseName != "" {
+			q.DatabaseName = d.Database.DatabaseName
+		}
+		return q.String()
+	}
+
+	return resolveName(ctx, cw.names, nameSpec[DatasetRestApiGetList]{
+		Resource: "Dataset",
+		CacheKey: "qualifier",
+		Search: func(ctx context.Context, _ string) ([]DatasetRestApiGetList, error) {
+			q, err := nameFilter("table_name", qualifier.TableName)
+			if err != nil {
+				return nil, err
+			}
+			if qualifier.Schema != "" {
+				schema, err := nameFilter("schema", qualifier.Schema)
+				if err != nil {
+					return nil, err
+				}
+				q.Filters = append(q.Filters, schema.Filters...)
+			}
+
+			p := newProgress(ctx, "Finding datasets")
+			defer p.done()
+			return listPages(ctx, p, cw.pageSize, cw.pageConcurrency, func(ctx context.Context, pageNumber int) ([]DatasetRestApiGetList, int, error) {
+				page := q
+				page.Page = pageNumber
+				page.PageSize = cw.pageSize
+				res, err := cw.GetApiV1DatasetWithResponse(ctx, &GetApiV1DatasetParams{Q: page})
+				if err != nil {
+					return nil, 0, err
+				}
+
+				if res.StatusCode() != http.StatusOK {
+					return nil, 0, fmt.Errorf("failed to find dataset, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+				}
+				return res.JSON200.Result, int(res.JSON200.Count), nil
+			})
+		},
+		List: cw.ListDatasets,
+		Name: name,
+		Describe: func(d DatasetRestApiGetList) string {
+			return DatasetQualifier{TableName: d.TableName, Schema: stringOrEmpty(d.Schema), DatabaseName: d.Database.DatabaseName}.String()
+		},
+	}, qualifier.String())
+}
+
+// LockDataset waits until no other operation of this client writes the dataset with the given datasetID, or
+// ctx is done. The dataset resource and its sub-resources each read the dataset and write it back, so they
+// hold the lock from the read to the write so that parallel writes do not overwrite each other. unlock
+// releases the lock.
+func (cw *ClientWrapper) LockDataset(ctx context.Context, datasetID int) (unlock func(), err error) {
+	return cw.datasets.lock(ctx, strconv.Itoa(datasetID))
 }
 
 // GetDataset retrieves the dataset with the given datasetID.
@@ -1346,6 +2308,8 @@ func (cw *ClientWrapper) GetDataset(ctx context.Context, datasetID int) (*Datase
 
 // DeleteDataset deletes the dataset with the given datasetID.
 func (cw *ClientWrapper) DeleteDataset(ctx context.Context, datasetID int) error {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
 	reqEditor, err := cw.createCsrfTokenRequestEditor()
 	if err != nil {
 		return err
@@ -1370,6 +2334,8 @@ func (cw *ClientWrapper) DeleteDataset(ctx context.Context, datasetID int) error
 
 // UpdateDataset updates the dataset with the given datasetID using the provided dataset data.
 func (cw *ClientWrapper) UpdateDataset(ctx context.Context, datasetID int, dataset DatasetRestApiPut) (*DatasetRestApiGet, error) {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
 	reqEditor, err := cw.createCsrfTokenRequestEditor()
 	if err != nil {
 		return nil, err
@@ -1405,5 +2371,1713 @@ func (cw *ClientWrapper) UpdateDataset(ctx context.Context, datasetID int, datas
 		return nil, err
 	}
 
+	cw.writes.record(ObjectKindDataset, datasetID, stringOrEmpty(updatedDatasetRes.ChangedOn))
+
 	return updatedDatasetRes, nil
 }
+
+// RefreshDataset syncs the columns of the dataset with the given datasetID with the table or query in the
+// database, adding new columns and removing dropped ones, and returns the dataset after the refresh.
+func (cw *ClientWrapper) RefreshDataset(ctx context.Context, datasetID int) (*DatasetRestApiGet, error) {
+	defer cw.lookups.invalidate()
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cw.PutApiV1DatasetPkRefresh(ctx, datasetID, reqEditor)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "Dataset", ID: datasetID}
+	}
+	if res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return nil, fmt.Errorf("failed to refresh dataset, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+
+	d, err := cw.GetDataset(ctx, datasetID)
+	if err != nil {
+		return nil, err
+	}
+
+	cw.writes.record(ObjectKindDataset, datasetID, stringOrEmpty(d.ChangedOn))
+
+	return d, nil
+}
+
+// ExportDashboards exports the dashboards with the given IDs, with their charts, datasets and databases,
+// as a ZIP bundle. The bundle is streamed to a temporary file whose path is returned; the caller must
+// remove it.
+func (cw *ClientWrapper) ExportDashboards(ctx context.Context, dashboardIDs []int) (string, error) {
+	p := newProgress(ctx, "Exporting dashboards")
+	res, err := cw.GetApiV1DashboardExport(withStreamedResponse(ctx), &GetApiV1DashboardExportParams{
+		Q: dashboardIDs,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode == http.StatusNotFound {
+		return "", &NotFoundError{Resource: "Dashboard", ID: dashboardIDs}
+	}
+	if res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return "", fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return "", fmt.Errorf("failed to export dashboards, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+
+	return streamToTempFile(res.Body, "superset-dashboard-export-*.zip", p)
+}
+
+// ExportAssets exports all databases, datasets, charts, dashboards and saved queries as a ZIP bundle.
+// The bundle is streamed to a temporary file whose path is returned; the caller must remove it.
+func (cw *ClientWrapper) ExportAssets(ctx context.Context) (string, error) {
+	p := newProgress(ctx, "Exporting assets")
+	res, err := cw.GetApiV1AssetsExport(withStreamedResponse(ctx))
+	if err != nil {
+		return "", err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return "", fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return "", fmt.Errorf("failed to export assets, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+
+	return streamToTempFile(res.Body, "superset-assets-export-*.zip", p)
+}
+
+// ImportAssets imports a ZIP bundle of assets, overwriting the existing assets with the same UUIDs.
+// passwords maps the database files of the bundle, e.g. `databases/examples.yaml`, to their passwords.
+func (cw *ClientWrapper) ImportAssets(ctx context.Context, bundle []byte, passwords map[string]string) error {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return err
+	}
+
+	body, contentType, err := importBundleBody("bundle", bundle, passwords, nil)
+	if err != nil {
+		return err
+	}
+
+	stop := newProgress(ctx, "Importing assets").waitForServer(map[string]interface{}{"bytes": len(bundle)})
+	defer stop()
+
+	res, err := cw.PostApiV1AssetsImportWithBody(ctx, contentType, body, reqEditor)
+	if err != nil {
+		return err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return fmt.Errorf("failed to import assets, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+
+	return nil
+}
+
+// The object types of the per-type import endpoints.
+const (
+	ImportObjectTypeChart      = "chart"
+	ImportObjectTypeDashboard  = "dashboard"
+	ImportObjectTypeDatabase   = "database"
+	ImportObjectTypeDataset    = "dataset"
+	ImportObjectTypeSavedQuery = "saved_query"
+)
+
+// ImportObjects imports a ZIP bundle exported for objectType, e.g. a dashboard with its charts, datasets
+// and databases, with the import endpoint of the type. Existing objects with the same UUIDs are only
+// overwritten when overwrite is set. passwords maps the database files of the bundle to their passwords.
+func (cw *ClientWrapper) ImportObjects(ctx context.Context, objectType string, bundle []byte, passwords map[string]string, overwrite bool) error {
+	defer cw.lookups.invalidate()
+	defer cw.names.invalidate()
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return err
+	}
+
+	body, contentType, err := importBundleBody("formData", bundle, passwords, map[string]string{
+		"overwrite": strconv.FormatBool(overwrite),
+	})
+	if err != nil {
+		return err
+	}
+
+	var post func(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	switch objectType {
+	case ImportObjectTypeChart:
+		post = cw.PostApiV1ChartImportWithBody
+	case ImportObjectTypeDashboard:
+		post = cw.PostApiV1DashboardImportWithBody
+	case ImportObjectTypeDatabase:
+		post = cw.PostApiV1DatabaseImportWithBody
+	case ImportObjectTypeDataset:
+		post = cw.PostApiV1DatasetImportWithBody
+	case ImportObjectTypeSavedQuery:
+		post = cw.PostApiV1SavedQueryImportWithBody
+	default:
+		return fmt.Errorf("unsupported import object type %q", objectType)
+	}
+
+	stop := newProgress(ctx, "Importing "+objectType+"s").waitForServer(map[string]interface{}{"bytes": len(bundle)})
+	defer stop()
+
+	res, err := post(ctx, contentType, body, reqEditor)
+	if err != nil {
+		return err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return fmt.Errorf("failed to import %ss, status code: %d, body: %s", objectType, res.StatusCode, string(msg))
+	}
+
+	return nil
+}
+
+// importBundleBody returns the multipart body of an import request uploading bundle as the file field,
+// with passwords and the other fields.
+func importBundleBody(field string, bundle []byte, passwords map[string]string, fields map[string]string) (io.Reader, string, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile(field, "bundle.zip")
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := part.Write(bundle); err != nil {
+		return nil, "", err
+	}
+	if len(passwords) > 0 {
+		p, err := json.Marshal(passwords)
+		if err != nil {
+			return nil, "", err
+		}
+		if err := w.WriteField("passwords", string(p)); err != nil {
+			return nil, "", err
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(fields)) {
+		if err := w.WriteField(name, fields[name]); err != nil {
+			return nil, "", err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+	return &body, w.FormDataContentType(), nil
+}
+
+// SupersetMenuItem is a node of the menu tree returned by the menu API.
+type SupersetMenuItem struct {
+	Name   string             `json:"name"`
+	Label  string             `json:"label"`
+	Icon   string             `json:"icon"`
+	Url    string             `json:"url"`
+	Childs []SupersetMenuItem `json:"childs"`
+}
+
+// GetDashboard retrieves the dashboard with the given dashboardID.
+func (cw *ClientWrapper) GetDashboard(ctx context.Context, dashboardID int) (*DashboardGetResponseSchema, error) {
+	res, err := cw.GetApiV1DashboardIdOrSlugWithResponse(ctx, strconv.Itoa(dashboardID))
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "Dashboard", ID: dashboardID}
+	}
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get dashboard, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+
+	return &res.JSON200.Result, nil
+}
+
+// UpdateDashboard updates the dashboard with the given dashboardID. Only the attributes set in dashboard are changed.
+func (cw *ClientWrapper) UpdateDashboard(ctx context.Context, dashboardID int, dashboard DashboardRestApiPut) (*DashboardGetResponseSchema, error) {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cw.PutApiV1DashboardPk(ctx, dashboardID, dashboard, reqEditor)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return nil, fmt.Errorf("failed to update dashboard, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+
+	return cw.GetDashboard(ctx, dashboardID)
+}
+
+// SetDashboardRoles sets the roles that can access the dashboard with the given dashboardID, when
+// DASHBOARD_RBAC is enabled. An empty roleIds removes the restriction.
+func (cw *ClientWrapper) SetDashboardRoles(ctx context.Context, dashboardID int, roleIds []int) (*DashboardGetResponseSchema, error) {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return nil, err
+	}
+
+	// The generated body omits an empty list of roles, so the roles are sent alone.
+	reqBody, err := json.Marshal(map[string][]int{"roles": roleIds})
+	if err != nil {
+		return nil, err
+	}
+	res, err := cw.PutApiV1DashboardPkWithBody(ctx, dashboardID, "application/json", bytes.NewReader(reqBody), reqEditor)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "Dashboard", ID: dashboardID}
+	}
+	if res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return nil, fmt.Errorf("failed to update dashboard roles, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+
+	return cw.GetDashboard(ctx, dashboardID)
+}
+
+// CacheWarmUpResult is the result of warming up the cache of a chart.
+type CacheWarmUpResult = ChartCacheWarmUpResponseSingle
+
+// ListDashboardChartIds returns the IDs of the charts of the dashboard with the given dashboardID.
+func (cw *ClientWrapper) ListDashboardChartIds(ctx context.Context, dashboardID int) ([]int, error) {
+	res, err := cw.GetApiV1DashboardIdOrSlugChartsWithResponse(ctx, strconv.Itoa(dashboardID))
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "Dashboard", ID: dashboardID}
+	}
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get dashboard charts, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+
+	ids := make([]int, 0, len(res.JSON200.Result))
+	for _, c := range res.JSON200.Result {
+		ids = append(ids, c.Id)
+	}
+	return ids, nil
+}
+
+// WarmUpChartCache runs the query of the chart with the given chartID so that its result is cached.
+// When dashboardID is not 0, the query uses the default filters of the dashboard, as the dashboard does.
+func (cw *ClientWrapper) WarmUpChartCache(ctx context.Context, chartID int, dashboardID int) ([]CacheWarmUpResult, error) {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return nil, err
+	}
+
+	stop := newProgress(ctx, "Warming up chart cache").waitForServer(map[string]interface{}{
+		"chart_id":     chartID,
+		"dashboard_id": dashboardID,
+	})
+	defer stop()
+
+	res, err := cw.PutApiV1ChartWarmUpCacheWithResponse(ctx, ChartCacheWarmUpRequestSchema{
+		ChartId:     chartID,
+		DashboardId: dashboardID,
+	}, reqEditor)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "Chart", ID: chartID}
+	}
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to warm up chart cache, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+
+	return res.JSON200.Result, nil
+}
+
+// WarmUpDatasetCache runs the queries of all charts of the dataset of the table tableName in the database
+// databaseName so that their results are cached.
+func (cw *ClientWrapper) WarmUpDatasetCache(ctx context.Context, databaseName string, tableName string) ([]CacheWarmUpResult, error) {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return nil, err
+	}
+
+	stop := newProgress(ctx, "Warming up dataset cache").waitForServer(map[string]interface{}{
+		"database_name": databaseName,
+		"table_name":    tableName,
+	})
+	defer stop()
+
+	res, err := cw.PutApiV1DatasetWarmUpCacheWithResponse(ctx, DatasetCacheWarmUpRequestSchema{
+		DbName:    databaseName,
+		TableName: tableName,
+	}, reqEditor)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "Dataset", ID: databaseName + "." + tableName}
+	}
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to warm up dataset cache, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+
+	results := make([]CacheWarmUpResult, 0, len(res.JSON200.Result))
+	for _, r := range res.JSON200.Result {
+		results = append(results, CacheWarmUpResult(r))
+	}
+	return results, nil
+}
+
+// Chart is a chart as returned by GET /api/v1/chart/{id}. The generated ChartGetResponseSchema
+// declares cache_timeout as a string while the server returns a number, so the body is decoded here.
+type Chart struct {
+	Id                   int                       `json:"id"`
+	SliceName            string                    `json:"slice_name"`
+	VizType              string                    `json:"viz_type"`
+	Description          nullable.Nullable[string] `json:"description"`
+	Params               nullable.Nullable[string] `json:"params"`
+	QueryContext         nullable.Nullable[string] `json:"query_context"`
+	CacheTimeout         nullable.Nullable[int]    `json:"cache_timeout"`
+	CertifiedBy          nullable.Nullable[string] `json:"certified_by"`
+	CertificationDetails nullable.Nullable[string] `json:"certification_details"`
+	DatasourceId         int                       `json:"datasource_id"`
+	DatasourceType       string                    `json:"datasource_type"`
+	Owners               []User                    `json:"owners"`
+	Dashboards           []Dashboard               `json:"dashboards"`
+	Url                  string                    `json:"url"`
+	Uuid                 string                    `json:"uuid"`
+}
+
+// CreateChart creates a new chart.
+func (cw *ClientWrapper) CreateChart(ctx context.Context, chart ChartRestApiPost) (*Chart, error) {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cw.PostApiV1Chart(ctx, chart, reqEditor)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("failed to create chart, status code: %d, body: %s", res.StatusCode, string(body))
+	}
+
+	var created struct {
+		Id int `json:"id"`
+	}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse create chart response: %w", err)
+	}
+
+	return cw.GetChart(ctx, created.Id)
+}
+
+// GetChart retrieves the chart with the given chartID.
+func (cw *ClientWrapper) GetChart(ctx context.Context, chartID int) (*Chart, error) {
+	res, err := cw.GetApiV1ChartIdOrUuid(ctx, strconv.Itoa(chartID))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "Chart", ID: chartID}
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get chart, status code: %d, body: %s", res.StatusCode, string(body))
+	}
+
+	var parsed struct {
+		Result Chart `json:"result"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse chart response: %w", err)
+	}
+
+	return &parsed.Result, nil
+}
+
+// UpdateChart updates the chart with the given chartID. Only the attributes set in chart are changed.
+func (cw *ClientWrapper) UpdateChart(ctx context.Context, chartID int, chart ChartRestApiPut) (*Chart, error) {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cw.PutApiV1ChartPk(ctx, chartID, chart, reqEditor)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return nil, fmt.Errorf("failed to update chart, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+
+	return cw.GetChart(ctx, chartID)
+}
+
+// DeleteChart deletes the chart with the given chartID.
+func (cw *ClientWrapper) DeleteChart(ctx context.Context, chartID int) error {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return err
+	}
+	res, err := cw.DeleteApiV1ChartPk(ctx, chartID, reqEditor)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return &NotFoundError{Resource: "Chart", ID: chartID}
+	}
+	if res.StatusCode != http.StatusOK {
+		defer func() { res.Body.Close() }()
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return fmt.Errorf("failed to delete chart, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+	return nil
+}
+
+// Asset kinds whose owners can be listed and reassigned.
+const (
+	AssetKindDataset   = "dataset"
+	AssetKindChart     = "chart"
+	AssetKindDashboard = "dashboard"
+)
+
+// AssetKindSavedQuery is the kind of saved queries, which can be listed by owner, their creator, but not
+// reassigned.
+const AssetKindSavedQuery = "saved_query"
+
+// OwnedAsset is a dataset, chart, dashboard or saved query together with the IDs of its owners.
+type OwnedAsset struct {
+	Id     int
+	Name   string
+	Owners []int
+}
+
+// ListOwnedAssets retrieves the assets of the given kind owned by the user with the given userID.
+func (cw *ClientWrapper) ListOwnedAssets(ctx context.Context, kind string, userID int) ([]OwnedAsset, error) {
+	pageNumber := 0
+	var allAssets []OwnedAsset
+	for {
+		assets, err := cw._ListOwnedAssets(ctx, kind, userID, pageNumber)
+		if err != nil {
+			return nil, err
+		}
+		allAssets = append(allAssets, assets...)
+		if len(assets) < cw.pageSize {
+			break
+		}
+		pageNumber++
+	}
+	return allAssets, nil
+}
+
+func (cw *ClientWrapper) _ListOwnedAssets(ctx context.Context, kind string, userID int, pageNumber int) ([]OwnedAsset, error) {
+	var v GetListSchema_Filters_Value
+	if err := v.FromGetListSchemaFiltersValue0(float32(userID)); err != nil {
+		return nil, err
+	}
+
+	// Saved queries have no owners but are owned by the user who created them.
+	ownerColumn, ownerOpr := "owners", "rel_m_m"
+	if kind == AssetKindSavedQuery {
+		ownerColumn, ownerOpr = "created_by", "rel_o_m"
+	}
+
+	q := GetListSchema{
+		Filters: []struct {
+			Col   string                      `json:"col"`
+			Opr   string                      `json:"opr"`
+			Value GetListSchema_Filters_Value `json:"value"`
+		}{
+			{Col: ownerColumn, Opr: ownerOpr, Value: v},
+		},
+		OrderColumn:    "id",
+		OrderDirection: GetListSchemaOrderDirectionAsc,
+		Page:           pageNumber,
+		PageSize:       cw.pageSize,
+	}
+
+	var res *http.Response
+	var err error
+	var nameColumn string
+	switch kind {
+	case AssetKindDataset:
+		nameColumn = "table_name"
+		res, err = cw.GetApiV1Dataset(ctx, &GetApiV1DatasetParams{Q: q})
+	case AssetKindChart:
+		nameColumn = "slice_name"
+		res, err = cw.GetApiV1Chart(ctx, &GetApiV1ChartParams{Q: q})
+	case AssetKindDashboard:
+		nameColumn = "dashboard_title"
+		res, err = cw.GetApiV1Dashboard(ctx, &GetApiV1DashboardParams{Q: q})
+	case AssetKindSavedQuery:
+		nameColumn = "label"
+		res, err = cw.GetApiV1SavedQuery(ctx, &GetApiV1SavedQueryParams{Q: q})
+	default:
+		return nil, fmt.Errorf("unsupported asset kind %q", kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to list %ss, status code: %d, body: %s", kind, res.StatusCode, string(body))
+	}
+
+	// Only the attributes shared by the three list endpoints are decoded, as the generated list
+	// schemas do not match the server responses for every asset kind.
+	var list struct {
+		Result []map[string]json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse %s list response: %w", kind, err)
+	}
+
+	assets := make([]OwnedAsset, 0, len(list.Result))
+	for _, r := range list.Result {
+		var asset OwnedAsset
+		var owners []struct {
+			Id int `json:"id"`
+		}
+		if err := json.Unmarshal(r["id"], &asset.Id); err != nil {
+			return nil, fmt.Errorf("failed to parse %s id: %w", kind, err)
+		}
+		if name, ok := r[nameColumn]; ok {
+			_ = json.Unmarshal(name, &asset.Name)
+		}
+		if o, ok := r["owners"]; ok {
+			if err := json.Unmarshal(o, &owners); err != nil {
+				return nil, fmt.Errorf("failed to parse owners of %s %d: %w", kind, asset.Id, err)
+			}
+		}
+		if c, ok := r["created_by"]; ok && kind == AssetKindSavedQuery {
+			var creator struct {
+				Id int `json:"id"`
+			}
+			if err := json.Unmarshal(c, &creator); err == nil && creator.Id != 0 {
+				owners = append(owners, creator)
+			}
+		}
+		for _, o := range owners {
+			asset.Owners = append(asset.Owners, o.Id)
+		}
+		assets = append(assets, asset)
+	}
+
+	return assets, nil
+}
+
+// SetAssetOwners replaces the owners of the asset of the given kind. No other attribute of the asset
+// is sent, so they are left as is.
+func (cw *ClientWrapper) SetAssetOwners(ctx context.Context, kind string, id int, owners []int) error {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(map[string][]int{"owners": owners})
+	if err != nil {
+		return err
+	}
+
+	var res *http.Response
+	switch kind {
+	case AssetKindDataset:
+		res, err = cw.PutApiV1DatasetPkWithBody(ctx, id, &PutApiV1DatasetPkParams{}, "application/json", bytes.NewReader(body), reqEditor)
+	case AssetKindChart:
+		res, err = cw.PutApiV1ChartPkWithBody(ctx, id, "application/json", bytes.NewReader(body), reqEditor)
+	case AssetKindDashboard:
+		res, err = cw.PutApiV1DashboardPkWithBody(ctx, id, "application/json", bytes.NewReader(body), reqEditor)
+	default:
+		return fmt.Errorf("unsupported asset kind %q", kind)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode == http.StatusNotFound {
+		return &NotFoundError{Resource: kind, ID: id}
+	}
+	if res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return fmt.Errorf("failed to update owners of %s %d, status code: %d, body: %s", kind, id, res.StatusCode, string(msg))
+	}
+
+	if kind == AssetKindDataset {
+		// Record the write so that dataset resources do not report it as an external modification.
+		d, err := cw.GetDataset(ctx, id)
+		if err != nil {
+			return err
+		}
+		cw.writes.record(ObjectKindDataset, id, stringOrEmpty(d.ChangedOn))
+	}
+
+	return nil
+}
+
+// GetMenu retrieves the menu tree visible to the authenticated user.
+func (cw *ClientWrapper) GetMenu(ctx context.Context) ([]SupersetMenuItem, error) {
+	res, err := cw.GetApiV1MenuWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get menu, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+
+	var menu struct {
+		Result []SupersetMenuItem `json:"result"`
+	}
+	if err := json.Unmarshal(res.Body, &menu); err != nil {
+		return nil, fmt.Errorf("failed to parse menu response: %w", err)
+	}
+
+	return menu.Result, nil
+}
+
+// GetCurrentUserPermissions retrieves the permissions granted to the authenticated user through all of its roles.
+func (cw *ClientWrapper) GetCurrentUserPermissions(ctx context.Context) ([]RolePermission, error) {
+	res, err := cw.GetApiV1MeRolesWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get current user roles, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+
+	// The generated schema does not describe the roles attribute, which maps each role name
+	// to a list of [permission_name, view_menu_name] pairs.
+	var me struct {
+		Result struct {
+			Roles map[string][][]string `json:"roles"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(res.Body, &me); err != nil {
+		return nil, fmt.Errorf("failed to parse current user roles response: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var permissions []RolePermission
+	for _, rolePermissions := range me.Result.Roles {
+		for _, p := range rolePermissions {
+			if len(p) != 2 || seen[p[0]+"_"+p[1]] {
+				continue
+			}
+			seen[p[0]+"_"+p[1]] = true
+			permissions = append(permissions, RolePermission{PermissionName: p[0], ViewMenuName: p[1]})
+		}
+	}
+
+	return permissions, nil
+}
+
+// GetCurrentUser retrieves the authenticated user.
+func (cw *ClientWrapper) GetCurrentUser(ctx context.Context) (*UserResponseSchema, error) {
+	res, err := cw.GetApiV1MeWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get current user, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+
+	return &res.JSON200.Result, nil
+}
+
+// Types of the objects a user can mark as favorite.
+const (
+	FavoriteObjectTypeChart     = "chart"
+	FavoriteObjectTypeDashboard = "dashboard"
+)
+
+// FavoriteStatus reports which objects of objectType with the given ids are favorites of the authenticated
+// user. Objects that do not exist are left out.
+func (cw *ClientWrapper) FavoriteStatus(ctx context.Context, objectType string, ids []int) (map[int]bool, error) {
+	var status int
+	var body []byte
+	var result *GetFavStarIdsSchema
+	switch objectType {
+	case FavoriteObjectTypeChart:
+		res, err := cw.GetApiV1ChartFavoriteStatusWithResponse(ctx, &GetApiV1ChartFavoriteStatusParams{Q: ids})
+		if err != nil {
+			return nil, err
+		}
+		status, body, result = res.StatusCode(), res.Body, res.JSON200
+	case FavoriteObjectTypeDashboard:
+		res, err := cw.GetApiV1DashboardFavoriteStatusWithResponse(ctx, &GetApiV1DashboardFavoriteStatusParams{Q: ids})
+		if err != nil {
+			return nil, err
+		}
+		status, body, result = res.StatusCode(), res.Body, res.JSON200
+	default:
+		return nil, fmt.Errorf("unknown type of favorite object %q", objectType)
+	}
+
+	favorites := make(map[int]bool, len(ids))
+	// The server answers 404 when none of the objects exist.
+	if status == http.StatusNotFound {
+		return favorites, nil
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to get favorite status, status code: %d, body: %s", status, string(body))
+	}
+
+	for _, r := range result.Result {
+		favorites[r.Id] = r.Value
+	}
+	return favorites, nil
+}
+
+// SetFavorite marks the object of objectType with the given id as a favorite of the authenticated user, or
+// unmarks it when favorite is false.
+func (cw *ClientWrapper) SetFavorite(ctx context.Context, objectType string, id int, favorite bool) error {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return err
+	}
+
+	var status int
+	var body []byte
+	switch {
+	case objectType == FavoriteObjectTypeChart && favorite:
+		res, err := cw.PostApiV1ChartPkFavoritesWithResponse(ctx, id, reqEditor)
+		if err != nil {
+			return err
+		}
+		status, body = res.StatusCode(), res.Body
+	case objectType == FavoriteObjectTypeChart:
+		res, err := cw.DeleteApiV1ChartPkFavoritesWithResponse(ctx, id, reqEditor)
+		if err != nil {
+			return err
+		}
+		status, body = res.StatusCode(), res.Body
+	case objectType == FavoriteObjectTypeDashboard && favorite:
+		res, err := cw.PostApiV1DashboardPkFavoritesWithResponse(ctx, id, reqEditor)
+		if err != nil {
+			return err
+		}
+		status, body = res.StatusCode(), res.Body
+	case objectType == FavoriteObjectTypeDashboard:
+		res, err := cw.DeleteApiV1DashboardPkFavoritesWithResponse(ctx, id, reqEditor)
+		if err != nil {
+			return err
+		}
+		status, body = res.StatusCode(), res.Body
+	default:
+		return fmt.Errorf("unknown type of favorite object %q", objectType)
+	}
+
+	if status == http.StatusNotFound {
+		return &NotFoundError{Resource: objectType, ID: id}
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("failed to update favorite status, status code: %d, body: %s", status, string(body))
+	}
+	return nil
+}
+
+// LogFilter narrows down the action logs returned by ListLogs. Zero values are ignored.
+type LogFilter struct {
+	UserId int
+	Action string
+	// Since and Until bound the log timestamp, in ISO 8601 format.
+	Since string
+	Until string
+}
+
+// SupersetLogEntry is an action log entry together with its ID.
+type SupersetLogEntry struct {
+	Id int
+	LogRestApiGetList
+}
+
+// ListLogs retrieves the action logs matching the filter, newest first, up to maxResults entries.
+func (cw *ClientWrapper) ListLogs(ctx context.Context, filter LogFilter, maxResults int) ([]SupersetLogEntry, error) {
+	pageNumber := 0
+	var allLogs []SupersetLogEntry
+	for len(allLogs) < maxResults {
+		logs, err := cw._ListLogs(ctx, filter, pageNumber)
+		if err != nil {
+			return nil, err
+		}
+		allLogs = append(allLogs, logs...)
+		if len(logs) < cw.pageSize {
+			break
+		}
+		pageNumber++
+	}
+
+	if len(allLogs) > maxResults {
+		allLogs = allLogs[:maxResults]
+	}
+	return allLogs, nil
+}
+
+func (cw *ClientWrapper) _ListLogs(ctx context.Context, filter LogFilter, pageNumber int) ([]SupersetLogEntry, error) {
+	q := GetListSchema{
+		OrderColumn:    "dttm",
+		OrderDirection: GetListSchemaOrderDirectionDesc,
+		Page:           pageNumber,
+		PageSize:       cw.pageSize,
+	}
+
+	addFilter := func(col, opr string, set func(v *GetListSchema_Filters_Value) error) error {
+		var v GetListSchema_Filters_Value
+		if err := set(&v); err != nil {
+			return err
+		}
+		q.Filters = append(q.Filters, struct {
+			Col   string                      `json:"col"`
+			Opr   string                      `json:"opr"`
+			Value GetListSchema_Filters_Value `json:"value"`
+		}{Col: col, Opr: opr, Value: v})
+		return nil
+	}
+
+	if filter.UserId != 0 {
+		if err := addFilter("user", "rel_o_m", func(v *GetListSchema_Filters_Value) error {
+			return v.FromGetListSchemaFiltersValue0(float32(filter.UserId))
+		}); err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range []struct{ col, opr, value string }{
+		{"action", "eq", filter.Action},
+		{"dttm", "gt", filter.Since},
+		{"dttm", "lt", filter.Until},
+	} {
+		if f.value == "" {
+			continue
+		}
+		if err := addFilter(f.col, f.opr, func(v *GetListSchema_Filters_Value) error {
+			return v.FromGetListSchemaFiltersValue1(f.value)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := cw.GetApiV1Log(ctx, &GetApiV1LogParams{Q: q})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get logs, status code: %d, body: %s", res.StatusCode, string(body))
+	}
+
+	// The ids are integers, although the generated schema declares them as strings.
+	var list struct {
+		Ids    []int               `json:"ids"`
+		Result []LogRestApiGetList `json:"result"`
+	}
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse logs response: %w", err)
+	}
+
+	logs := make([]SupersetLogEntry, 0, len(list.Result))
+	for i, l := range list.Result {
+		entry := SupersetLogEntry{LogRestApiGetList: l}
+		if i < len(list.Ids) {
+			entry.Id = list.Ids[i]
+		}
+		logs = append(logs, entry)
+	}
+
+	return logs, nil
+}
+
+// Report schedule types.
+const (
+	ReportScheduleTypeAlert  = "Alert"
+	ReportScheduleTypeReport = "Report"
+)
+
+// ReportValidatorConfig is the condition of an alert. The generated ValidatorConfigJSON omits a zero
+// threshold, so it is not used.
+type ReportValidatorConfig struct {
+	Op        string   `json:"op,omitempty"`
+	Threshold *float64 `json:"threshold,omitempty"`
+}
+
+// ReportScheduleRequest is the body of POST /api/v1/report/ and PUT /api/v1/report/{pk}. The generated
+// request types omit false booleans and zero thresholds, so the body is encoded here.
+type ReportScheduleRequest struct {
+	Type                string                    `json:"type,omitempty"`
+	Name                string                    `json:"name"`
+	Description         nullable.Nullable[string] `json:"description,omitempty"`
+	Crontab             string                    `json:"crontab"`
+	Timezone            string                    `json:"timezone,omitempty"`
+	Active              bool                      `json:"active"`
+	CreationMethod      string                    `json:"creation_method,omitempty"`
+	Owners              []int                     `json:"owners,omitempty"`
+	Recipients          []ReportRecipient         `json:"recipients"`
+	Chart               nullable.Nullable[int]    `json:"chart,omitempty"`
+	Dashboard           nullable.Nullable[int]    `json:"dashboard,omitempty"`
+	ReportFormat        string                    `json:"report_format,omitempty"`
+	EmailSubject        nullable.Nullable[string] `json:"email_subject,omitempty"`
+	ForceScreenshot     bool                      `json:"force_screenshot"`
+	LogRetention        int                       `json:"log_retention"`
+	Database            int                       `json:"database,omitempty"`
+	Sql                 string                    `json:"sql,omitempty"`
+	ValidatorType       string                    `json:"validator_type,omitempty"`
+	ValidatorConfigJson *ReportValidatorConfig    `json:"validator_config_json,omitempty"`
+	GracePeriod         int                       `json:"grace_period,omitempty"`
+	WorkingTimeout      int                       `json:"working_timeout,omitempty"`
+}
+
+// ReportScheduleRecipient is a recipient as returned by GET /api/v1/report/{pk}. The configuration is
+// returned as a JSON string.
+type ReportScheduleRecipient struct {
+	Id                  int                       `json:"id"`
+	Type                string                    `json:"type"`
+	RecipientConfigJson nullable.Nullable[string] `json:"recipient_config_json"`
+}
+
+// Config parses the recipient configuration.
+func (r *ReportScheduleRecipient) Config() (ReportRecipientConfigJSON, error) {
+	var config ReportRecipientConfigJSON
+	if s := stringOrEmpty(r.RecipientConfigJson); s != "" {
+		if err := json.Unmarshal([]byte(s), &config); err != nil {
+			return config, fmt.Errorf("failed to parse recipient config: %w", err)
+		}
+	}
+	return config, nil
+}
+
+// ReportSchedule is an alert or report as returned by GET /api/v1/report/{pk}.
+type ReportSchedule struct {
+	Id                  int                                `json:"id"`
+	Type                string                             `json:"type"`
+	Name                string                             `json:"name"`
+	Description         nullable.Nullable[string]          `json:"description"`
+	Crontab             string                             `json:"crontab"`
+	Timezone            string                             `json:"timezone"`
+	Active              nullable.Nullable[bool]            `json:"active"`
+	Owners              []User                             `json:"owners"`
+	Recipients          []ReportScheduleRecipient          `json:"recipients"`
+	Chart               *ReportScheduleRestApiGetSlice     `json:"chart"`
+	Dashboard           *ReportScheduleRestApiGetDashboard `json:"dashboard"`
+	Database            *ReportScheduleRestApiGetDatabase  `json:"database"`
+	ReportFormat        nullable.Nullable[string]          `json:"report_format"`
+	EmailSubject        nullable.Nullable[string]          `json:"email_subject"`
+	ForceScreenshot     nullable.Nullable[bool]            `json:"force_screenshot"`
+	LogRetention        nullable.Nullable[int]             `json:"log_retention"`
+	Sql                 nullable.Nullable[string]          `json:"sql"`
+	ValidatorType       nullable.Nullable[string]          `json:"validator_type"`
+	ValidatorConfigJson nullable.Nullable[string]          `json:"validator_config_json"`
+	GracePeriod         nullable.Nullable[int]             `json:"grace_period"`
+	WorkingTimeout      nullable.Nullable[int]             `json:"working_timeout"`
+	LastState           nullable.Nullable[string]          `json:"last_state"`
+}
+
+// ValidatorConfig parses the condition of the alert.
+func (r *ReportSchedule) ValidatorConfig() (ReportValidatorConfig, error) {
+	var config ReportValidatorConfig
+	if s := stringOrEmpty(r.ValidatorConfigJson); s != "" {
+		if err := json.Unmarshal([]byte(s), &config); err != nil {
+			return config, fmt.Errorf("failed to parse validator config: %w", err)
+		}
+	}
+	return config, nil
+}
+
+// ReportScheduleState is the execution state of an alert or report, as listed by GET /api/v1/report/.
+type ReportScheduleState struct {
+	Id           int                       `json:"id"`
+	Name         string                    `json:"name"`
+	Type         string                    `json:"type"`
+	Active       nullable.Nullable[bool]   `json:"active"`
+	LastState    nullable.Nullable[string] `json:"last_state"`
+	LastEvalDttm nullable.Nullable[string] `json:"last_eval_dttm"`
+}
+
+// ListReportScheduleStates lists the execution states of the alerts or reports of the given
+// scheduleType.
+func (cw *ClientWrapper) ListReportScheduleStates(ctx context.Context, scheduleType string) ([]ReportScheduleState, error) {
+	pageNumber := 0
+	var allStates []ReportScheduleState
+	for {
+		states, err := cw._ListReportScheduleStates(ctx, scheduleType, pageNumber)
+		if err != nil {
+			return nil, err
+		}
+		allStates = append(allStates, states...)
+		if len(states) < cw.pageSize {
+			break
+		}
+		pageNumber++
+	}
+	return allStates, nil
+}
+
+func (cw *ClientWrapper) _ListReportScheduleStates(ctx context.Context, scheduleType string, pageNumber int) ([]ReportScheduleState, error) {
+	var v GetListSchema_Filters_Value
+	if err := v.FromGetListSchemaFiltersValue1(scheduleType); err != nil {
+		return nil, err
+	}
+
+	res, err := cw.GetApiV1Report(ctx, &GetApiV1ReportParams{
+		Q: GetListSchema{
+			Columns: []string{"id", "name", "type", "active", "last_state", "last_eval_dttm"},
+			Filters: []struct {
+				Col   string                      `json:"col"`
+				Opr   string                      `json:"opr"`
+				Value GetListSchema_Filters_Value `json:"value"`
+			}{
+				{Col: "type", Opr: "eq", Value: v},
+			},
+			OrderColumn:    "id",
+			OrderDirection: GetListSchemaOrderDirectionAsc,
+			Page:           pageNumber,
+			PageSize:       cw.pageSize,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to list report schedules, status code: %d, body: %s", res.StatusCode, string(body))
+	}
+
+	var list struct {
+		Result []ReportScheduleState `json:"result"`
+	}
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse report schedule list response: %w", err)
+	}
+
+	return list.Result, nil
+}
+
+// CreateReportSchedule creates a new alert or report.
+func (cw *ClientWrapper) CreateReportSchedule(ctx context.Context, report ReportScheduleRequest) (*ReportSchedule, error) {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody, err := json.Marshal(report)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cw.PostApiV1ReportWithBody(ctx, "application/json", bytes.NewReader(reqBody), reqEditor)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("failed to create report schedule, status code: %d, body: %s", res.StatusCode, string(body))
+	}
+
+	var created struct {
+		Id int `json:"id"`
+	}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse create report schedule response: %w", err)
+	}
+
+	return cw.GetReportSchedule(ctx, created.Id)
+}
+
+// GetReportSchedule retrieves the alert or report with the given reportID.
+func (cw *ClientWrapper) GetReportSchedule(ctx context.Context, reportID int) (*ReportSchedule, error) {
+	res, err := cw.GetApiV1ReportPk(ctx, reportID, &GetApiV1ReportPkParams{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "ReportSchedule", ID: reportID}
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get report schedule, status code: %d, body: %s", res.StatusCode, string(body))
+	}
+
+	var parsed struct {
+		Result ReportSchedule `json:"result"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse report schedule response: %w", err)
+	}
+
+	return &parsed.Result, nil
+}
+
+// UpdateReportSchedule updates the alert or report with the given reportID.
+func (cw *ClientWrapper) UpdateReportSchedule(ctx context.Context, reportID int, report ReportScheduleRequest) (*ReportSchedule, error) {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody, err := json.Marshal(report)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cw.PutApiV1ReportPkWithBody(ctx, reportID, "application/json", bytes.NewReader(reqBody), reqEditor)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "ReportSchedule", ID: reportID}
+	}
+	if res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return nil, fmt.Errorf("failed to update report schedule, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+
+	return cw.GetReportSchedule(ctx, reportID)
+}
+
+// DeleteReportSchedule deletes the alert or report with the given reportID.
+func (cw *ClientWrapper) DeleteReportSchedule(ctx context.Context, reportID int) error {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return err
+	}
+	res, err := cw.DeleteApiV1ReportPk(ctx, reportID, reqEditor)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return &NotFoundError{Resource: "ReportSchedule", ID: reportID}
+	}
+	if res.StatusCode != http.StatusOK {
+		defer func() { res.Body.Close() }()
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return fmt.Errorf("failed to delete report schedule, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+	return nil
+}
+
+// CreateAnnotationLayer creates a new annotation layer.
+func (cw *ClientWrapper) CreateAnnotationLayer(ctx context.Context, layer AnnotationLayerRestApiPost) (*AnnotationLayerRestApiGet, error) {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cw.PostApiV1AnnotationLayer(ctx, layer, reqEditor)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("failed to create annotation layer, status code: %d, body: %s", res.StatusCode, string(body))
+	}
+
+	var created struct {
+		Id int `json:"id"`
+	}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse create annotation layer response: %w", err)
+	}
+
+	return cw.GetAnnotationLayer(ctx, created.Id)
+}
+
+// GetAnnotationLayer retrieves the annotation layer with the given layerID.
+func (cw *ClientWrapper) GetAnnotationLayer(ctx context.Context, layerID int) (*AnnotationLayerRestApiGet, error) {
+	res, err := cw.GetApiV1AnnotationLayerPk(ctx, layerID, &GetApiV1AnnotationLayerPkParams{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "AnnotationLayer", ID: layerID}
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get annotation layer, status code: %d, body: %s", res.StatusCode, string(body))
+	}
+
+	var parsed struct {
+		Result AnnotationLayerRestApiGet `json:"result"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse annotation layer response: %w", err)
+	}
+	if parsed.Result.Id == 0 {
+		parsed.Result.Id = layerID
+	}
+
+	return &parsed.Result, nil
+}
+
+// UpdateAnnotationLayer updates the annotation layer with the given layerID. The generated
+// AnnotationLayerRestApiPut omits an empty description, so the body is encoded from the post schema
+// to be able to clear it.
+func (cw *ClientWrapper) UpdateAnnotationLayer(ctx context.Context, layerID int, layer AnnotationLayerRestApiPost) (*AnnotationLayerRestApiGet, error) {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody, err := json.Marshal(layer)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cw.PutApiV1AnnotationLayerPkWithBody(ctx, layerID, "application/json", bytes.NewReader(reqBody), reqEditor)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "AnnotationLayer", ID: layerID}
+	}
+	if res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return nil, fmt.Errorf("failed to update annotation layer, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+
+	return cw.GetAnnotationLayer(ctx, layerID)
+}
+
+// DeleteAnnotationLayer deletes the annotation layer with the given layerID.
+func (cw *ClientWrapper) DeleteAnnotationLayer(ctx context.Context, layerID int) error {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return err
+	}
+	res, err := cw.DeleteApiV1AnnotationLayerPk(ctx, layerID, reqEditor)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return &NotFoundError{Resource: "AnnotationLayer", ID: layerID}
+	}
+	if res.StatusCode != http.StatusOK {
+		defer func() { res.Body.Close() }()
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return fmt.Errorf("failed to delete annotation layer, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+	return nil
+}
+
+// CreateAnnotation creates a new annotation in the annotation layer with the given layerID.
+func (cw *ClientWrapper) CreateAnnotation(ctx context.Context, layerID int, annotation AnnotationRestApiPost) (*AnnotationRestApiGet, error) {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cw.PostApiV1AnnotationLayerPkAnnotation(ctx, layerID, annotation, reqEditor)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "AnnotationLayer", ID: layerID}
+	}
+	if res.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("failed to create annotation, status code: %d, body: %s", res.StatusCode, string(body))
+	}
+
+	var created struct {
+		Id int `json:"id"`
+	}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse create annotation response: %w", err)
+	}
+
+	return cw.GetAnnotation(ctx, layerID, created.Id)
+}
+
+// GetAnnotation retrieves the annotation with the given annotationID of the annotation layer with
+// the given layerID.
+func (cw *ClientWrapper) GetAnnotation(ctx context.Context, layerID int, annotationID int) (*AnnotationRestApiGet, error) {
+	res, err := cw.GetApiV1AnnotationLayerPkAnnotationAnnotationId(ctx, layerID, annotationID, &GetApiV1AnnotationLayerPkAnnotationAnnotationIdParams{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "Annotation", ID: annotationID}
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get annotation, status code: %d, body: %s", res.StatusCode, string(body))
+	}
+
+	var parsed struct {
+		Result AnnotationRestApiGet `json:"result"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse annotation response: %w", err)
+	}
+	if parsed.Result.Id == 0 {
+		parsed.Result.Id = annotationID
+	}
+	if parsed.Result.Layer.Id == 0 {
+		parsed.Result.Layer.Id = layerID
+	}
+
+	return &parsed.Result, nil
+}
+
+// UpdateAnnotation updates the annotation with the given annotationID of the annotation layer with
+// the given layerID.
+func (cw *ClientWrapper) UpdateAnnotation(ctx context.Context, layerID int, annotationID int, annotation AnnotationRestApiPut) (*AnnotationRestApiGet, error) {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cw.PutApiV1AnnotationLayerPkAnnotationAnnotationId(ctx, layerID, annotationID, annotation, reqEditor)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { res.Body.Close() }()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "Annotation", ID: annotationID}
+	}
+	if res.StatusCode != http.StatusOK {
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return nil, fmt.Errorf("failed to update annotation, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+
+	return cw.GetAnnotation(ctx, layerID, annotationID)
+}
+
+// DeleteAnnotation deletes the annotation with the given annotationID of the annotation layer with
+// the given layerID.
+func (cw *ClientWrapper) DeleteAnnotation(ctx context.Context, layerID int, annotationID int) error {
+	reqEditor, err := cw.createCsrfTokenRequestEditor()
+	if err != nil {
+		return err
+	}
+	res, err := cw.DeleteApiV1AnnotationLayerPkAnnotationAnnotationId(ctx, layerID, annotationID, reqEditor)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return &NotFoundError{Resource: "Annotation", ID: annotationID}
+	}
+	if res.StatusCode != http.StatusOK {
+		defer func() { res.Body.Close() }()
+		msg, err := io.ReadAll(res.Body)
+
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return fmt.Errorf("failed to delete annotation, status code: %d, body: %s", res.StatusCode, string(msg))
+	}
+	return nil
+}
+
+// ListCssTemplates retrieves the list of CSS templates.
+func (cw *ClientWrapper) ListCssTemplates(ctx context.Context) ([]CssTemplateRestApiGetList, error) {
+	pageNumber := 0
+	var allTemplates []CssTemplateRestApiGetList
+	for {
+		templates, err := cw._ListCssTemplates(ctx, pageNumber)
+		if err != nil {
+			return nil, err
+		}
+		allTemplates = append(allTemplates, templates...)
+		if len(templates) < cw.pageSize {
+			break
+		}
+		pageNumber++
+	}
+	return allTemplates, nil
+}
+
+func (cw *ClientWrapper) _ListCssTemplates(ctx context.Context, pageNumber int) ([]CssTemplateRestApiGetList, error) {
+	res, err := cw.GetApiV1CssTemplateWithResponse(ctx, &GetApiV1CssTemplateParams{
+		Q: GetListSchema{
+			Page:     pageNumber,
+			PageSize: cw.pageSize,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get css templates, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+
+	return res.JSON200.Result, nil
+}
+
+// FindCssTemplate finds a CSS template by template name.
+func (cw *ClientWrapper) FindCssTemplate(ctx context.Context, templateName string) (*CssTemplateRestApiGetList, error) {
+	return resolveName(ctx, cw.names, nameSpec[CssTemplateRestApiGetList]{
+		Resource: "CssTemplate",
+		CacheKey: "template_name",
+		Search: func(ctx context.Context, name string) ([]CssTemplateRestApiGetList, error) {
+			q, err := nameFilter("template_name", name)
+			if err != nil {
+				return nil, err
+			}
+
+			res, err := cw.GetApiV1CssTemplateWithResponse(ctx, &GetApiV1CssTemplateParams{Q: q})
+			if err != nil {
+				return nil, err
+			}
+
+			if res.StatusCode() != http.StatusOK {
+				return nil, fmt.Errorf("failed to find css template, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+			}
+			return res.JSON200.Result, nil
+		},
+		List: cw.ListCssTemplates,
+		Name: func(t CssTemplateRestApiGetList) string { return stringOrEmpty(t.TemplateName) },
+		Describe: func(t CssTemplateRestApiGetList) string {
+			return fmt.Sprintf("%s (id=%d)", stringOrEmpty(t.TemplateName), t.Id)
+		},
+	}, templateName)
+}
+
+// savedQueryColumns are the columns requested for saved queries. The tags are left out, as the server
+// returns a list where the schema declares a single tag.
+var savedQueryColumns = []string{"id", "label", "description", "sql", "schema", "catalog", "database.id", "database.database_name"}
+
+// ListSavedQueries retrieves the list of saved queries visible to the provider account.
+func (cw *ClientWrapper) ListSavedQueries(ctx context.Context) ([]SavedQueryRestApiGetList, error) {
+	pageNumber := 0
+	var allQueries []SavedQueryRestApiGetList
+	for {
+		queries, err := cw._ListSavedQueries(ctx, pageNumber)
+		if err != nil {
+			return nil, err
+		}
+		allQueries = append(allQueries, queries...)
+		if len(queries) < cw.pageSize {
+			break
+		}
+		pageNumber++
+	}
+	return allQueries, nil
+}
+
+func (cw *ClientWrapper) _ListSavedQueries(ctx context.Context, pageNumber int) ([]SavedQueryRestApiGetList, error) {
+	res, err := cw.GetApiV1SavedQueryWithResponse(ctx, &GetApiV1SavedQueryParams{
+		Q: GetListSchema{
+			Columns:  savedQueryColumns,
+			Page:     pageNumber,
+			PageSize: cw.pageSize,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get saved queries, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+	}
+
+	return res.JSON200.Result, nil
+}
+
+// FindSavedQuery finds a saved query by label. databaseName is optional and narrows down the match when
+// queries of several databases share the label.
+func (cw *ClientWrapper) FindSavedQuery(ctx context.Context, label string, databaseName string) (*SavedQueryRestApiGetList, error) {
+	return resolveName(ctx, cw.names, nameSpec[SavedQueryRestApiGetList]{
+		Resource: "SavedQuery",
+		CacheKey: "label/" + databaseName,
+		Search: func(ctx context.Context, name string) ([]SavedQueryRestApiGetList, error) {
+			q, err := nameFilter("label", name)
+			if err != nil {
+				return nil, err
+			}
+			q.Columns = savedQueryColumns
+			q.PageSize = cw.pageSize
+
+			res, err := cw.GetApiV1SavedQueryWithResponse(ctx, &GetApiV1SavedQueryParams{Q: q})
+			if err != nil {
+				return nil, err
+			}
+
+			if res.StatusCode() != http.StatusOK {
+				return nil, fmt.Errorf("failed to find saved query, status code: %d, body: %s", res.StatusCode(), string(res.Body))
+			}
+
+			var queries []SavedQueryRestApiGetList
+			for _, sq := range res.JSON200.Result {
+				if databaseName == "" || sq.Database.DatabaseName == databaseName {
+					queries = append(queries, sq)
+				}
+			}
+			return queries, nil
+		},
+		List: cw.ListSavedQueries,
+		Name: func(sq SavedQueryRestApiGetList) string { return stringOrEmpty(sq.Label) },
+		Describe: func(sq SavedQueryRestApiGetList) string {
+			return fmt.Sprintf("%s on %s (id=%d)", stringOrEmpty(sq.Label), sq.Database.DatabaseName, sq.Id)
+		},
+	}, label)
+}
@@ -0,0 +1,38 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"net/http"
+)
+
+// concurrencyLimitTransport caps the number of requests in flight to the server, independently of how
+// many resources Terraform operates on in parallel. A slot is held until the response headers arrive,
+// which is when the server side worker has finished processing the request.
+type concurrencyLimitTransport struct {
+	base http.RoundTripper
+	sem  chan struct{}
+}
+
+func newConcurrencyLimitTransport(base http.RoundTripper, maxConcurrentRequests int) http.RoundTripper {
+	if maxConcurrentRequests <= 0 {
+		return base
+	}
+
+	return &concurrencyLimitTransport{base: base, sem: make(chan struct{}, maxConcurrentRequests)}
+}
+
+func (t *concurrencyLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.sem <- struct{}{}:
+	case <-req.Context().Done():
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, req.Context().Err()
+	}
+	defer func() { <-t.sem }()
+
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,80 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/oapi-codegen/nullable"
+)
+
+// Object kinds used to key the modification markers recorded by writeTracker.
+const (
+	ObjectKindDataset         = "dataset"
+	ObjectKindUser            = "user"
+	ObjectKindRolePermissions = "role_permissions"
+)
+
+// writeTracker remembers the changed_on markers produced by this client's own writes,
+// so that modifications made by the provider itself are not reported as external changes.
+type writeTracker struct {
+	mu     sync.Mutex
+	writes map[string]string
+}
+
+func newWriteTracker() *writeTracker {
+	return &writeTracker{writes: make(map[string]string)}
+}
+
+func (t *writeTracker) record(kind string, id int, changedOn string) {
+	if changedOn == "" {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.writes[fmt.Sprintf("%s/%d", kind, id)] = changedOn
+}
+
+func (t *writeTracker) isOwnWrite(kind string, id int, changedOn string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	v, ok := t.writes[fmt.Sprintf("%s/%d", kind, id)]
+	return ok && v == changedOn
+}
+
+// PermissionsFingerprint returns a stable token for a set of permission IDs. Roles have no
+// changed_on column, so the fingerprint serves as their modification marker.
+func PermissionsFingerprint(permissionIds []int) string {
+	ids := slices.Clone(permissionIds)
+	slices.Sort(ids)
+
+	h := sha256.New()
+	for _, id := range ids {
+		h.Write([]byte(strconv.Itoa(id)))
+		h.Write([]byte{','})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// IsOwnWrite reports whether changedOn is the modification marker of the latest write this client
+// made to the given object.
+func (cw *ClientWrapper) IsOwnWrite(kind string, id int, changedOn string) bool {
+	return cw.writes.isOwnWrite(kind, id, changedOn)
+}
+
+// FailOnConflict reports whether external modifications detected during an update should be errors.
+func (cw *ClientWrapper) FailOnConflict() bool {
+	return cw.failOnConflict
+}
+
+func stringOrEmpty(v nullable.Nullable[string]) string {
+	s, _ := v.Get()
+	return s
+}
@@ -0,0 +1,61 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestChangeRolePermissionsRereads(t *testing.T) {
+	// The role gained permission 3 outside Terraform after current was read.
+	var written []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v1/security/roles/5/permissions/":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"result": [{"id": 1}, {"id": 2}, {"id": 3}]}`))
+		case "POST /api/v1/security/roles/5/permissions":
+			var body RolePermissionPostSchema
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+			written = body.PermissionViewMenuIds
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	cw, err := NewClientWrapper(ctx, server.URL, ClientCredentials{AccessToken: "token"})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if err := cw.ChangeRolePermissions(ctx, 5, []int{1, 2}, []int{4}, []int{1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []int{2, 3, 4}; !reflect.DeepEqual(written, want) {
+		t.Errorf("expected the changes to be applied to the permissions read again, %v, got %v", want, written)
+	}
+
+	cw, err = NewClientWrapper(ctx, server.URL, ClientCredentials{AccessToken: "token"}, WithFailOnConflict(true))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	written = nil
+	if err := cw.ChangeRolePermissions(ctx, 5, []int{1, 2}, []int{4}, []int{1}); err == nil {
+		t.Error("expected an error for permissions modified with fail on conflict")
+	}
+	if written != nil {
+		t.Errorf("expected nothing to be written, got %v", written)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxHTMLErrorBody is the number of bytes of an HTML error page read to summarize it.
+	maxHTMLErrorBody = 64 << 10
+	// maxHTMLErrorSummary is the maximum length of the summary of an HTML error page.
+	maxHTMLErrorSummary = 200
+)
+
+var (
+	htmlTitlePattern  = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	htmlIgnorePattern = regexp.MustCompile(`(?is)<(script|style)[^>]*>.*?</(script|style)>`)
+	htmlTagPattern    = regexp.MustCompile(`(?s)<[^>]*>`)
+)
+
+// htmlErrorTransport replaces the HTML error pages of reverse proxies and load balancers in front of the
+// server, such as the 502 and 504 pages of nginx or a cloud load balancer, with a short plain text summary,
+// so that error messages quoting the response body do not dump the whole page.
+type htmlErrorTransport struct {
+	base http.RoundTripper
+}
+
+func (t *htmlErrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.base.RoundTrip(req)
+	if err != nil || res.StatusCode < http.StatusBadRequest || !isHTMLResponse(res) {
+		return res, err
+	}
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxHTMLErrorBody))
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := htmlErrorMessage(res.StatusCode, body)
+	res.Body = io.NopCloser(strings.NewReader(msg))
+	res.ContentLength = int64(len(msg))
+	res.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	res.Header.Set("Content-Length", strconv.Itoa(len(msg)))
+	return res, nil
+}
+
+// isHTMLResponse reports whether res is an HTML page rather than an API response.
+func isHTMLResponse(res *http.Response) bool {
+	mediaType, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	return mediaType == "text/html" || mediaType == "application/xhtml+xml"
+}
+
+// htmlErrorMessage returns the message replacing the HTML error page body of a response with status.
+func htmlErrorMessage(status int, body []byte) string {
+	summary := htmlSummary(body)
+	if summary == "" {
+		summary = http.StatusText(status)
+	}
+
+	switch status {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return fmt.Sprintf("gateway error from proxy (%s): the proxy in front of Superset got no valid response from it in time. "+
+			"This is usually temporary, e.g. while Superset restarts or is overloaded; retry the apply, or set max_retries in the provider configuration to retry automatically", summary)
+	default:
+		return fmt.Sprintf("HTML page instead of an API response (%s), likely from a proxy in front of Superset; check the proxy and the Superset URL", summary)
+	}
+}
+
+// htmlSummary returns the title of an HTML page, or else its text, as a single truncated line.
+func htmlSummary(body []byte) string {
+	text := body
+	if m := htmlTitlePattern.FindSubmatch(body); m != nil {
+		text = m[1]
+	} else {
+		text = htmlIgnorePattern.ReplaceAll(text, nil)
+	}
+	text = htmlTagPattern.ReplaceAll(text, []byte(" "))
+
+	summary := strings.Join(strings.Fields(html.UnescapeString(string(bytes.TrimSpace(text)))), " ")
+	if runes := []rune(summary); len(runes) > maxHTMLErrorSummary {
+		summary = string(runes[:maxHTMLErrorSummary]) + "..."
+	}
+	return summary
+}
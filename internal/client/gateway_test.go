@@ -0,0 +1,72 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestHTMLErrorTransport(t *testing.T) {
+	var status int
+	var contentType, body string
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		header := http.Header{}
+		header.Set("Content-Type", contentType)
+		return &http.Response{StatusCode: status, Header: header, Body: io.NopCloser(strings.NewReader(body))}, nil
+	})
+	transport := &htmlErrorTransport{base: base}
+
+	cases := []struct {
+		status      int
+		contentType string
+		body        string
+		want        string
+	}{
+		{
+			http.StatusBadGateway, "text/html",
+			"<html><head><title>502 Bad Gateway</title></head><body><center><h1>502 Bad Gateway</h1></center><hr><center>nginx</center></body></html>",
+			"gateway error from proxy (502 Bad Gateway): ",
+		},
+		{
+			http.StatusGatewayTimeout, "text/html; charset=utf-8",
+			"<html><body><script>var x = 1;</script><p>Upstream  request\n timeout &amp; retry</p></body></html>",
+			"gateway error from proxy (Upstream request timeout & retry): ",
+		},
+		{
+			http.StatusForbidden, "text/html", "<html></html>",
+			"HTML page instead of an API response (Forbidden), ",
+		},
+		{
+			http.StatusBadGateway, "application/json", `{"message":"error"}`,
+			`{"message":"error"}`,
+		},
+		{
+			http.StatusOK, "text/html", "<html><title>Superset</title></html>",
+			"<html><title>Superset</title></html>",
+		},
+	}
+
+	for _, c := range cases {
+		status, contentType, body = c.status, c.contentType, c.body
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost/api/v1/dashboard/1", nil)
+		res, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got, _ := io.ReadAll(res.Body)
+		if !strings.HasPrefix(string(got), c.want) {
+			t.Errorf("body of %d %s response = %q, want prefix %q", c.status, c.contentType, string(got), c.want)
+		}
+	}
+}
+
+func TestHTMLSummaryTruncates(t *testing.T) {
+	summary := htmlSummary([]byte("<p>" + strings.Repeat("a", 500) + "</p>"))
+	if len(summary) != maxHTMLErrorSummary+len("...") {
+		t.Errorf("expected the summary to be truncated, got %d characters", len(summary))
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"encoding/base64"
+	"net/http"
+)
+
+// DefaultIngressAuthHeader is the header the credentials of an ingress in front of Superset are sent in,
+// as Superset authenticates the API with the Authorization header.
+const DefaultIngressAuthHeader = "Proxy-Authorization"
+
+// IngressAuth holds the credentials of an ingress in front of Superset requiring its own authentication,
+// e.g. an oauth2-proxy or an identity-aware proxy. Only one kind of credentials is expected to be set.
+type IngressAuth struct {
+	// BearerToken is sent as a bearer token in Header.
+	BearerToken string
+	// Username and Password are sent as basic authentication credentials in Header.
+	Username string
+	Password string
+	// Cookies are sent with every request, keyed by cookie name, e.g. the session cookie of an oauth2-proxy.
+	Cookies map[string]string
+	// Header is the header of the bearer token or the basic authentication credentials. Empty uses
+	// DefaultIngressAuthHeader.
+	Header string
+}
+
+func (auth IngressAuth) empty() bool {
+	return auth.BearerToken == "" && auth.Username == "" && len(auth.Cookies) == 0
+}
+
+// ingressAuthTransport adds the credentials of the ingress to every request, including the login, CSRF
+// token and bootstrap page requests, which Superset itself does not authenticate.
+type ingressAuthTransport struct {
+	base        http.RoundTripper
+	header      string
+	headerValue string
+	cookies     map[string]string
+}
+
+func newIngressAuthTransport(base http.RoundTripper, opts *ClientOptions) http.RoundTripper {
+	auth := opts.IngressAuth
+	if auth.empty() {
+		return base
+	}
+
+	t := &ingressAuthTransport{base: base, header: auth.Header, cookies: auth.Cookies}
+	if t.header == "" {
+		t.header = DefaultIngressAuthHeader
+	}
+	switch {
+	case auth.BearerToken != "":
+		t.headerValue = "Bearer " + auth.BearerToken
+	case auth.Username != "":
+		t.headerValue = "Basic " + base64.StdEncoding.EncodeToString([]byte(auth.Username+":"+auth.Password))
+	}
+	return t
+}
+
+func (t *ingressAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it is given.
+	req = req.Clone(req.Context())
+	if t.headerValue != "" {
+		req.Header.Set(t.header, t.headerValue)
+	}
+	for name, value := range t.cookies {
+		req.AddCookie(&http.Cookie{Name: name, Value: value})
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,49 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestIngressAuthTransport(t *testing.T) {
+	var got *http.Request
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		got = req
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+
+	transport := newIngressAuthTransport(base, &ClientOptions{IngressAuth: IngressAuth{BearerToken: "token"}})
+	req, _ := http.NewRequest(http.MethodPost, "http://localhost/api/v1/security/login", nil)
+	req.Header.Set("Authorization", "Bearer superset")
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Header.Get("Proxy-Authorization") != "Bearer token" || got.Header.Get("Authorization") != "Bearer superset" {
+		t.Errorf("expected the token of the ingress next to the token of Superset, got %v", got.Header)
+	}
+	if req.Header.Get("Proxy-Authorization") != "" {
+		t.Error("expected the original request to be left unmodified")
+	}
+
+	transport = newIngressAuthTransport(base, &ClientOptions{IngressAuth: IngressAuth{Username: "terraform", Password: "secret", Header: "X-Ingress-Authorization"}})
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user, password, ok := (&http.Request{Header: http.Header{"Authorization": got.Header.Values("X-Ingress-Authorization")}}).BasicAuth(); !ok || user != "terraform" || password != "secret" {
+		t.Errorf("expected basic authentication credentials, got %v", got.Header)
+	}
+
+	transport = newIngressAuthTransport(base, &ClientOptions{IngressAuth: IngressAuth{Cookies: map[string]string{"_oauth2_proxy": "session"}}})
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c, err := got.Cookie("_oauth2_proxy"); err != nil || c.Value != "session" {
+		t.Errorf("expected the session cookie of the ingress, got %v", got.Header)
+	}
+	if got.Header.Get("Proxy-Authorization") != "" {
+		t.Errorf("expected no credentials header with cookies, got %v", got.Header)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestKeyedMutexSerializesSameKey(t *testing.T) {
+	var m keyedMutex
+	var mu sync.Mutex
+	active, maxActive := 0, 0
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock, err := m.lock(context.Background(), "dataset/1")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer unlock()
+
+			mu.Lock()
+			active++
+			maxActive = max(maxActive, active)
+			mu.Unlock()
+			time.Sleep(time.Millisecond)
+			mu.Lock()
+			active--
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if maxActive != 1 {
+		t.Errorf("%d operations held the lock at the same time, want 1", maxActive)
+	}
+	if len(m.locks) != 0 {
+		t.Errorf("%d unused locks are kept, want 0", len(m.locks))
+	}
+}
+
+func TestKeyedMutexOtherKeyAndTimeout(t *testing.T) {
+	var m keyedMutex
+	unlock, err := m.lock(context.Background(), "dataset/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := m.lock(context.Background(), "dataset/2")
+	if err != nil {
+		t.Fatalf("locking another key failed: %s", err)
+	}
+	other()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := m.lock(ctx, "dataset/1"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("lock() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	unlock()
+	unlock()
+	if len(m.locks) != 0 {
+		t.Errorf("%d unused locks are kept, want 0", len(m.locks))
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// DefaultLookupCacheTTL is the default time the role, group, permission and user lists are cached.
+const DefaultLookupCacheTTL = 5 * time.Minute
+
+// lookupCache keeps the role, group, permission and user lists for a while, so that resources resolving
+// roles, groups or permissions by name, or usernames by ID, do not list all of them once per operation,
+// which is slow on servers with thousands of permissions. Lists expire after ttl, or never in bulk mode.
+// Any role, group or user write invalidates the cache, and so does any write that creates or renames
+// permissions, such as a database or dataset write.
+type lookupCache struct {
+	ttl         time.Duration
+	bulk        bool
+	now         func() time.Time
+	mu          sync.Mutex
+	roles       cachedList[SupersetRoleApiGetList]
+	groups      cachedList[SupersetGroupApiGetList]
+	permissions cachedList[SupersetPermissionApiGetList]
+	users       cachedList[SupersetUserApiGetList]
+}
+
+// cachedList is a list of a lookupCache, which is not cached while values is nil.
+type cachedList[T any] struct {
+	values  []T
+	expires time.Time
+}
+
+// newLookupCache returns a cache of lists kept for ttl, disabled when ttl is zero unless in bulk mode.
+func newLookupCache(ttl time.Duration, bulk bool) *lookupCache {
+	return &lookupCache{ttl: ttl, bulk: bulk, now: time.Now}
+}
+
+func (c *lookupCache) enabled() bool {
+	return c.bulk || c.ttl > 0
+}
+
+func cachedValues[T any](c *lookupCache, l *cachedList[T]) ([]T, bool) {
+	if !c.enabled() {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if l.values == nil || (!c.bulk && !c.now().Before(l.expires)) {
+		return nil, false
+	}
+	return slices.Clone(l.values), true
+}
+
+func storeValues[T any](c *lookupCache, l *cachedList[T], values []T) {
+	if !c.enabled() {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	l.values = slices.Clone(values)
+	if l.values == nil {
+		l.values = []T{}
+	}
+	l.expires = c.now().Add(c.ttl)
+}
+
+func (c *lookupCache) cachedRoles() ([]SupersetRoleApiGetList, bool) {
+	return cachedValues(c, &c.roles)
+}
+
+func (c *lookupCache) storeRoles(roles []SupersetRoleApiGetList) {
+	storeValues(c, &c.roles, roles)
+}
+
+func (c *lookupCache) cachedGroups() ([]SupersetGroupApiGetList, bool) {
+	return cachedValues(c, &c.groups)
+}
+
+func (c *lookupCache) storeGroups(groups []SupersetGroupApiGetList) {
+	storeValues(c, &c.groups, groups)
+}
+
+func (c *lookupCache) cachedPermissions() ([]SupersetPermissionApiGetList, bool) {
+	return cachedValues(c, &c.permissions)
+}
+
+func (c *lookupCache) storePermissions(permissions []SupersetPermissionApiGetList) {
+	storeValues(c, &c.permissions, permissions)
+}
+
+func (c *lookupCache) cachedUsers() ([]SupersetUserApiGetList, bool) {
+	return cachedValues(c, &c.users)
+}
+
+func (c *lookupCache) storeUsers(users []SupersetUserApiGetList) {
+	storeValues(c, &c.users, users)
+}
+
+func (c *lookupCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.roles = cachedList[SupersetRoleApiGetList]{}
+	c.groups = cachedList[SupersetGroupApiGetList]{}
+	c.permissions = cachedList[SupersetPermissionApiGetList]{}
+	c.users = cachedList[SupersetUserApiGetList]{}
+}
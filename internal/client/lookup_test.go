@@ -0,0 +1,53 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLookupCache(t *testing.T) {
+	now := time.Now()
+	c := newLookupCache(time.Minute, false)
+	c.now = func() time.Time { return now }
+
+	if _, ok := c.cachedRoles(); ok {
+		t.Fatal("expected no cached roles before storing them")
+	}
+	c.storeRoles([]SupersetRoleApiGetList{{Id: 1, Name: "Admin"}})
+	c.storePermissions(nil)
+
+	if roles, ok := c.cachedRoles(); !ok || len(roles) != 1 {
+		t.Fatalf("expected the stored roles, got %v, %v", roles, ok)
+	}
+	if permissions, ok := c.cachedPermissions(); !ok || len(permissions) != 0 {
+		t.Fatalf("expected an empty list of permissions to be cached, got %v, %v", permissions, ok)
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := c.cachedRoles(); ok {
+		t.Error("expected the roles to expire after the TTL")
+	}
+
+	c.storeGroups([]SupersetGroupApiGetList{{Id: 1}})
+	c.invalidate()
+	if _, ok := c.cachedGroups(); ok {
+		t.Error("expected the groups to be invalidated")
+	}
+
+	disabled := newLookupCache(0, false)
+	disabled.storeRoles([]SupersetRoleApiGetList{{Id: 1}})
+	if _, ok := disabled.cachedRoles(); ok {
+		t.Error("expected no caching with a zero TTL")
+	}
+
+	bulk := newLookupCache(0, true)
+	bulk.now = func() time.Time { return now }
+	bulk.storeRoles([]SupersetRoleApiGetList{{Id: 1}})
+	now = now.Add(time.Hour)
+	if _, ok := bulk.cachedRoles(); !ok {
+		t.Error("expected the roles to be cached without expiry in bulk mode")
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultPageConcurrency is the default number of pages of a list fetched at the same time.
+const DefaultPageConcurrency = 4
+
+// pageFetcher fetches a page, counted from 0, of a list, and returns its items and the number of items
+// of the whole list.
+type pageFetcher[T any] func(ctx context.Context, pageNumber int) ([]T, int, error)
+
+// listPages fetches all items of a list. The first page tells how many pages there are, and the others
+// are then fetched with up to concurrency requests at the same time. The page size is the one of the
+// first page, since the server caps the requested size, to 100 by default, and numbers the pages in
+// the capped size. Pages are fetched until one is not full, so that items added meanwhile are listed.
+func listPages[T any](ctx context.Context, p *progress, pageSize int, concurrency int, fetch pageFetcher[T]) ([]T, error) {
+	first, count, err := fetch(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	p.page(0, pageSize, count)
+	if len(first) == 0 || (len(first) < pageSize && count <= len(first)) {
+		return first, nil
+	}
+
+	size := len(first)
+	pages := max((count+size-1)/size, 1)
+	results := make([][]T, pages)
+	results[0] = first
+	if err := fetchPages(ctx, p, size, count, concurrency, fetch, results); err != nil {
+		return nil, err
+	}
+
+	// Pages of items added since the count was taken.
+	for pageNumber := pages; len(results[len(results)-1]) >= size; pageNumber++ {
+		items, _, err := fetch(ctx, pageNumber)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, items)
+	}
+
+	all := make([]T, 0, count)
+	for _, items := range results {
+		all = append(all, items...)
+	}
+	return all, nil
+}
+
+// fetchPages fetches the pages from 1 of results into it, with up to concurrency requests at the same
+// time, and returns the first error.
+func fetchPages[T any](ctx context.Context, p *progress, size int, count int, concurrency int, fetch pageFetcher[T], results [][]T) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	slots := make(chan struct{}, max(concurrency, 1))
+	for pageNumber := 1; pageNumber < len(results); pageNumber++ {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			items, _, err := fetch(ctx, pageNumber)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			results[pageNumber] = items
+			p.page(pageNumber, size, count)
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,76 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+)
+
+// fakePages returns a fetcher of a list of count items with pages capped to maxSize items, like the
+// server, and with added items that only show up after the count.
+func fakePages(count int, added int, maxSize int, requests *atomic.Int32) pageFetcher[int] {
+	return func(ctx context.Context, pageNumber int) ([]int, int, error) {
+		requests.Add(1)
+		var items []int
+		for i := pageNumber * maxSize; i < min((pageNumber+1)*maxSize, count+added); i++ {
+			items = append(items, i)
+		}
+		return items, count, nil
+	}
+}
+
+func TestListPages(t *testing.T) {
+	cases := []struct {
+		name         string
+		count        int
+		added        int
+		pageSize     int
+		maxSize      int
+		wantRequests int32
+	}{
+		{"single page", 5, 0, 10, 100, 1},
+		{"empty", 0, 0, 10, 100, 1},
+		{"pages", 25, 0, 10, 100, 3},
+		{"exact pages", 30, 0, 10, 100, 4},
+		{"capped page size", 250, 0, 4096, 100, 3},
+		{"added items", 25, 10, 10, 100, 4},
+	}
+
+	for _, c := range cases {
+		var requests atomic.Int32
+		items, err := listPages(context.Background(), newProgress(context.Background(), "test"), c.pageSize, 3, fakePages(c.count, c.added, min(c.pageSize, c.maxSize), &requests))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if len(items) != c.count+c.added {
+			t.Errorf("%s: expected %d items, got %d", c.name, c.count+c.added, len(items))
+		}
+		for i, v := range items {
+			if v != i {
+				t.Errorf("%s: expected the items in page order, got %d at %d", c.name, v, i)
+				break
+			}
+		}
+		if requests.Load() != c.wantRequests {
+			t.Errorf("%s: expected %d requests, got %d", c.name, c.wantRequests, requests.Load())
+		}
+	}
+}
+
+func TestListPagesError(t *testing.T) {
+	failure := errors.New("failure")
+	fetch := func(ctx context.Context, pageNumber int) ([]int, int, error) {
+		if pageNumber == 2 {
+			return nil, 0, failure
+		}
+		return make([]int, 10), 100, nil
+	}
+
+	if _, err := listPages(context.Background(), newProgress(context.Background(), "test"), 10, 4, fetch); !errors.Is(err, failure) {
+		t.Fatalf("expected the error of the failed page, got %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// progressInterval is the minimum time between two progress messages of an operation, so that a slow
+// operation shows it is alive without flooding the log, and a fast one logs nothing.
+const progressInterval = 5 * time.Second
+
+// progress logs the progress of a long operation, such as a scan of a large list or an export.
+type progress struct {
+	ctx       context.Context
+	operation string
+	start     time.Time
+	last      time.Time
+	now       func() time.Time
+}
+
+func newProgress(ctx context.Context, operation string) *progress {
+	now := time.Now()
+	return &progress{ctx: ctx, operation: operation, start: now, last: now, now: time.Now}
+}
+
+// log logs msg when progressInterval passed since the previous message.
+func (p *progress) log(msg string, fields map[string]interface{}) {
+	now := p.now()
+	if now.Sub(p.last) < progressInterval {
+		return
+	}
+	p.last = now
+
+	fields["operation"] = p.operation
+	fields["elapsed"] = now.Sub(p.start).Round(time.Second).String()
+	tflog.Info(p.ctx, fmt.Sprintf("%s: %s", p.operation, msg), fields)
+}
+
+// page logs that page, counted from 0, of a list of count items was fetched.
+func (p *progress) page(pageNumber int, pageSize int, count int) {
+	pages := 1
+	if pageSize > 0 && count > pageSize {
+		pages = (count + pageSize - 1) / pageSize
+	}
+	if pageNumber >= pages {
+		// The empty page that ends some scans.
+		return
+	}
+	p.log(fmt.Sprintf("page %d of %d", pageNumber+1, pages), map[string]interface{}{"items": count})
+}
+
+// waitForServer logs a message every progressInterval until the returned function is called, for
+// operations made of a single slow request, such as an import.
+func (p *progress) waitForServer(fields map[string]interface{}) func() {
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(progressInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				copied := make(map[string]interface{}, len(fields))
+				for k, v := range fields {
+					copied[k] = v
+				}
+				p.log("waiting for the server", copied)
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return func() {
+		close(stop)
+		<-stopped
+		p.done()
+	}
+}
+
+// done logs the end of the operation, when it took long enough for progress to be logged.
+func (p *progress) done() {
+	if p.now().Sub(p.start) < progressInterval {
+		return
+	}
+	tflog.Info(p.ctx, fmt.Sprintf("%s: done", p.operation), map[string]interface{}{
+		"operation": p.operation,
+		"elapsed":   p.now().Sub(p.start).Round(time.Second).String(),
+	})
+}
+
+// progressWriter logs the number of bytes written through it, e.g. while an export is downloaded.
+type progressWriter struct {
+	w        io.Writer
+	progress *progress
+	written  int64
+}
+
+func (w *progressWriter) Write(b []byte) (int, error) {
+	n, err := w.w.Write(b)
+	w.written += int64(n)
+	w.progress.log(fmt.Sprintf("%d bytes transferred", w.written), map[string]interface{}{"bytes": w.written})
+	return n, err
+}
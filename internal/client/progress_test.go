@@ -0,0 +1,28 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestProgressThrottle(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := newProgress(context.Background(), "Listing permissions")
+	p.start, p.last = now, now
+	p.now = func() time.Time { return now }
+
+	p.page(0, 100, 1000)
+	if !p.last.Equal(now) {
+		t.Error("expected no progress message before the interval passed")
+	}
+
+	now = now.Add(progressInterval)
+	p.page(1, 100, 1000)
+	if !p.last.Equal(now) {
+		t.Error("expected a progress message once the interval passed")
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// newProxyTransport returns base, or a copy of it sending requests through the proxies of opts. Proxies
+// that are not set in opts fall back to the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables, as
+// with the default transport. Requests to localhost are never proxied.
+func newProxyTransport(base http.RoundTripper, opts *ClientOptions) (http.RoundTripper, error) {
+	if opts.HTTPProxy == "" && opts.HTTPSProxy == "" && opts.NoProxy == "" {
+		return base, nil
+	}
+
+	for _, proxy := range []string{opts.HTTPProxy, opts.HTTPSProxy} {
+		if proxy == "" {
+			continue
+		}
+		if _, err := url.Parse(proxy); err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+	}
+
+	config := httpproxy.FromEnvironment()
+	if opts.HTTPProxy != "" {
+		config.HTTPProxy = opts.HTTPProxy
+	}
+	if opts.HTTPSProxy != "" {
+		config.HTTPSProxy = opts.HTTPSProxy
+	}
+	if opts.NoProxy != "" {
+		config.NoProxy = opts.NoProxy
+	}
+
+	t, ok := base.(*http.Transport)
+	if !ok {
+		return nil, errors.New("failed to configure proxy: unexpected type of the transport")
+	}
+	transport := t.Clone()
+	proxyFunc := config.ProxyFunc()
+	transport.Proxy = func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+	return transport, nil
+}
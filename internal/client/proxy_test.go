@@ -0,0 +1,54 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewProxyTransport(t *testing.T) {
+	for _, env := range []string{"HTTP_PROXY", "http_proxy", "HTTPS_PROXY", "https_proxy", "NO_PROXY", "no_proxy"} {
+		t.Setenv(env, "")
+	}
+	t.Setenv("HTTP_PROXY", "http://env-proxy:3128")
+
+	transport, err := newProxyTransport(http.DefaultTransport, &ClientOptions{})
+	if err != nil || transport != http.DefaultTransport {
+		t.Errorf("expected the transport as is without proxy options, got %v, %v", transport, err)
+	}
+
+	transport, err = newProxyTransport(http.DefaultTransport, &ClientOptions{HTTPSProxy: "http://proxy:3128", NoProxy: ".internal.example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tr, ok := transport.(*http.Transport)
+	if !ok {
+		t.Fatal("expected an HTTP transport")
+	}
+
+	cases := map[string]string{
+		"https://superset.example.com/api/v1/chart/":          "http://proxy:3128",
+		"https://superset.internal.example.com/api/v1/chart/": "",
+		"http://superset.example.com/api/v1/chart/":           "http://env-proxy:3128",
+	}
+	for target, want := range cases {
+		req, _ := http.NewRequest(http.MethodGet, target, nil)
+		proxy, err := tr.Proxy(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := ""
+		if proxy != nil {
+			got = proxy.String()
+		}
+		if got != want {
+			t.Errorf("expected %s to use proxy %q, got %q", target, want, got)
+		}
+	}
+
+	if _, err := newProxyTransport(http.DefaultTransport, &ClientOptions{HTTPProxy: "http://proxy:port"}); err == nil {
+		t.Error("expected an invalid proxy URL to fail")
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// tokenBucket allows rate events per second on average, and bursts of up to burst events.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), now: time.Now}
+}
+
+// reserve takes a token and returns how long to wait before it is available. Tokens are reserved in
+// order, so waiting requests are served first come, first served.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a token reserved by a request that gave up waiting for it.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = min(b.burst, b.tokens+1)
+}
+
+// rateLimitTransport spreads requests to the server out to a maximum rate, so that gateways in front of
+// Superset do not reject the bursts of lookups of large plans.
+type rateLimitTransport struct {
+	base   http.RoundTripper
+	bucket *tokenBucket
+}
+
+func newRateLimitTransport(base http.RoundTripper, requestsPerSecond float64, burst int) http.RoundTripper {
+	if requestsPerSecond <= 0 {
+		return base
+	}
+
+	return &rateLimitTransport{base: base, bucket: newTokenBucket(requestsPerSecond, burst)}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if d := t.bucket.reserve(); d > 0 {
+		if err := sleepContext(req.Context(), d); err != nil {
+			t.bucket.cancel()
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,37 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	bucket := newTokenBucket(2, 3)
+	bucket.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if d := bucket.reserve(); d != 0 {
+			t.Fatalf("expected the burst of 3 to pass, request %d waits %v", i, d)
+		}
+	}
+	if d := bucket.reserve(); d != 500*time.Millisecond {
+		t.Errorf("expected the 4th request to wait 500ms at 2 requests per second, got %v", d)
+	}
+	if d := bucket.reserve(); d != time.Second {
+		t.Errorf("expected the 5th request to wait behind the 4th, got %v", d)
+	}
+
+	now = now.Add(10 * time.Second)
+	for i := 0; i < 3; i++ {
+		if d := bucket.reserve(); d != 0 {
+			t.Fatalf("expected the bucket to refill up to the burst, request %d waits %v", i, d)
+		}
+	}
+	if d := bucket.reserve(); d == 0 {
+		t.Error("expected the refill to be capped at the burst")
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// maxNameSuggestions is the number of close matches listed when a name is not found.
+const maxNameSuggestions = 3
+
+// AmbiguousNameError is returned when a name matches more than one object.
+type AmbiguousNameError struct {
+	Resource   string
+	Name       string
+	Candidates []string
+}
+
+func (e *AmbiguousNameError) Error() string {
+	return fmt.Sprintf("found %d %ss named %q (%s)", len(e.Candidates), strings.ToLower(e.Resource), e.Name, strings.Join(e.Candidates, ", "))
+}
+
+// nameSpec describes how objects of a kind are found by name.
+type nameSpec[T any] struct {
+	// Resource is the kind of the objects, e.g. "Role".
+	Resource string
+	// CacheKey is the attribute the name is matched against, e.g. "username" or "email".
+	CacheKey string
+	// Search returns the objects the server matches for the name.
+	Search func(ctx context.Context, name string) ([]T, error)
+	// List returns all objects, to suggest close matches when the name is not found.
+	List func(ctx context.Context) ([]T, error)
+	Name func(T) string
+	// Describe identifies an object in the error of an ambiguous name.
+	Describe func(T) string
+}
+
+// nameCache keeps the objects found by name for the lifetime of the client, so every resource
+// referencing the same database or role by name does not look it up again. Any write of an object
+// that can be found by name invalidates the cache.
+type nameCache struct {
+	mu      sync.Mutex
+	entries map[string]any
+}
+
+func (c *nameCache) get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.entries[key]
+	return v, ok
+}
+
+func (c *nameCache) store(key string, v any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]any)
+	}
+	c.entries[key] = v
+}
+
+func (c *nameCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = nil
+}
+
+// resolveName finds the single object of spec named name. It returns a NotFoundError listing close
+// matches when there is none, and an AmbiguousNameError when there are several.
+func resolveName[T any](ctx context.Context, cache *nameCache, spec nameSpec[T], name string) (*T, error) {
+	key := spec.Resource + "/" + spec.CacheKey + "/" + name
+	if cached, ok := cache.get(key); ok {
+		if v, ok := cached.(T); ok {
+			return &v, nil
+		}
+	}
+
+	found, err := spec.Search(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// The server may match case-insensitively, depending on the collation of the metadata database.
+	var matches []T
+	for _, v := range found {
+		if spec.Name(v) == name {
+			matches = append(matches, v)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		notFound := &NotFoundError{Resource: spec.Resource, ID: name}
+		if all, err := spec.List(ctx); err == nil {
+			names := make([]string, 0, len(all))
+			for _, v := range all {
+				names = append(names, spec.Name(v))
+			}
+			notFound.Suggestions = closeMatches(name, names)
+		}
+		return nil, notFound
+	case 1:
+		cache.store(key, matches[0])
+		return &matches[0], nil
+	default:
+		candidates := make([]string, 0, len(matches))
+		for _, v := range matches {
+			candidates = append(candidates, spec.Describe(v))
+		}
+		return nil, &AmbiguousNameError{Resource: spec.Resource, Name: name, Candidates: candidates}
+	}
+}
+
+// closeMatches returns the names closest to name, for "did you mean" suggestions: the names equal
+// to it ignoring case, containing it or within a small edit distance of it.
+func closeMatches(name string, names []string) []string {
+	type match struct {
+		name     string
+		distance int
+	}
+
+	lower := strings.ToLower(name)
+	maxDistance := max(2, len(name)/3)
+	seen := make(map[string]bool, len(names))
+	var matches []match
+	for _, n := range names {
+		if n == name || seen[n] {
+			continue
+		}
+		seen[n] = true
+
+		l := strings.ToLower(n)
+		d := editDistance(lower, l)
+		if l == lower || (len(lower) >= 3 && strings.Contains(l, lower)) {
+			d = 0
+		}
+		if d <= maxDistance {
+			matches = append(matches, match{n, d})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].name < matches[j].name
+	})
+
+	suggestions := make([]string, 0, maxNameSuggestions)
+	for i := 0; i < len(matches) && i < maxNameSuggestions; i++ {
+		suggestions = append(suggestions, matches[i].name)
+	}
+	return suggestions
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// nameFilter returns the list query filtering the column col by value.
+func nameFilter(col string, value string) (GetListSchema, error) {
+	var v GetListSchema_Filters_Value
+	if err := v.FromGetListSchemaFiltersValue1(value); err != nil {
+		return GetListSchema{}, err
+	}
+
+	return GetListSchema{
+		Filters: []struct {
+			Col   string                      `json:"col"`
+			Opr   string                      `json:"opr"`
+			Value GetListSchema_Filters_Value `json:"value"`
+		}{
+			{Col: col, Opr: "eq", Value: v},
+		},
+	}, nil
+}
@@ -0,0 +1,153 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestCloseMatches(t *testing.T) {
+	names := []string{"Admin", "Alpha", "Gamma", "analytics_viewer", "analytics_editor", "sql_lab"}
+
+	cases := []struct {
+		name string
+		want []string
+	}{
+		{"admin", []string{"Admin"}},
+		{"Gama", []string{"Gamma"}},
+		{"analytics", []string{"analytics_editor", "analytics_viewer"}},
+		{"sqllab", []string{"sql_lab"}},
+		{"reporting", []string{}},
+		{"Admin", []string{}},
+	}
+
+	for _, c := range cases {
+		if got := closeMatches(c.name, names); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("closeMatches(%q) = %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestResolveName(t *testing.T) {
+	type object struct {
+		Id   int
+		Name string
+	}
+
+	objects := []object{{1, "Admin"}, {2, "admin"}, {3, "Gamma"}, {4, "Public"}, {5, "Public"}}
+	searches := 0
+	spec := nameSpec[object]{
+		Resource: "Role",
+		CacheKey: "name",
+		Search: func(ctx context.Context, name string) ([]object, error) {
+			searches++
+			var found []object
+			for _, o := range objects {
+				if o.Name == name || o.Name == "admin" && name == "Admin" {
+					found = append(found, o)
+				}
+			}
+			return found, nil
+		},
+		List:     func(ctx context.Context) ([]object, error) { return objects, nil },
+		Name:     func(o object) string { return o.Name },
+		Describe: func(o object) string { return o.Name },
+	}
+	cache := &nameCache{}
+	ctx := context.Background()
+
+	got, err := resolveName(ctx, cache, spec, "Admin")
+	if err != nil || got.Id != 1 {
+		t.Fatalf("resolveName(Admin) = %v, %v, want id 1", got, err)
+	}
+	if _, err := resolveName(ctx, cache, spec, "Admin"); err != nil || searches != 1 {
+		t.Errorf("expected the second lookup to be cached, got %d searches and error %v", searches, err)
+	}
+
+	cache.invalidate()
+	if _, err := resolveName(ctx, cache, spec, "Admin"); err != nil || searches != 2 {
+		t.Errorf("expected a search after invalidation, got %d searches and error %v", searches, err)
+	}
+
+	_, err = resolveName(ctx, cache, spec, "Gama")
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) || !reflect.DeepEqual(notFound.Suggestions, []string{"Gamma"}) {
+		t.Errorf("resolveName(Gama) = %v, want a not found error suggesting Gamma", err)
+	}
+	if want := `Role not found (id=Gama), did you mean "Gamma"?`; err.Error() != want {
+		t.Errorf("got error %q, want %q", err, want)
+	}
+
+	_, err = resolveName(ctx, cache, spec, "Public")
+	var ambiguous *AmbiguousNameError
+	if !errors.As(err, &ambiguous) || len(ambiguous.Candidates) != 2 {
+		t.Errorf("resolveName(Public) = %v, want an ambiguous name error", err)
+	}
+}
+
+func TestFindQualifiedDataset(t *testing.T) {
+	datasets := []map[string]interface{}{
+		{"id": 1, "table_name": "orders", "schema": "public", "database": map[string]interface{}{"id": 1, "database_name": "examples"}},
+		{"id": 2, "table_name": "orders", "schema": "sales", "database": map[string]interface{}{"id": 1, "database_name": "examples"}},
+		{"id": 3, "table_name": "orders", "schema": "sales", "database": map[string]interface{}{"id": 2, "database_name": "warehouse"}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var q GetListSchema
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("q")), &q); err != nil {
+			t.Errorf("failed to decode query: %v", err)
+		}
+		// The server filters by the columns of the query, and returns a dataset per page.
+		var found []map[string]interface{}
+		for _, d := range datasets {
+			matches := true
+			for _, f := range q.Filters {
+				v, err := f.Value.AsGetListSchemaFiltersValue1()
+				if err != nil {
+					t.Errorf("unexpected filter value: %v", err)
+				}
+				matches = matches && d[f.Col] == v
+			}
+			if matches {
+				found = append(found, d)
+			}
+		}
+		result := []map[string]interface{}{}
+		if q.Page < len(found) {
+			result = found[q.Page : q.Page+1]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{"count": len(found), "result": result}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	cw, err := NewClientWrapper(ctx, server.URL, ClientCredentials{AccessToken: "token"}, WithPageSize(1))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	found, err := cw.FindQualifiedDataset(ctx, DatasetQualifier{TableName: "orders", Schema: "sales", DatabaseName: "warehouse"})
+	if err != nil || found.Id != 3 {
+		t.Errorf("expected dataset 3 on the second page, got %v, %v", found, err)
+	}
+
+	_, err = cw.FindQualifiedDataset(ctx, DatasetQualifier{TableName: "orders", Schema: "sales"})
+	var ambiguous *AmbiguousNameError
+	if !errors.As(err, &ambiguous) || !reflect.DeepEqual(ambiguous.Candidates, []string{"examples/sales.orders", "warehouse/sales.orders"}) {
+		t.Errorf("expected an ambiguous name error, got %v", err)
+	}
+
+	_, err = cw.FindQualifiedDataset(ctx, DatasetQualifier{TableName: "orders", Schema: "marketing"})
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
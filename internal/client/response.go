@@ -0,0 +1,124 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+// DefaultMaxResponseSize is the default upper bound (in bytes) for a single API response body.
+const DefaultMaxResponseSize int64 = 256 << 20
+
+// ResponseTooLargeError is returned when a response body exceeds the configured maximum size.
+type ResponseTooLargeError struct {
+	Limit int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response body exceeds the maximum allowed size of %d bytes", e.Limit)
+}
+
+// IsResponseTooLarge checks if the error is a ResponseTooLargeError.
+func IsResponseTooLarge(err error) bool {
+	var rtl *ResponseTooLargeError
+	return errors.As(err, &rtl)
+}
+
+// streamedResponseKey marks the context of a request whose successful response is streamed to disk
+// rather than read into memory, such as an export bundle.
+type streamedResponseKey struct{}
+
+// withStreamedResponse returns ctx marking its requests as streamed, so that limitedBodyTransport does not
+// limit their successful responses, which may well be larger than the maximum response size.
+func withStreamedResponse(ctx context.Context) context.Context {
+	return context.WithValue(ctx, streamedResponseKey{}, true)
+}
+
+func isStreamedResponse(ctx context.Context) bool {
+	streamed, _ := ctx.Value(streamedResponseKey{}).(bool)
+	return streamed
+}
+
+// limitedBodyTransport wraps response bodies so that reading more than maxSize bytes fails
+// instead of growing the provider's memory without bound. Successful responses to streamed
+// requests are not limited, as they are written to disk.
+type limitedBodyTransport struct {
+	base    http.RoundTripper
+	maxSize int64
+}
+
+func (t *limitedBodyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.base.RoundTrip(req)
+	if err != nil || t.maxSize <= 0 {
+		return res, err
+	}
+	if res.StatusCode == http.StatusOK && isStreamedResponse(req.Context()) {
+		return res, nil
+	}
+
+	if res.ContentLength > t.maxSize {
+		res.Body.Close()
+		return nil, &ResponseTooLargeError{Limit: t.maxSize}
+	}
+
+	res.Body = &limitedReadCloser{rc: res.Body, remaining: t.maxSize, limit: t.maxSize}
+	return res, nil
+}
+
+type limitedReadCloser struct {
+	rc        io.ReadCloser
+	remaining int64
+	limit     int64
+}
+
+func (l *limitedReadCloser) Read(p []byte) (int, error) {
+	if l.remaining <= 0 {
+		// Probe for one more byte to distinguish an exact-size body from an oversized one.
+		var probe [1]byte
+		n, err := l.rc.Read(probe[:])
+		if n > 0 {
+			return 0, &ResponseTooLargeError{Limit: l.limit}
+		}
+		return 0, err
+	}
+
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.rc.Read(p)
+	l.remaining -= int64(n)
+	return n, err
+}
+
+func (l *limitedReadCloser) Close() error {
+	return l.rc.Close()
+}
+
+// streamToTempFile copies body into a new temporary file and returns its path.
+// The caller is responsible for removing the file.
+func streamToTempFile(body io.Reader, pattern string, p *progress) (string, error) {
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	if _, err := io.Copy(&progressWriter{w: f, progress: p}, body); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", fmt.Errorf("failed to write response body to temporary file: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return "", fmt.Errorf("failed to close temporary file: %w", err)
+	}
+
+	p.done()
+	return f.Name(), nil
+}
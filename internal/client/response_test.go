@@ -0,0 +1,71 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLimitedReadCloser(t *testing.T) {
+	body := "0123456789"
+
+	r := &limitedReadCloser{rc: io.NopCloser(strings.NewReader(body)), remaining: 10, limit: 10}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("unexpected error for body at the limit: %v", err)
+	}
+	if string(b) != body {
+		t.Fatalf("unexpected body: %q", string(b))
+	}
+
+	r = &limitedReadCloser{rc: io.NopCloser(strings.NewReader(body)), remaining: 5, limit: 5}
+	_, err = io.ReadAll(r)
+	if !IsResponseTooLarge(err) {
+		t.Fatalf("expected ResponseTooLargeError, got %v", err)
+	}
+}
+
+func TestExportIsNotLimited(t *testing.T) {
+	bundle := strings.Repeat("x", 64)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v1/assets/export/":
+			w.Header().Set("Content-Type", "application/zip")
+			_, _ = w.Write([]byte(bundle))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"count": 0, "result": [], "padding": "` + bundle + `"}`))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	cw, err := NewClientWrapper(ctx, server.URL, ClientCredentials{AccessToken: "token"}, WithMaxResponseSize(16))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	path, err := cw.ExportAssets(ctx)
+	if err != nil {
+		t.Fatalf("expected the export to be streamed regardless of the limit, got %v", err)
+	}
+	defer os.Remove(path)
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != bundle {
+		t.Errorf("unexpected bundle: %q", string(b))
+	}
+
+	if _, err := cw.ListUsers(ctx); !IsResponseTooLarge(err) {
+		t.Errorf("expected other responses to be limited, got %v", err)
+	}
+}
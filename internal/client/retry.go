@@ -0,0 +1,137 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultRetryMinDelay = 1 * time.Second
+	DefaultRetryMaxDelay = 30 * time.Second
+)
+
+// retryTransport retries requests the server rejected as overloaded or failed to process, waiting a jittered
+// exponential backoff, or the delay of a Retry-After header, between attempts. Requests that are not
+// idempotent are only retried when the server did not process them (429 and 503).
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	minDelay   time.Duration
+	maxDelay   time.Duration
+	sleep      func(ctx context.Context, d time.Duration) error
+}
+
+func newRetryTransport(base http.RoundTripper, opts *ClientOptions) http.RoundTripper {
+	if opts.MaxRetries <= 0 {
+		return base
+	}
+
+	minDelay, maxDelay := opts.RetryMinDelay, opts.RetryMaxDelay
+	if minDelay <= 0 {
+		minDelay = DefaultRetryMinDelay
+	}
+	if maxDelay < minDelay {
+		maxDelay = minDelay
+	}
+	return &retryTransport{base: base, maxRetries: opts.MaxRetries, minDelay: minDelay, maxDelay: maxDelay, sleep: sleepContext}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The body of the request is consumed by every attempt, so only requests whose body can be recreated
+	// are retried.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		res, err := t.base.RoundTrip(attemptReq)
+		if err != nil || attempt >= t.maxRetries || !isRetryable(req.Method, res.StatusCode) {
+			return res, err
+		}
+
+		delay := t.delay(attempt, res.Header.Get("Retry-After"))
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		if err := t.sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// delay returns the time to wait before retrying after attempt: the delay of retryAfter when the server
+// sent one, and otherwise the exponential backoff with jitter. Both are capped at maxDelay.
+func (t *retryTransport) delay(attempt int, retryAfter string) time.Duration {
+	if d, ok := parseRetryAfter(retryAfter, time.Now()); ok {
+		return min(d, t.maxDelay)
+	}
+
+	backoff := t.maxDelay
+	if attempt < 32 {
+		backoff = min(t.minDelay<<attempt, t.maxDelay)
+	}
+	// Jitter spreads out the retries of the requests that failed together, e.g. during a server restart.
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// isRetryable reports whether a request with method that got statusCode may succeed when retried.
+func isRetryable(method string, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	}
+	if statusCode < 500 {
+		return false
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	statuses := []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}
+	var bodies []string
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(body))
+		status := statuses[len(bodies)-1]
+		header := http.Header{}
+		if status == http.StatusTooManyRequests {
+			header.Set("Retry-After", "7")
+		}
+		return &http.Response{StatusCode: status, Header: header, Body: http.NoBody}, nil
+	})
+
+	var delays []time.Duration
+	transport, ok := newRetryTransport(base, &ClientOptions{MaxRetries: 3, RetryMinDelay: time.Second, RetryMaxDelay: 5 * time.Second}).(*retryTransport)
+	if !ok {
+		t.Fatal("expected a retrying transport")
+	}
+	transport.sleep = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, "http://localhost/api/v1/chart/", bytes.NewReader([]byte(`{"slice_name":"a"}`)))
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.StatusCode != http.StatusOK || len(bodies) != 3 {
+		t.Fatalf("expected success after 3 attempts, got status code %d after %d attempts", res.StatusCode, len(bodies))
+	}
+	for i, body := range bodies {
+		if body != `{"slice_name":"a"}` {
+			t.Errorf("expected attempt %d to send the body, got %q", i, body)
+		}
+	}
+	if len(delays) != 2 || delays[0] < 500*time.Millisecond || delays[0] > time.Second {
+		t.Errorf("expected a jittered backoff of at most 1s before the first retry, got %v", delays)
+	}
+	if len(delays) == 2 && delays[1] != 5*time.Second {
+		t.Errorf("expected Retry-After capped at the maximum delay, got %v", delays[1])
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	cases := []struct {
+		method string
+		status int
+		want   bool
+	}{
+		{http.MethodGet, http.StatusInternalServerError, true},
+		{http.MethodPut, http.StatusBadGateway, true},
+		{http.MethodPost, http.StatusTooManyRequests, true},
+		{http.MethodPost, http.StatusServiceUnavailable, true},
+		{http.MethodPost, http.StatusInternalServerError, false},
+		{http.MethodGet, http.StatusNotFound, false},
+	}
+	for _, c := range cases {
+		if got := isRetryable(c.method, c.status); got != c.want {
+			t.Errorf("isRetryable(%s, %d) = %v, want %v", c.method, c.status, got, c.want)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	if d, ok := parseRetryAfter("120", now); !ok || d != 2*time.Minute {
+		t.Errorf("expected 2m, got %v %v", d, ok)
+	}
+	if d, ok := parseRetryAfter("Thu, 01 Jan 2026 00:00:30 GMT", now); !ok || d != 30*time.Second {
+		t.Errorf("expected 30s, got %v %v", d, ok)
+	}
+	if _, ok := parseRetryAfter("soon", now); ok {
+		t.Error("expected an invalid Retry-After to be ignored")
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	// TenantRoutingHeader sends the tenant in a header of every request.
+	TenantRoutingHeader = "header"
+	// TenantRoutingPath prefixes the path of every request with the tenant.
+	TenantRoutingPath = "path"
+
+	DefaultTenantHeader = "X-Tenant-ID"
+)
+
+// tenantTransport adds the tenant header to every request, including the login and CSRF token requests
+// of multi-tenant distributions routing on headers.
+type tenantTransport struct {
+	base   http.RoundTripper
+	header string
+	tenant string
+}
+
+func newTenantTransport(base http.RoundTripper, opts *ClientOptions) http.RoundTripper {
+	if opts.Tenant == "" || opts.TenantRouting == TenantRoutingPath {
+		return base
+	}
+
+	header := opts.TenantHeader
+	if header == "" {
+		header = DefaultTenantHeader
+	}
+	return &tenantTransport{base: base, header: header, tenant: opts.Tenant}
+}
+
+func (t *tenantTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it is given.
+	req = req.Clone(req.Context())
+	req.Header.Set(t.header, t.tenant)
+	return t.base.RoundTrip(req)
+}
+
+// tenantBasePath returns the path prefix of the tenant's API: basePath itself, or basePath followed by
+// the tenant for distributions routing on paths.
+func tenantBasePath(opts *ClientOptions) (string, error) {
+	if opts.Tenant == "" || opts.TenantRouting != TenantRoutingPath {
+		return opts.BasePath, nil
+	}
+
+	if strings.Contains(opts.Tenant, "/") {
+		return "", fmt.Errorf("invalid tenant %q: it must be a single path segment", opts.Tenant)
+	}
+	return strings.TrimSuffix(opts.BasePath, "/") + "/" + opts.Tenant, nil
+}
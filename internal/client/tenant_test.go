@@ -0,0 +1,64 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestTenantTransport(t *testing.T) {
+	var got string
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		got = req.Header.Get("X-Workspace")
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+
+	transport := newTenantTransport(base, &ClientOptions{Tenant: "acme", TenantRouting: TenantRoutingHeader, TenantHeader: "X-Workspace"})
+	req, _ := http.NewRequest(http.MethodPost, "http://localhost/api/v1/security/login", nil)
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "acme" {
+		t.Errorf("expected the tenant header to be acme, got %q", got)
+	}
+	if req.Header.Get("X-Workspace") != "" {
+		t.Error("expected the original request to be left unmodified")
+	}
+
+	got = ""
+	transport = newTenantTransport(base, &ClientOptions{Tenant: "acme", TenantRouting: TenantRoutingPath, TenantHeader: "X-Workspace"})
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "" {
+		t.Errorf("expected no tenant header with path routing, got %q", got)
+	}
+}
+
+func TestTenantBasePath(t *testing.T) {
+	cases := []struct {
+		opts ClientOptions
+		want string
+	}{
+		{ClientOptions{BasePath: "/analytics"}, "/analytics"},
+		{ClientOptions{BasePath: "/analytics", Tenant: "acme", TenantRouting: TenantRoutingHeader}, "/analytics"},
+		{ClientOptions{BasePath: "/analytics/", Tenant: "acme", TenantRouting: TenantRoutingPath}, "/analytics/acme"},
+		{ClientOptions{Tenant: "acme", TenantRouting: TenantRoutingPath}, "/acme"},
+	}
+
+	for _, c := range cases {
+		got, err := tenantBasePath(&c.opts)
+		if err != nil {
+			t.Fatalf("tenantBasePath(%+v) returned error: %v", c.opts, err)
+		}
+		if got != c.want {
+			t.Errorf("tenantBasePath(%+v) = %q, want %q", c.opts, got, c.want)
+		}
+	}
+
+	if _, err := tenantBasePath(&ClientOptions{Tenant: "a/b", TenantRouting: TenantRoutingPath}); err == nil {
+		t.Error("expected an error for a tenant with a slash")
+	}
+}
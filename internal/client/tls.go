@@ -0,0 +1,43 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+)
+
+// newTLSTransport returns the transport connecting to the server: the default transport, or a copy of
+// it trusting the CA certificates of opts in addition to the system ones, or skipping verification.
+func newTLSTransport(opts *ClientOptions) (http.RoundTripper, error) {
+	if opts.CACertPEM == "" && !opts.InsecureSkipVerify {
+		return http.DefaultTransport, nil
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// Only when explicitly configured, e.g. for a test server with a self-signed certificate.
+		InsecureSkipVerify: opts.InsecureSkipVerify,
+	}
+	if opts.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(opts.CACertPEM)) {
+			return nil, errors.New("failed to parse CA certificate: no PEM encoded certificate found")
+		}
+		config.RootCAs = pool
+	}
+
+	base, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, errors.New("failed to configure TLS: unexpected type of the default transport")
+	}
+	transport := base.Clone()
+	transport.TLSClientConfig = config
+	return transport, nil
+}
@@ -0,0 +1,29 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewTLSTransport(t *testing.T) {
+	transport, err := newTLSTransport(&ClientOptions{})
+	if err != nil || transport != http.DefaultTransport {
+		t.Errorf("expected the default transport without TLS options, got %v, %v", transport, err)
+	}
+
+	transport, err = newTLSTransport(&ClientOptions{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tr, ok := transport.(*http.Transport)
+	if !ok || !tr.TLSClientConfig.InsecureSkipVerify {
+		t.Error("expected a transport skipping the verification")
+	}
+
+	if _, err := newTLSTransport(&ClientOptions{CACertPEM: "not a certificate"}); err == nil {
+		t.Error("expected an invalid CA certificate to fail")
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// buildServerBaseUrl joins the server URL and an optional path prefix such as "/analytics" into a
+// base URL without a trailing slash. Redundant slashes on either side are ignored.
+func buildServerBaseUrl(serverBaseUrl string, basePath string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(serverBaseUrl))
+	if err != nil {
+		return "", fmt.Errorf("invalid server base URL %q: %w", serverBaseUrl, err)
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("invalid server base URL %q: scheme and host are required", serverBaseUrl)
+	}
+
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("invalid server base URL %q: query and fragment are not allowed", serverBaseUrl)
+	}
+
+	segments := make([]string, 0)
+	for _, p := range []string{u.Path, basePath} {
+		for _, s := range strings.Split(p, "/") {
+			if s != "" {
+				segments = append(segments, s)
+			}
+		}
+	}
+
+	u.Path = ""
+	u.RawPath = ""
+	if len(segments) > 0 {
+		u.Path = "/" + strings.Join(segments, "/")
+	}
+
+	return u.String(), nil
+}
+
+// url returns the absolute URL of the given server path, which must start with a slash.
+func (cw *ClientWrapper) url(path string) string {
+	return cw.serverBaseUrl + path
+}
@@ -0,0 +1,37 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import "testing"
+
+func TestBuildServerBaseUrl(t *testing.T) {
+	cases := []struct {
+		serverBaseUrl string
+		basePath      string
+		want          string
+	}{
+		{"http://localhost:8088", "", "http://localhost:8088"},
+		{"http://localhost:8088/", "", "http://localhost:8088"},
+		{"http://localhost:8088", "/analytics", "http://localhost:8088/analytics"},
+		{"http://localhost:8088/", "analytics/", "http://localhost:8088/analytics"},
+		{"https://example.com/analytics/", "", "https://example.com/analytics"},
+		{"https://example.com/corp//", "//analytics/", "https://example.com/corp/analytics"},
+	}
+
+	for _, c := range cases {
+		got, err := buildServerBaseUrl(c.serverBaseUrl, c.basePath)
+		if err != nil {
+			t.Fatalf("buildServerBaseUrl(%q, %q) returned error: %v", c.serverBaseUrl, c.basePath, err)
+		}
+		if got != c.want {
+			t.Errorf("buildServerBaseUrl(%q, %q) = %q, want %q", c.serverBaseUrl, c.basePath, got, c.want)
+		}
+	}
+
+	for _, invalid := range []string{"localhost:8088", "/analytics", "http://localhost:8088/?a=b"} {
+		if _, err := buildServerBaseUrl(invalid, ""); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
@@ -0,0 +1,396 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// assetBundleDirs are the directories of an export bundle holding one asset per file.
+var assetBundleDirs = []string{"databases", "datasets", "charts", "dashboards", "queries"}
+
+// assetBundleOverrides are the environment specific changes applied to an export bundle before it is
+// imported.
+type assetBundleOverrides struct {
+	// UuidMapping replaces source UUIDs with target UUIDs wherever they appear in the bundle.
+	UuidMapping map[string]string
+	// Databases overrides the connection of the databases of the bundle, keyed by database name.
+	Databases map[string]databaseOverride
+}
+
+type databaseOverride struct {
+	DatabaseName  string
+	SqlalchemyUri string
+	Password      string
+}
+
+// assetBundle is an export bundle with the overrides applied.
+type assetBundle struct {
+	// Data is the ZIP file of the bundle. It is nil when the bundle was only written to a file.
+	Data []byte
+	// Passwords maps the database files of the bundle to their passwords, as expected by the import API.
+	Passwords map[string]string
+	// Assets maps the asset files of the bundle to the UUID of the asset.
+	Assets map[string]string
+	// checksum is the hex encoded SHA-256 checksum of the ZIP file, computed while it is written.
+	checksum string
+}
+
+func (b *assetBundle) sha256() string {
+	return b.checksum
+}
+
+// files returns the content of the files of the bundle, keyed by their path below the top level directory.
+func (b *assetBundle) files() (map[string]string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(b.Data), int64(len(b.Data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bundle: %w", err)
+	}
+
+	files := make(map[string]string, len(zr.File))
+	for _, f := range zr.File {
+		content, err := readZipFile(f)
+		if err != nil {
+			return nil, err
+		}
+		files[strings.TrimPrefix(f.Name, assetBundleRoot+"/")] = string(content)
+	}
+	return files, nil
+}
+
+// assetBundleRoot is the top level directory of rewritten bundles.
+const assetBundleRoot = "bundle"
+
+// rewriteAssetBundle applies the overrides to the export bundle read from r, and keeps the result in
+// the Data of the bundle.
+func rewriteAssetBundle(r io.ReaderAt, size int64, overrides assetBundleOverrides) (*assetBundle, error) {
+	var buf bytes.Buffer
+	bundle, err := streamAssetBundle(&buf, r, size, overrides)
+	if err != nil {
+		return nil, err
+	}
+	bundle.Data = buf.Bytes()
+	return bundle, nil
+}
+
+// streamAssetBundle applies the overrides to the export bundle read from r and writes the ZIP file of
+// the result to w. The export timestamp and the top level directory are normalized and the files are
+// written in name order with no modification time, so exports of the same assets always produce the
+// same bundle.
+func streamAssetBundle(w io.Writer, r io.ReaderAt, size int64, overrides assetBundleOverrides) (*assetBundle, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bundle: %w", err)
+	}
+
+	files := make([]*zip.File, 0, len(zr.File))
+	for _, f := range zr.File {
+		if !f.FileInfo().IsDir() {
+			files = append(files, f)
+		}
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+
+	uuids := sortedKeys(overrides.UuidMapping)
+	matchedDatabases := make(map[string]bool)
+	bundle := &assetBundle{
+		Passwords: make(map[string]string),
+		Assets:    make(map[string]string),
+	}
+
+	hash := sha256.New()
+	zw := zip.NewWriter(io.MultiWriter(w, hash))
+	for _, f := range files {
+		content, err := readZipFile(f)
+		if err != nil {
+			return nil, err
+		}
+
+		// The import API ignores the top level directory of the bundle.
+		name := f.Name
+		if i := strings.Index(name, "/"); i >= 0 {
+			name = name[i+1:]
+		}
+
+		if ext := path.Ext(name); ext == ".yaml" || ext == ".yml" {
+			var doc yaml.Node
+			if err := yaml.Unmarshal(content, &doc); err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %w", f.Name, err)
+			}
+			root := &doc
+			if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+				root = root.Content[0]
+			}
+
+			replaceYAMLScalars(root, func(s string) string {
+				for _, from := range uuids {
+					s = strings.ReplaceAll(s, from, overrides.UuidMapping[from])
+				}
+				return s
+			})
+
+			dir := path.Dir(name)
+			if name == "metadata.yaml" {
+				deleteYAMLMappingKey(root, "timestamp")
+			}
+			if dir == "databases" {
+				databaseName := yamlMappingValue(root, "database_name")
+				if o, ok := overrides.Databases[databaseName]; ok {
+					matchedDatabases[databaseName] = true
+					if o.DatabaseName != "" {
+						setYAMLMappingValue(root, "database_name", o.DatabaseName)
+					}
+					if o.SqlalchemyUri != "" {
+						setYAMLMappingValue(root, "sqlalchemy_uri", o.SqlalchemyUri)
+					}
+					if o.Password != "" {
+						bundle.Passwords[name] = o.Password
+					}
+				}
+			}
+			for _, d := range assetBundleDirs {
+				if dir == d || strings.HasPrefix(dir, d+"/") {
+					bundle.Assets[name] = yamlMappingValue(root, "uuid")
+				}
+			}
+
+			var out bytes.Buffer
+			enc := yaml.NewEncoder(&out)
+			enc.SetIndent(2)
+			if err := enc.Encode(&doc); err != nil {
+				return nil, fmt.Errorf("failed to encode %s: %w", f.Name, err)
+			}
+			if err := enc.Close(); err != nil {
+				return nil, fmt.Errorf("failed to encode %s: %w", f.Name, err)
+			}
+			content = out.Bytes()
+		}
+
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: assetBundleRoot + "/" + name, Method: zip.Deflate})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(content); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	for _, name := range sortedKeys(overrides.Databases) {
+		if !matchedDatabases[name] {
+			return nil, fmt.Errorf("database override %q does not match any database of the bundle", name)
+		}
+	}
+
+	bundle.checksum = hex.EncodeToString(hash.Sum(nil))
+	return bundle, nil
+}
+
+// readAssetBundle reads the bundle at name, a ZIP file or a directory of YAML files, and applies the
+// overrides to it.
+func readAssetBundle(name string, overrides assetBundleOverrides) (*assetBundle, error) {
+	var buf bytes.Buffer
+	bundle, err := writeAssetBundle(&buf, name, overrides)
+	if err != nil {
+		return nil, err
+	}
+	bundle.Data = buf.Bytes()
+	return bundle, nil
+}
+
+// writeAssetBundleFile reads the bundle at name like readAssetBundle, and writes the result to the file
+// at outputPath instead of keeping it in memory. An existing file is overwritten.
+func writeAssetBundleFile(outputPath, name string, overrides assetBundleOverrides) (*assetBundle, error) {
+	out, err := os.OpenFile(outputPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := writeAssetBundle(out, name, overrides)
+	if err != nil {
+		out.Close()
+		return nil, err
+	}
+	return bundle, out.Close()
+}
+
+// writeAssetBundle reads the bundle at name, a ZIP file or a directory of YAML files, applies the
+// overrides to it and writes the result to w.
+func writeAssetBundle(w io.Writer, name string, overrides assetBundleOverrides) (*assetBundle, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		data, err := zipAssetBundleDir(name)
+		if err != nil {
+			return nil, err
+		}
+		return streamAssetBundle(w, bytes.NewReader(data), int64(len(data)), overrides)
+	}
+	return streamAssetBundle(w, f, info.Size(), overrides)
+}
+
+// bundleExists reports whether the file or directory of a bundle exists at name.
+func bundleExists(name string) bool {
+	_, err := os.Stat(name)
+	return !errors.Is(err, fs.ErrNotExist)
+}
+
+// decodeAssetBundle reads the bundle from contentBase64, a base64 encoded ZIP file, e.g. the
+// content_base64 of an export data source or the result of filebase64, and applies the overrides to it.
+func decodeAssetBundle(contentBase64 string, overrides assetBundleOverrides) (*assetBundle, error) {
+	data, err := decodeBase64Content(contentBase64)
+	if err != nil {
+		return nil, err
+	}
+	return rewriteAssetBundle(bytes.NewReader(data), int64(len(data)), overrides)
+}
+
+// decodeBase64Content decodes standard base64, ignoring the line breaks of wrapped output such as that of
+// the base64 command.
+func decodeBase64Content(s string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 content: %w", err)
+	}
+	return data, nil
+}
+
+// zipAssetBundleDir returns a ZIP bundle of the files of dir, an extracted export bundle, e.g. committed
+// to source control. Hidden files and directories, such as .git, are skipped.
+func zipAssetBundleDir(dir string) ([]byte, error) {
+	if _, err := os.Stat(filepath.Join(dir, "metadata.yaml")); err != nil {
+		return nil, fmt.Errorf("%s is not an export bundle, it has no metadata.yaml: %w", dir, err)
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	err := filepath.WalkDir(dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if name != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, name)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		w, err := zw.Create(assetBundleRoot + "/" + filepath.ToSlash(rel))
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(content)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func readZipFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	b, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", f.Name, err)
+	}
+	return b, nil
+}
+
+// replaceYAMLScalars replaces every scalar of the node tree, including mapping keys, with replace(value).
+func replaceYAMLScalars(n *yaml.Node, replace func(string) string) {
+	if n.Kind == yaml.ScalarNode {
+		if v := replace(n.Value); v != n.Value {
+			n.Value = v
+		}
+		return
+	}
+	for _, c := range n.Content {
+		replaceYAMLScalars(c, replace)
+	}
+}
+
+// yamlMappingValue returns the scalar value of key in the mapping node, or "" when it is not set.
+func yamlMappingValue(n *yaml.Node, key string) string {
+	if n.Kind != yaml.MappingNode {
+		return ""
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key && n.Content[i+1].Kind == yaml.ScalarNode {
+			return n.Content[i+1].Value
+		}
+	}
+	return ""
+}
+
+// setYAMLMappingValue sets key of the mapping node to the string value, adding the key when needed.
+func setYAMLMappingValue(n *yaml.Node, key, value string) {
+	if n.Kind != yaml.MappingNode {
+		return
+	}
+	v := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			n.Content[i+1] = v
+			return
+		}
+	}
+	n.Content = append(n.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, v)
+}
+
+func deleteYAMLMappingKey(n *yaml.Node, key string) {
+	if n.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			n.Content = append(n.Content[:i], n.Content[i+2:]...)
+			return
+		}
+	}
+}
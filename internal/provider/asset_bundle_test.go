@@ -0,0 +1,253 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	testDatabaseUuid = "a2dc77af-e654-49bb-b321-40f6b559a1ee"
+	testTargetUuid   = "3f2c1d2e-0000-4000-8000-000000000001"
+)
+
+func testExportFiles(timestamp string) map[string]string {
+	return map[string]string{
+		"metadata.yaml": "version: 1.0.0\ntype: assets\ntimestamp: '" + timestamp + "'\n",
+		"databases/examples.yaml": "database_name: examples\nsqlalchemy_uri: postgresql://superset:XXXXXXXXXX@db/examples\n" +
+			"uuid: " + testDatabaseUuid + "\n",
+		"datasets/examples/orders.yaml": "table_name: orders\nuuid: 9d3b5cf4-0b9c-4a0b-9d4a-27c5a3f1c001\n" +
+			"database_uuid: " + testDatabaseUuid + "\n",
+	}
+}
+
+func testExportBundle(t *testing.T, root, timestamp string) *bytes.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range testExportFiles(timestamp) {
+		w, err := zw.Create(root + "/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return bytes.NewReader(buf.Bytes())
+}
+
+func readTestBundleFile(t *testing.T, b *assetBundle, name string) string {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(b.Data), int64(len(b.Data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range zr.File {
+		if f.Name == assetBundleRoot+"/"+name {
+			content, err := readZipFile(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return string(content)
+		}
+	}
+	t.Fatalf("%s not found in bundle", name)
+	return ""
+}
+
+func TestRewriteAssetBundleIsDeterministic(t *testing.T) {
+	first := testExportBundle(t, "assets_export_20261001T120000", "2026-10-01T12:00:00")
+	second := testExportBundle(t, "assets_export_20261002T080000", "2026-10-02T08:00:00")
+
+	a, err := rewriteAssetBundle(first, first.Size(), assetBundleOverrides{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := rewriteAssetBundle(second, second.Size(), assetBundleOverrides{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.sha256() != b.sha256() {
+		t.Errorf("exports of the same assets produced different bundles")
+	}
+	if got := a.Assets["databases/examples.yaml"]; got != testDatabaseUuid {
+		t.Errorf("assets[databases/examples.yaml] = %q, want %q", got, testDatabaseUuid)
+	}
+}
+
+func TestRewriteAssetBundleOverrides(t *testing.T) {
+	r := testExportBundle(t, "assets_export", "2026-10-01T12:00:00")
+
+	b, err := rewriteAssetBundle(r, r.Size(), assetBundleOverrides{
+		UuidMapping: map[string]string{testDatabaseUuid: testTargetUuid},
+		Databases: map[string]databaseOverride{
+			"examples": {DatabaseName: "warehouse", SqlalchemyUri: "postgresql://superset@prod-db/warehouse", Password: "secret"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	database := readTestBundleFile(t, b, "databases/examples.yaml")
+	for _, want := range []string{"database_name: warehouse", "sqlalchemy_uri: postgresql://superset@prod-db/warehouse", "uuid: " + testTargetUuid} {
+		if !strings.Contains(database, want) {
+			t.Errorf("databases/examples.yaml does not contain %q:\n%s", want, database)
+		}
+	}
+	if dataset := readTestBundleFile(t, b, "datasets/examples/orders.yaml"); !strings.Contains(dataset, "database_uuid: "+testTargetUuid) {
+		t.Errorf("dataset does not reference the mapped database UUID:\n%s", dataset)
+	}
+	if metadata := readTestBundleFile(t, b, "metadata.yaml"); strings.Contains(metadata, "timestamp") {
+		t.Errorf("metadata.yaml still contains the export timestamp:\n%s", metadata)
+	}
+	if got := b.Passwords["databases/examples.yaml"]; got != "secret" {
+		t.Errorf("passwords[databases/examples.yaml] = %q, want %q", got, "secret")
+	}
+}
+
+func TestRewriteAssetBundleUnmatchedDatabaseOverride(t *testing.T) {
+	r := testExportBundle(t, "assets_export", "2026-10-01T12:00:00")
+
+	_, err := rewriteAssetBundle(r, r.Size(), assetBundleOverrides{
+		Databases: map[string]databaseOverride{"missing": {Password: "secret"}},
+	})
+	if err == nil {
+		t.Error("rewriteAssetBundle succeeded, want an error for the unmatched database override")
+	}
+}
+
+func TestReadAssetBundleDir(t *testing.T) {
+	dir := t.TempDir()
+	files := testExportFiles("2026-10-01T12:00:00")
+	files[".git/HEAD"] = "ref: refs/heads/main\n"
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fromDir, err := readAssetBundle(dir, assetBundleOverrides{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := testExportBundle(t, "assets_export", "2026-10-02T08:00:00")
+	fromZip, err := rewriteAssetBundle(r, r.Size(), assetBundleOverrides{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fromDir.sha256() != fromZip.sha256() {
+		t.Errorf("the directory and the ZIP of the same export produced different bundles")
+	}
+
+	if _, err := readAssetBundle(filepath.Join(dir, "datasets"), assetBundleOverrides{}); err == nil {
+		t.Error("readAssetBundle succeeded, want an error for a directory without metadata.yaml")
+	}
+}
+
+func TestAssetBundleFiles(t *testing.T) {
+	r := testExportBundle(t, "dashboard_export_20261001T120000", "2026-10-01T12:00:00")
+	b, err := rewriteAssetBundle(r, r.Size(), assetBundleOverrides{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := b.files()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 3 {
+		t.Errorf("len(files) = %d, want 3", len(files))
+	}
+	if got := files["datasets/examples/orders.yaml"]; !strings.Contains(got, "table_name: orders") {
+		t.Errorf("files[datasets/examples/orders.yaml] = %q", got)
+	}
+}
+
+func TestDecodeAssetBundle(t *testing.T) {
+	r := testExportBundle(t, "assets_export", "2026-10-01T12:00:00")
+	fromZip, err := rewriteAssetBundle(r, r.Size(), assetBundleOverrides{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := make([]byte, r.Size())
+	if _, err := r.ReadAt(data, 0); err != nil {
+		t.Fatal(err)
+	}
+	// The line breaks of wrapped base64 output are ignored.
+	encoded := base64.StdEncoding.EncodeToString(data)
+	var wrapped strings.Builder
+	for i := 0; i < len(encoded); i += 76 {
+		wrapped.WriteString(encoded[i:min(i+76, len(encoded))] + "\n")
+	}
+
+	decoded, err := decodeAssetBundle(wrapped.String(), assetBundleOverrides{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.sha256() != fromZip.sha256() {
+		t.Errorf("the base64 encoded and the ZIP of the same export produced different bundles")
+	}
+
+	if _, err := decodeAssetBundle("not base64!", assetBundleOverrides{}); err == nil {
+		t.Error("decodeAssetBundle succeeded, want an error for invalid base64")
+	}
+}
+
+func TestWriteAssetBundleFile(t *testing.T) {
+	dir := t.TempDir()
+	r := testExportBundle(t, "assets_export", "2026-10-01T12:00:00")
+	exported := filepath.Join(dir, "export.zip")
+	data := make([]byte, r.Size())
+	if _, err := r.ReadAt(data, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(exported, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	outputPath := filepath.Join(dir, "bundle.zip")
+	written, err := writeAssetBundleFile(outputPath, exported, assetBundleOverrides{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if written.Data != nil {
+		t.Error("writeAssetBundleFile kept the bundle in memory")
+	}
+
+	read, err := readAssetBundle(exported, assetBundleOverrides{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(content, read.Data) {
+		t.Error("the written and the read bundle of the same export differ")
+	}
+	sum := sha256.Sum256(content)
+	if written.sha256() != hex.EncodeToString(sum[:]) || read.sha256() != written.sha256() {
+		t.Errorf("sha256() = %s and %s, want the checksum of the file", written.sha256(), read.sha256())
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+// privateKeyLastModified is the private state key holding the modification marker observed
+// the last time the provider read or wrote the object.
+const privateKeyLastModified = "last_modified"
+
+// lastModified is the modification marker of an object. Objects without a changed_on column
+// are tracked by a fingerprint of their server side state instead.
+type lastModified struct {
+	ChangedOn   string `json:"changed_on,omitempty"`
+	ChangedBy   string `json:"changed_by,omitempty"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+func (m lastModified) version() string {
+	if m.ChangedOn != "" {
+		return m.ChangedOn
+	}
+	return m.Fingerprint
+}
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func userLastModified(u *client.SupersetUserApiGet) lastModified {
+	m := lastModified{}
+	m.ChangedOn, _ = u.ChangedOn.Get()
+	if u.ChangedBy.Id != 0 {
+		m.ChangedBy = fmt.Sprintf("user ID %d", u.ChangedBy.Id)
+	}
+	return m
+}
+
+func datasetLastModified(d *client.DatasetRestApiGet) lastModified {
+	m := lastModified{}
+	m.ChangedOn, _ = d.ChangedOn.Get()
+	m.ChangedBy = strings.TrimSpace(d.ChangedBy.FirstName + " " + d.ChangedBy.LastName)
+	return m
+}
+
+func rolePermissionsLastModified(permissions []client.SupersetRolePermissionApiGetList) lastModified {
+	ids := make([]int, 0, len(permissions))
+	for _, p := range permissions {
+		ids = append(ids, p.Id)
+	}
+	return lastModified{Fingerprint: client.PermissionsFingerprint(ids)}
+}
+
+// setLastModified stores the modification marker of the object in the private state.
+func setLastModified(ctx context.Context, p privateStateSetter, m lastModified) diag.Diagnostics {
+	if m.version() == "" {
+		return nil
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Private State Error", fmt.Sprintf("Unable to marshal modification marker: %s", err))
+		return diags
+	}
+
+	return p.SetKey(ctx, privateKeyLastModified, b)
+}
+
+// checkConcurrentModification compares the modification marker stored in the private state with the
+// current one and reports a warning, or an error when fail_on_conflict is enabled, if the object was
+// changed by someone else since Terraform last saw it.
+func checkConcurrentModification(ctx context.Context, p privateStateGetter, cw *client.ClientWrapper, kind string, id int, objectDesc string, current lastModified) diag.Diagnostics {
+	b, diags := p.GetKey(ctx, privateKeyLastModified)
+	if diags.HasError() || len(b) == 0 {
+		return diags
+	}
+
+	var prev lastModified
+	if err := json.Unmarshal(b, &prev); err != nil {
+		tflog.Debug(ctx, "Ignoring unreadable modification marker", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return diags
+	}
+
+	if current.version() == "" || prev.version() == current.version() || cw.IsOwnWrite(kind, id, current.version()) {
+		return diags
+	}
+
+	summary := "Object Modified Outside Terraform"
+	detail := fmt.Sprintf("%s was modified outside Terraform after it was last read.", objectDesc)
+	if current.ChangedOn != "" {
+		changedBy := current.ChangedBy
+		if changedBy == "" {
+			changedBy = "an unknown user"
+		}
+		detail = fmt.Sprintf("%s was modified by %s at %s after Terraform last read it at %s.", objectDesc, changedBy, current.ChangedOn, prev.ChangedOn)
+	}
+
+	if cw.FailOnConflict() {
+		diags.AddError(summary, detail+" The update was not applied. Run terraform apply again to review the changes, "+
+			"or set fail_on_conflict = false in the provider configuration to overwrite them with a warning.")
+	} else {
+		diags.AddWarning(summary, detail+" The update will overwrite those changes.")
+	}
+
+	return diags
+}
+
+// lockDataset takes the lock of the dataset with the given ID until the returned function is called, so that
+// the read-modify-write of a dataset by the dataset resources is not interleaved with another one of the
+// provider.
+func lockDataset(ctx context.Context, cw *client.ClientWrapper, datasetID int) (func(), diag.Diagnostics) {
+	var diags diag.Diagnostics
+	unlock, err := cw.LockDataset(ctx, datasetID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to wait for the other writes of dataset with ID %d: %s", datasetID, err))
+		return func() {}, diags
+	}
+	return unlock, diags
+}
+
+// pruneDatasetFolders removes from the folders of d the columns and metrics that are no longer in d, after
+// a write of the columns or metrics of the dataset deleted some. Superset rejects folders listing unknown
+// columns or metrics, so that a superset_dataset_folder resource of the dataset could no longer be updated
+// otherwise. It returns the dataset after the update, or d when the folders are left as they are.
+func pruneDatasetFolders(ctx context.Context, cw *client.ClientWrapper, d *client.DatasetRestApiGet) (*client.DatasetRestApiGet, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	folders, err := datasetFolders(d)
+	if err != nil {
+		diags.AddError("Folder Conversion Error", fmt.Sprintf("Unable to read folders of dataset with ID %d: %s", d.Id, err))
+		return d, diags
+	}
+	folders, pruned := pruneFolderChildren(folders, d)
+	if !pruned {
+		return d, diags
+	}
+
+	tflog.Debug(ctx, "Removing deleted columns and metrics from the dataset folders", map[string]interface{}{
+		"dataset_id": d.Id,
+	})
+	updated, err := cw.UpdateDataset(ctx, d.Id, client.DatasetRestApiPut{Folders: folders})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update folders of dataset with ID %d: %s", d.Id, err))
+		return d, diags
+	}
+	return updated, diags
+}
@@ -0,0 +1,118 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+// privateKeyCreatedIds is the private state key holding the ids of the sub-objects, e.g. the columns of
+// a dataset or the permissions of a role, the resource created rather than found on the server.
+const privateKeyCreatedIds = "created_ids"
+
+// getCreatedIds returns the ids stored by setCreatedIds. It returns nil when none were stored, e.g. for
+// imported resources and resources created by an earlier version of the provider.
+func getCreatedIds(ctx context.Context, p privateStateGetter) (map[string]bool, diag.Diagnostics) {
+	b, diags := p.GetKey(ctx, privateKeyCreatedIds)
+	if diags.HasError() || len(b) == 0 {
+		return nil, diags
+	}
+
+	var ids []string
+	if err := json.Unmarshal(b, &ids); err != nil {
+		tflog.Debug(ctx, "Ignoring unreadable created ids", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, diags
+	}
+
+	created := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		created[id] = true
+	}
+	return created, diags
+}
+
+// setCreatedIds stores the ids of the sub-objects the resource created in the private state.
+func setCreatedIds(ctx context.Context, p privateStateSetter, created map[string]bool) diag.Diagnostics {
+	ids := make([]string, 0, len(created))
+	for id := range created {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	b, err := json.Marshal(ids)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Private State Error", fmt.Sprintf("Unable to marshal created ids: %s", err))
+		return diags
+	}
+
+	return p.SetKey(ctx, privateKeyCreatedIds, b)
+}
+
+// trackCreatedIds returns the ids of after, the sub-objects after a write, the resource created: the ones
+// already in created and the ones missing from before, the sub-objects before the write.
+func trackCreatedIds(created map[string]bool, before, after []string) map[string]bool {
+	existed := make(map[string]bool, len(before))
+	for _, id := range before {
+		existed[id] = true
+	}
+
+	tracked := make(map[string]bool)
+	for _, id := range after {
+		if created[id] || !existed[id] {
+			tracked[id] = true
+		}
+	}
+	return tracked
+}
+
+func datasetColumnIds(columns []client.DatasetRestApiGetTableColumn) []string {
+	ids := make([]string, 0, len(columns))
+	for _, c := range columns {
+		ids = append(ids, strconv.Itoa(c.Id))
+	}
+	return ids
+}
+
+func datasetMetricIds(metrics []client.DatasetRestApiGetSqlMetric) []string {
+	ids := make([]string, 0, len(metrics))
+	for _, m := range metrics {
+		ids = append(ids, strconv.Itoa(m.Id))
+	}
+	return ids
+}
+
+// datasetFolders returns the root level folders of the dataset.
+func datasetFolders(d *client.DatasetRestApiGet) ([]client.Folder, error) {
+	if d.Folders.IsNull() || !d.Folders.IsSpecified() {
+		return nil, nil
+	}
+	return mapToFolders(d.Folders.MustGet())
+}
+
+func datasetFolderIds(folders []client.Folder) []string {
+	ids := make([]string, 0, len(folders))
+	for _, f := range folders {
+		ids = append(ids, f.Uuid.String())
+	}
+	return ids
+}
+
+func rolePermissionIds(permissions []client.SupersetRolePermissionApiGetList) []string {
+	ids := make([]string, 0, len(permissions))
+	for _, p := range permissions {
+		ids = append(ids, strconv.Itoa(p.Id))
+	}
+	return ids
+}
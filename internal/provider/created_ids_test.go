@@ -0,0 +1,46 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTrackCreatedIds(t *testing.T) {
+	tests := []struct {
+		name          string
+		created       map[string]bool
+		before, after []string
+		want          map[string]bool
+	}{
+		{
+			name:   "create",
+			before: []string{"1", "2"},
+			after:  []string{"1", "2", "3"},
+			want:   map[string]bool{"3": true},
+		},
+		{
+			name:    "update keeps created and drops removed",
+			created: map[string]bool{"3": true, "4": true},
+			before:  []string{"1", "3", "4"},
+			after:   []string{"1", "3", "5"},
+			want:    map[string]bool{"3": true, "5": true},
+		},
+		{
+			name:   "nothing created",
+			before: []string{"1"},
+			after:  []string{"1"},
+			want:   map[string]bool{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := trackCreatedIds(tt.created, tt.before, tt.after); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("trackCreatedIds() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	// Embed the IANA timezone database, so timezones validate the same way on every platform.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// crontabField is the range of values of a field of a crontab.
+type crontabField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+// The fields of a crontab, as evaluated by Superset: minute, hour, day of month, month and day of week.
+var crontabFields = []crontabField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+// crontab is a parsed crontab expression. Each field holds the values it matches.
+type crontab struct {
+	minutes, hours, days, months, weekdays map[int]bool
+	// anyDay and anyWeekday are set when the field is "*". When both day fields are restricted, a day
+	// matches when either of them matches.
+	anyDay, anyWeekday bool
+}
+
+// parseCrontab parses a crontab expression with five fields. Each field is a comma separated list
+// of "*", values and ranges, optionally with a step, e.g. "*/15", "1-5" or "mon,wed,fri".
+func parseCrontab(expr string) (*crontab, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != len(crontabFields) {
+		return nil, fmt.Errorf("expected %d fields, got %d", len(crontabFields), len(fields))
+	}
+
+	values := make([]map[int]bool, len(fields))
+	for i, f := range fields {
+		v, err := crontabFields[i].parse(f)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+
+	// 7 is Sunday as well as 0.
+	if values[4][7] {
+		values[4][0] = true
+	}
+
+	return &crontab{
+		minutes:    values[0],
+		hours:      values[1],
+		days:       values[2],
+		months:     values[3],
+		weekdays:   values[4],
+		anyDay:     fields[2] == "*",
+		anyWeekday: fields[4] == "*",
+	}, nil
+}
+
+func (f crontabField) parse(expr string) (map[int]bool, error) {
+	values := make(map[int]bool)
+	for _, part := range strings.Split(expr, ",") {
+		rng, stepExpr, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			s, err := strconv.Atoi(stepExpr)
+			if err != nil || s < 1 {
+				return nil, fmt.Errorf("invalid step %q in %s field", stepExpr, f.name)
+			}
+			step = s
+		}
+
+		lo, hi := f.min, f.max
+		if rng != "*" {
+			from, to, isRange := strings.Cut(rng, "-")
+			var err error
+			if lo, err = f.value(from); err != nil {
+				return nil, err
+			}
+			hi = lo
+			if isRange {
+				if hi, err = f.value(to); err != nil {
+					return nil, err
+				}
+			} else if hasStep {
+				hi = f.max
+			}
+			if hi < lo {
+				return nil, fmt.Errorf("invalid range %q in %s field", rng, f.name)
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			values[v] = true
+		}
+	}
+	return values, nil
+}
+
+func (f crontabField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return i + f.min, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value %q in %s field, expected %d-%d", s, f.name, f.min, f.max)
+	}
+	return v, nil
+}
+
+func (c *crontab) matchesDay(t time.Time) bool {
+	day, weekday := c.days[t.Day()], c.weekdays[int(t.Weekday())]
+	switch {
+	case c.anyDay && c.anyWeekday:
+		return true
+	case c.anyDay:
+		return weekday
+	case c.anyWeekday:
+		return day
+	default:
+		return day || weekday
+	}
+}
+
+// next returns the first time after after that matches the crontab, in the location of after. It
+// returns false when there is none within five years, e.g. for "0 0 31 2 *".
+func (c *crontab) next(after time.Time) (time.Time, bool) {
+	loc := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		switch {
+		case !c.months[int(t.Month())]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !c.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case !c.hours[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case !c.minutes[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// crontabValidator validates that a string is a crontab expression parseCrontab accepts.
+type crontabValidator struct{}
+
+func (v crontabValidator) Description(ctx context.Context) string {
+	return "value must be a crontab expression with five fields"
+}
+
+func (v crontabValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v crontabValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseCrontab(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Crontab",
+			fmt.Sprintf("%q is not a valid crontab expression: %s", req.ConfigValue.ValueString(), err))
+	}
+}
+
+// timezoneValidator validates that a string is a timezone of the IANA timezone database.
+type timezoneValidator struct{}
+
+func (v timezoneValidator) Description(ctx context.Context) string {
+	return "value must be a timezone of the IANA timezone database, e.g. Asia/Tokyo"
+}
+
+func (v timezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timezoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// LoadLocation accepts "Local" for the timezone of the machine running Terraform.
+	name := req.ConfigValue.ValueString()
+	if _, err := time.LoadLocation(name); err != nil || name == "" || name == "Local" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Timezone",
+			fmt.Sprintf("%q is not a timezone of the IANA timezone database, e.g. Asia/Tokyo.", name))
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCrontabNext(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A Wednesday.
+	after := time.Date(2026, 10, 14, 10, 7, 30, 0, tokyo)
+
+	cases := []struct {
+		expr string
+		want time.Time
+	}{
+		{"*/15 * * * *", time.Date(2026, 10, 14, 10, 15, 0, 0, tokyo)},
+		{"0 9 * * *", time.Date(2026, 10, 15, 9, 0, 0, 0, tokyo)},
+		{"0 9 * * mon", time.Date(2026, 10, 19, 9, 0, 0, 0, tokyo)},
+		{"30 8 1 * *", time.Date(2026, 11, 1, 8, 30, 0, 0, tokyo)},
+		{"0 0 1 jan *", time.Date(2027, 1, 1, 0, 0, 0, 0, tokyo)},
+		{"0 12 * * 7", time.Date(2026, 10, 18, 12, 0, 0, 0, tokyo)},
+		// Both day fields are restricted, so either of them matches.
+		{"0 0 20 * 5", time.Date(2026, 10, 16, 0, 0, 0, 0, tokyo)},
+		{"0 10-18/4 * * 1-5", time.Date(2026, 10, 14, 14, 0, 0, 0, tokyo)},
+	}
+	for _, tc := range cases {
+		c, err := parseCrontab(tc.expr)
+		if err != nil {
+			t.Errorf("parseCrontab(%q) failed: %s", tc.expr, err)
+			continue
+		}
+		got, ok := c.next(after)
+		if !ok || !got.Equal(tc.want) {
+			t.Errorf("next(%q) = %s, %t, want %s", tc.expr, got, ok, tc.want)
+		}
+	}
+}
+
+func TestCrontabNextNever(t *testing.T) {
+	c, err := parseCrontab("0 0 31 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := c.next(time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("next returned %s, want no match", got)
+	}
+}
+
+func TestParseCrontabInvalid(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "* * * foo *"} {
+		if _, err := parseCrontab(expr); err == nil {
+			t.Errorf("parseCrontab(%q) succeeded, want an error", expr)
+		}
+	}
+}
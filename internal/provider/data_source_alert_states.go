@@ -0,0 +1,155 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+// alertStateError is the last_state of an alert whose last run failed.
+const alertStateError = "Error"
+
+var _ datasource.DataSource = &AlertStatesDataSource{}
+
+func NewAlertStatesDataSource() datasource.DataSource {
+	return &AlertStatesDataSource{}
+}
+
+type AlertStatesDataSource struct {
+	client *client.ClientWrapper
+}
+
+type alertStatesDataSourceModel struct {
+	Ids      types.Set         `tfsdk:"ids"`
+	Alerts   []alertStateModel `tfsdk:"alerts"`
+	ErrorIds types.Set         `tfsdk:"error_ids"`
+}
+
+type alertStateModel struct {
+	Id           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Active       types.Bool   `tfsdk:"active"`
+	LastState    types.String `tfsdk:"last_state"`
+	LastEvalDttm types.String `tfsdk:"last_eval_dttm"`
+}
+
+func (d *AlertStatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_states"
+}
+
+func (d *AlertStatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the state of the last run of the alerts, e.g. to fail a `check` block when a managed alert is in error.",
+
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Only return the alerts with these IDs, e.g. the IDs of the `superset_alert` resources of the configuration. Defaults to all alerts.",
+			},
+			"alerts": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The alerts, ordered by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the alert.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the alert.",
+						},
+						"active": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the alert is scheduled.",
+						},
+						"last_state": schema.StringAttribute{
+							Computed: true,
+							MarkdownDescription: "The state of the last run of the alert: `Success`, `Working`, `Error`, `Not triggered` or `On Grace`. " +
+								"Null when the alert has not run yet.",
+						},
+						"last_eval_dttm": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The time of the last run of the alert, in UTC.",
+						},
+					},
+				},
+			},
+			"error_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the alerts whose last run failed.",
+			},
+		},
+	}
+}
+
+func (d *AlertStatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *AlertStatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data alertStatesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	states, err := d.client.ListReportScheduleStates(ctx, client.ReportScheduleTypeAlert)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list alerts: %s", err))
+		return
+	}
+
+	ids := int64SetToInts(data.Ids)
+
+	data.Alerts = make([]alertStateModel, 0, len(states))
+	errorIds := make([]int64, 0)
+	for _, s := range states {
+		if !data.Ids.IsNull() && !slices.Contains(ids, s.Id) {
+			continue
+		}
+		data.Alerts = append(data.Alerts, alertStateModel{
+			Id:           types.Int64Value(int64(s.Id)),
+			Name:         types.StringValue(s.Name),
+			Active:       types.BoolValue(nullableBoolValue(s.Active)),
+			LastState:    emptyAsNull(nullableStringValue(s.LastState)),
+			LastEvalDttm: nullableStringValue(s.LastEvalDttm),
+		})
+		if nullableStringValue(s.LastState).ValueString() == alertStateError {
+			errorIds = append(errorIds, int64(s.Id))
+		}
+	}
+
+	errorIdsValue, diags := types.SetValueFrom(ctx, types.Int64Type, errorIds)
+	resp.Diagnostics.Append(diags...)
+	data.ErrorIds = errorIdsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
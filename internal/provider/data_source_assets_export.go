@@ -0,0 +1,128 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &AssetsExportDataSource{}
+
+func NewAssetsExportDataSource() datasource.DataSource {
+	return &AssetsExportDataSource{}
+}
+
+type AssetsExportDataSource struct {
+	client *client.ClientWrapper
+}
+
+type assetsExportDataSourceModel struct {
+	OutputPath    types.String `tfsdk:"output_path"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Sha256        types.String `tfsdk:"sha256"`
+	Assets        types.Map    `tfsdk:"assets"`
+}
+
+func (d *AssetsExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_assets_export"
+}
+
+func (d *AssetsExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Export all databases, datasets, charts, dashboards and saved queries of a Superset environment to a ZIP bundle, " +
+			"to promote them to another environment with the `superset_asset_promotion` resource. " +
+			"The export timestamp is removed and the files are sorted, so the bundle only changes when the assets change. " +
+			"Without `output_path`, the bundle is kept in the state as `content_base64`, so that runs without a shared filesystem, e.g. on Terraform Cloud agents, can pass it to `bundle_base64`.",
+
+		Attributes: map[string]schema.Attribute{
+			"output_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The path the bundle is written to. An existing file is overwritten. When set, the bundle is streamed to the file and `content_base64` is null, so large bundles are neither kept in memory nor in the state.",
+			},
+			"content_base64": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ZIP bundle, base64 encoded. Null when `output_path` is set.",
+			},
+			"sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 checksum of the bundle.",
+			},
+			"assets": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The UUIDs of the exported assets, keyed by their file in the bundle, e.g. `dashboards/Sales_1.yaml`.",
+			},
+		},
+	}
+}
+
+func (d *AssetsExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *AssetsExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data assetsExportDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exported, err := d.client.ExportAssets(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to export assets: %s", err))
+		return
+	}
+	defer os.Remove(exported)
+
+	// With output_path, the bundle is streamed to the file, so that large bundles are not kept in
+	// memory or in the state.
+	var bundle *assetBundle
+	if !data.OutputPath.IsNull() {
+		bundle, err = writeAssetBundleFile(data.OutputPath.ValueString(), exported, assetBundleOverrides{})
+		if err != nil {
+			resp.Diagnostics.AddError("Bundle Error", fmt.Sprintf("Unable to write bundle to '%s': %s", data.OutputPath.ValueString(), err))
+			return
+		}
+		data.ContentBase64 = types.StringNull()
+	} else {
+		bundle, err = readAssetBundle(exported, assetBundleOverrides{})
+		if err != nil {
+			resp.Diagnostics.AddError("Bundle Error", fmt.Sprintf("Unable to read the exported bundle: %s", err))
+			return
+		}
+		data.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString(bundle.Data))
+	}
+
+	data.Sha256 = types.StringValue(bundle.sha256())
+	assets, diags := types.MapValueFrom(ctx, types.StringType, bundle.Assets)
+	resp.Diagnostics.Append(diags...)
+	data.Assets = assets
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
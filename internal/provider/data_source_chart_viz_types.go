@@ -0,0 +1,112 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &ChartVizTypesDataSource{}
+
+func NewChartVizTypesDataSource() datasource.DataSource {
+	return &ChartVizTypesDataSource{}
+}
+
+type ChartVizTypesDataSource struct {
+	client *client.ClientWrapper
+}
+
+type chartVizTypesDataSourceModel struct {
+	chartVizTypesBaseModel
+}
+
+func (d *ChartVizTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chart_viz_types"
+}
+
+func (d *ChartVizTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the visualization types available for the `viz_type` of charts: the types of the plugins bundled with Superset, and the types used by the charts of the server, e.g. of custom plugins. " +
+			"Superset registers visualization plugins in its frontend and does not expose them through its REST API, so a custom plugin is only listed once a chart uses it.",
+
+		Attributes: map[string]schema.Attribute{
+			"required": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Visualization types that must be available. Reading the data source fails with an error listing the missing types otherwise.",
+			},
+			"viz_types": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The available visualization types.",
+			},
+			"custom_viz_types": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The visualization types used by charts that are not bundled with Superset, e.g. of custom plugins or of plugins removed from recent releases.",
+			},
+			"chart_counts": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The number of charts of each visualization type used by charts, keyed by type.",
+			},
+		},
+	}
+}
+
+func (d *ChartVizTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ChartVizTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data chartVizTypesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	counts, err := d.client.CountChartVizTypes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list chart visualization types: %s", err))
+		return
+	}
+
+	if missing := data.missingRequired(counts); len(missing) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("required"),
+			"Required Visualization Types Not Available",
+			fmt.Sprintf("The following visualization types are neither bundled with Superset nor used by a chart of the server: %s. "+
+				"Check the spelling, or deploy the plugins; a custom plugin is only known once a chart uses it.", strings.Join(missing, ", ")),
+		)
+		return
+	}
+
+	data.updateState(counts)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,154 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &DashboardExportDataSource{}
+
+func NewDashboardExportDataSource() datasource.DataSource {
+	return &DashboardExportDataSource{}
+}
+
+type DashboardExportDataSource struct {
+	client *client.ClientWrapper
+}
+
+type dashboardExportDataSourceModel struct {
+	DashboardIds  types.Set    `tfsdk:"dashboard_ids"`
+	OutputPath    types.String `tfsdk:"output_path"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Files         types.Map    `tfsdk:"files"`
+	Sha256        types.String `tfsdk:"sha256"`
+	Assets        types.Map    `tfsdk:"assets"`
+}
+
+func (d *DashboardExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_export"
+}
+
+func (d *DashboardExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Export dashboards with their charts, datasets and databases, e.g. to diff the dashboards of two environments in CI or to archive them. " +
+			"The export timestamp is removed and the files are sorted, so the export only changes when the dashboards change. " +
+			"The export is kept in the state unless `output_path` is set, so use `output_path` for large exports.",
+
+		Attributes: map[string]schema.Attribute{
+			"dashboard_ids": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the dashboards to export.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"output_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The path the ZIP bundle is written to, e.g. to import it with `superset_import_bundle`. An existing file is overwritten. When set, `content_base64` and `files` are null, so the bundle is not kept in the state.",
+			},
+			"content_base64": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ZIP bundle, base64 encoded. Null when `output_path` is set.",
+			},
+			"files": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The YAML files of the bundle, keyed by their path in the bundle, e.g. `dashboards/Sales_1.yaml`. Null when `output_path` is set.",
+			},
+			"sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 checksum of the bundle.",
+			},
+			"assets": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The UUIDs of the exported objects, keyed by their file in the bundle.",
+			},
+		},
+	}
+}
+
+func (d *DashboardExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *DashboardExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dashboardExportDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := int64SetToInts(data.DashboardIds)
+	exported, err := d.client.ExportDashboards(ctx, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to export Dashboards with IDs %v: %s", ids, err))
+		return
+	}
+	defer os.Remove(exported)
+
+	// With output_path, the bundle is streamed to the file, so that large exports are not kept in
+	// memory or in the state.
+	var bundle *assetBundle
+	var diags diag.Diagnostics
+	if !data.OutputPath.IsNull() {
+		bundle, err = writeAssetBundleFile(data.OutputPath.ValueString(), exported, assetBundleOverrides{})
+		if err != nil {
+			resp.Diagnostics.AddError("Bundle Error", fmt.Sprintf("Unable to write bundle to '%s': %s", data.OutputPath.ValueString(), err))
+			return
+		}
+		data.ContentBase64 = types.StringNull()
+		data.Files = types.MapNull(types.StringType)
+	} else {
+		bundle, err = readAssetBundle(exported, assetBundleOverrides{})
+		if err != nil {
+			resp.Diagnostics.AddError("Bundle Error", fmt.Sprintf("Unable to read the exported bundle: %s", err))
+			return
+		}
+		files, err := bundle.files()
+		if err != nil {
+			resp.Diagnostics.AddError("Bundle Error", fmt.Sprintf("Unable to read the exported bundle: %s", err))
+			return
+		}
+		data.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString(bundle.Data))
+		data.Files, diags = types.MapValueFrom(ctx, types.StringType, files)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	data.Sha256 = types.StringValue(bundle.sha256())
+	data.Assets, diags = types.MapValueFrom(ctx, types.StringType, bundle.Assets)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
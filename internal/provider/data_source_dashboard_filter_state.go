@@ -0,0 +1,177 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &DashboardFilterStateDataSource{}
+
+func NewDashboardFilterStateDataSource() datasource.DataSource {
+	return &DashboardFilterStateDataSource{}
+}
+
+type DashboardFilterStateDataSource struct {
+	client *client.ClientWrapper
+}
+
+type dashboardFilterStateDataSourceModel struct {
+	dashboardFilterStateBaseModel
+}
+
+func (d *DashboardFilterStateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_filter_state"
+}
+
+func (d *DashboardFilterStateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the native filters of a dashboard, e.g. to give new dashboards the filters of an existing one. " +
+			"The filters are read from the `native_filter_configuration` of the `json_metadata` of the dashboard, in the order of the filter bar. " +
+			"The filters target datasets by ID, so copying them to another server requires replacing the dataset IDs.",
+
+		Attributes: map[string]schema.Attribute{
+			"dashboard_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the dashboard.",
+			},
+			"dashboard_title": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The title of the dashboard.",
+			},
+			"cross_filters_enabled": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the charts of the dashboard filter each other. Null when the dashboard does not set it.",
+			},
+			"native_filter_configuration": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The native filters of the dashboard as the JSON array Superset stores, e.g. to copy them as a whole with `jsondecode`.",
+			},
+			"filters": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The native filters and dividers of the dashboard, in the order of the filter bar.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the filter, e.g. `NATIVE_FILTER-1a2b3c`.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the filter.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the entry: `NATIVE_FILTER` or `DIVIDER`.",
+						},
+						"filter_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the filter, e.g. `filter_select`, `filter_range` or `filter_time`. Null for dividers.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The description of the filter.",
+						},
+						"targets": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The dataset columns the filter applies to.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"dataset_id": schema.Int64Attribute{
+										Computed:            true,
+										MarkdownDescription: "The ID of the dataset.",
+									},
+									"column_name": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The name of the column. Null for filters on time ranges or time grains.",
+									},
+								},
+							},
+						},
+						"cascade_parent_ids": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The IDs of the filters whose values restrict the values of the filter.",
+						},
+						"scope_root_path": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The IDs of the layout components, e.g. `ROOT_ID` or tabs, whose charts the filter applies to.",
+						},
+						"scope_excluded": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.Int64Type,
+							MarkdownDescription: "The IDs of the charts of the scope the filter does not apply to.",
+						},
+						"charts_in_scope": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.Int64Type,
+							MarkdownDescription: "The IDs of the charts the filter applies to, as last computed by Superset.",
+						},
+						"control_values": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The settings of the filter as JSON, e.g. `{\"multiSelect\": true}`.",
+						},
+						"default_data_mask": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The default value of the filter as JSON.",
+						},
+						"configuration": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The filter as the JSON object Superset stores.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DashboardFilterStateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *DashboardFilterStateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dashboardFilterStateDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard, err := d.client.GetDashboard(ctx, int(data.DashboardId.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get dashboard with ID %d: %s", data.DashboardId.ValueInt64(), err))
+		return
+	}
+
+	if err := data.updateState(ctx, dashboard.Id, dashboard.DashboardTitle, dashboard.JsonMetadata); err != nil {
+		resp.Diagnostics.AddError("Filter Conversion Error", fmt.Sprintf("Unable to read the native filters of dashboard with ID %d: %s", dashboard.Id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
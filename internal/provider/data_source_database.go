@@ -0,0 +1,175 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &DatabaseDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &DatabaseDataSource{}
+
+func NewDatabaseDataSource() datasource.DataSource {
+	return &DatabaseDataSource{}
+}
+
+type DatabaseDataSource struct {
+	client *client.ClientWrapper
+}
+
+type databaseDataSourceModel struct {
+	Id              types.Int64  `tfsdk:"id"`
+	DatabaseName    types.String `tfsdk:"database_name"`
+	SqlalchemyUri   types.String `tfsdk:"sqlalchemy_uri"`
+	Backend         types.String `tfsdk:"backend"`
+	Driver          types.String `tfsdk:"driver"`
+	ExposeInSqllab  types.Bool   `tfsdk:"expose_in_sqllab"`
+	AllowCtas       types.Bool   `tfsdk:"allow_ctas"`
+	AllowCvas       types.Bool   `tfsdk:"allow_cvas"`
+	AllowDml        types.Bool   `tfsdk:"allow_dml"`
+	AllowFileUpload types.Bool   `tfsdk:"allow_file_upload"`
+	AllowRunAsync   types.Bool   `tfsdk:"allow_run_async"`
+	Uuid            types.String `tfsdk:"uuid"`
+}
+
+func (model *databaseDataSourceModel) updateState(db *client.DatabaseConnectionSchema) {
+	model.Id = types.Int64Value(int64(db.Id))
+	model.DatabaseName = nullableStringValue(db.DatabaseName)
+	model.SqlalchemyUri = types.StringValue(db.SqlalchemyUri)
+	model.Backend = nullableStringValue(db.Backend)
+	model.Driver = nullableStringValue(db.Driver)
+	model.ExposeInSqllab = types.BoolValue(db.ExposeInSqllab)
+	model.AllowCtas = types.BoolValue(db.AllowCtas)
+	model.AllowCvas = types.BoolValue(db.AllowCvas)
+	model.AllowDml = types.BoolValue(db.AllowDml)
+	model.AllowFileUpload = types.BoolValue(db.AllowFileUpload)
+	model.AllowRunAsync = types.BoolValue(db.AllowRunAsync)
+	model.Uuid = types.StringValue(db.Uuid)
+}
+
+func (d *DatabaseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+func (d *DatabaseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up an existing database connection by ID or name, e.g. to reference a database that is managed outside of the configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the database. Exactly one of `id` and `database_name` must be set.",
+			},
+			"database_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the database. Exactly one of `id` and `database_name` must be set.",
+			},
+			"sqlalchemy_uri": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SQLAlchemy URI of the database, with the password masked.",
+			},
+			"backend": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The backend of the database, e.g. `postgresql`.",
+			},
+			"driver": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The driver of the database, e.g. `psycopg2`.",
+			},
+			"expose_in_sqllab": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the database is available in SQL Lab.",
+			},
+			"allow_ctas": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether `CREATE TABLE AS` is allowed in SQL Lab.",
+			},
+			"allow_cvas": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether `CREATE VIEW AS` is allowed in SQL Lab.",
+			},
+			"allow_dml": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether DML statements such as `UPDATE` are allowed in SQL Lab.",
+			},
+			"allow_file_upload": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether files can be uploaded to the database.",
+			},
+			"allow_run_async": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether queries are run asynchronously.",
+			},
+			"uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UUID of the database.",
+			},
+		},
+	}
+}
+
+func (d *DatabaseDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("database_name")),
+	}
+}
+
+func (d *DatabaseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *DatabaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data databaseDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(data.Id.ValueInt64())
+	if data.Id.IsNull() {
+		db, err := d.client.FindDatabase(ctx, data.DatabaseName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find database '%s': %s", data.DatabaseName.ValueString(), err))
+			return
+		}
+		id = db.Id
+	}
+
+	db, err := d.client.GetDatabaseConnection(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database with ID %d: %s", id, err))
+		return
+	}
+
+	data.updateState(db)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,180 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &DatabaseByEngineDataSource{}
+
+func NewDatabaseByEngineDataSource() datasource.DataSource {
+	return &DatabaseByEngineDataSource{}
+}
+
+type DatabaseByEngineDataSource struct {
+	client *client.ClientWrapper
+}
+
+func (d *DatabaseByEngineDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_by_engine"
+}
+
+func (d *DatabaseByEngineDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up the only database connection of a backend, e.g. the `trino` warehouse connection of each environment, without knowing its name. " +
+			"Fails when several databases use the backend, in which case `superset_database` must be used with the name instead.",
+
+		Attributes: map[string]schema.Attribute{
+			"backend": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The backend of the database, e.g. `trino` or `postgresql`. The comparison is case-insensitive.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the database.",
+			},
+			"database_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the database.",
+			},
+			"sqlalchemy_uri": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SQLAlchemy URI of the database, with the password masked.",
+			},
+			"driver": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The driver of the database, e.g. `psycopg2`.",
+			},
+			"expose_in_sqllab": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the database is available in SQL Lab.",
+			},
+			"allow_ctas": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether `CREATE TABLE AS` is allowed in SQL Lab.",
+			},
+			"allow_cvas": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether `CREATE VIEW AS` is allowed in SQL Lab.",
+			},
+			"allow_dml": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether DML statements such as `UPDATE` are allowed in SQL Lab.",
+			},
+			"allow_file_upload": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether files can be uploaded to the database.",
+			},
+			"allow_run_async": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether queries are run asynchronously.",
+			},
+			"uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UUID of the database.",
+			},
+		},
+	}
+}
+
+func (d *DatabaseByEngineDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *DatabaseByEngineDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data databaseDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databases, err := d.client.ListDatabases(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list databases: %s", err))
+		return
+	}
+
+	backend := data.Backend.ValueString()
+	var matches []client.SupersetDatabaseApiGetList
+	backends := make(map[string]bool)
+	for _, db := range databases {
+		b, _ := db.Backend.(string)
+		backends[b] = true
+		if strings.EqualFold(b, backend) {
+			matches = append(matches, db)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		available := make([]string, 0, len(backends))
+		for b := range backends {
+			available = append(available, b)
+		}
+		sort.Strings(available)
+		resp.Diagnostics.AddAttributeError(
+			path.Root("backend"),
+			"Database Not Found",
+			fmt.Sprintf("No database uses the backend %s. The databases use: %s", backend, strings.Join(available, ", ")),
+		)
+		return
+	case 1:
+	default:
+		names := make([]string, 0, len(matches))
+		for _, db := range matches {
+			names = append(names, fmt.Sprintf("%s (id=%d)", db.DatabaseName, db.Id))
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("backend"),
+			"Ambiguous Database",
+			fmt.Sprintf("%d databases use the backend %s: %s. Use the superset_database data source with the name of one of them instead.",
+				len(matches), backend, strings.Join(names, ", ")),
+		)
+		return
+	}
+
+	db, err := d.client.GetDatabaseConnection(ctx, matches[0].Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database with ID %d: %s", matches[0].Id, err))
+		return
+	}
+
+	data.updateState(db)
+	// Keep the backend as configured, whatever its case.
+	data.Backend = types.StringValue(backend)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,125 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &DatasetHclDataSource{}
+
+func NewDatasetHclDataSource() datasource.DataSource {
+	return &DatasetHclDataSource{}
+}
+
+type DatasetHclDataSource struct {
+	client *client.ClientWrapper
+}
+
+type datasetHclDataSourceModel struct {
+	datasetHclBaseModel
+}
+
+func (d *DatasetHclDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_hcl"
+}
+
+func (d *DatasetHclDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generate the Terraform configuration of an existing superset dataset. " +
+			"The `hcl` attribute holds ready to paste `superset_dataset`, `superset_dataset_columns`, `superset_dataset_metrics` and `superset_dataset_folder` resources " +
+			"matching the current columns, metrics and folders of the dataset, to bring datasets created in the Superset UI under Terraform management.",
+
+		Attributes: map[string]schema.Attribute{
+			"table_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the dataset.",
+			},
+			"schema": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The schema of the dataset, when the same name exists in several schemas.",
+			},
+			"database_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The database name of the dataset, when the same name exists in several databases.",
+			},
+			"resource_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the generated resources. Defaults to the table name converted to a valid identifier.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`), "must be a valid Terraform resource name"),
+				},
+			},
+			"dataset_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the dataset.",
+			},
+			"hcl": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The generated Terraform configuration.",
+			},
+		},
+	}
+}
+
+func (d *DatasetHclDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *DatasetHclDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasetHclDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	qualifier := client.DatasetQualifier{
+		TableName:    data.TableName.ValueString(),
+		Schema:       data.Schema.ValueString(),
+		DatabaseName: data.DatabaseName.ValueString(),
+	}
+	found, err := d.client.FindQualifiedDataset(ctx, qualifier)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find dataset '%s': %s", qualifier, err))
+		return
+	}
+
+	dataset, err := d.client.GetDataset(ctx, found.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get dataset with ID %d: %s", found.Id, err))
+		return
+	}
+
+	if err := data.updateState(dataset); err != nil {
+		resp.Diagnostics.AddError("HCL Generation Error", fmt.Sprintf("Unable to generate configuration for dataset with ID %d: %s", dataset.Id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
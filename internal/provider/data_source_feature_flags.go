@@ -0,0 +1,107 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &FeatureFlagsDataSource{}
+
+func NewFeatureFlagsDataSource() datasource.DataSource {
+	return &FeatureFlagsDataSource{}
+}
+
+type FeatureFlagsDataSource struct {
+	client *client.ClientWrapper
+}
+
+type featureFlagsDataSourceModel struct {
+	featureFlagsBaseModel
+}
+
+func (d *FeatureFlagsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_flags"
+}
+
+func (d *FeatureFlagsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the feature flags of the Superset server, such as `DASHBOARD_RBAC`, `EMBEDDED_SUPERSET` or `TAGGING_SYSTEM`. " +
+			"Superset does not expose feature flags through its REST API, so they are read from the bootstrap data embedded in the login page.",
+
+		Attributes: map[string]schema.Attribute{
+			"required": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Feature flags that must be enabled on the server. Reading the data source fails with an error listing the missing flags otherwise.",
+			},
+			"flags": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.BoolType,
+				MarkdownDescription: "All boolean feature flags of the server, keyed by name.",
+			},
+			"enabled": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the enabled feature flags.",
+			},
+		},
+	}
+}
+
+func (d *FeatureFlagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *FeatureFlagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data featureFlagsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flags, err := d.client.GetFeatureFlags(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read feature flags: %s", err))
+		return
+	}
+
+	if missing := data.missingRequired(flags); len(missing) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("required"),
+			"Required Feature Flags Not Enabled",
+			fmt.Sprintf("The following feature flags are not enabled on the Superset server: %s. "+
+				"Enable them in the FEATURE_FLAGS dictionary of superset_config.py and restart the server.", strings.Join(missing, ", ")),
+		)
+		return
+	}
+
+	data.updateState(flags)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
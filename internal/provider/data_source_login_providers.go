@@ -0,0 +1,123 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &LoginProvidersDataSource{}
+
+func NewLoginProvidersDataSource() datasource.DataSource {
+	return &LoginProvidersDataSource{}
+}
+
+type LoginProvidersDataSource struct {
+	client *client.ClientWrapper
+}
+
+type loginProvidersDataSourceModel struct {
+	loginProvidersBaseModel
+}
+
+func (d *LoginProvidersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_login_providers"
+}
+
+func (d *LoginProvidersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the authentication type of the Superset server and the login providers its API accepts, e.g. to check the `auth_provider` of the provider configuration. " +
+			"Superset does not expose its authentication configuration through its REST API, so it is read from the bootstrap data embedded in the login page. " +
+			"Older versions of Superset do not announce it there, and the attributes are null or empty.",
+
+		Attributes: map[string]schema.Attribute{
+			"required": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Login providers, e.g. `db` or `ldap`, that the API of the server must accept. Reading the data source fails with an error listing the providers the server does not accept otherwise.",
+			},
+			"auth_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The authentication type of the server: `db`, `ldap`, `oauth`, `remote_user` or `oid`. Null when the server does not announce it.",
+			},
+			"login_providers": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The login providers the API of the server accepts for `auth_provider`. Empty when the authentication type has no API login, e.g. `oauth`, which requires an `access_token`, or when the server does not announce it.",
+			},
+			"oauth_providers": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the OAuth providers users log in to the UI with, when the authentication type is `oauth`.",
+			},
+		},
+	}
+}
+
+func (d *LoginProvidersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *LoginProvidersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data loginProvidersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bootstrap, err := d.client.GetBootstrapData(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the authentication configuration: %s", err))
+		return
+	}
+
+	data.updateState(bootstrap)
+
+	if missing := data.missingRequired(bootstrap); len(missing) > 0 {
+		authType, ok := bootstrap.AuthType()
+		if !ok {
+			// Older versions do not announce the authentication type, so the providers cannot be checked.
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("required"),
+				"Login Providers Not Announced",
+				"The Superset server does not announce its authentication type, so the required login providers could not be checked.",
+			)
+		} else {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("required"),
+				"Required Login Providers Not Accepted",
+				fmt.Sprintf("The Superset server uses the %q authentication type and its API does not accept the following login providers: %s. "+
+					"Change AUTH_TYPE in superset_config.py, or the auth_provider of the provider configuration.", authType, strings.Join(missing, ", ")),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,197 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+const defaultLogsMaxResults = 1000
+
+// supersetLogTimeFormat is the format of the naive UTC timestamps stored in the Superset logs table.
+const supersetLogTimeFormat = "2006-01-02T15:04:05"
+
+var _ datasource.DataSource = &LogsDataSource{}
+
+func NewLogsDataSource() datasource.DataSource {
+	return &LogsDataSource{}
+}
+
+type LogsDataSource struct {
+	client *client.ClientWrapper
+}
+
+type logsDataSourceModel struct {
+	logsBaseModel
+}
+
+func (d *LogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_logs"
+}
+
+func (d *LogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the action logs of the Superset server, such as dashboard views and chart queries, newest first. " +
+			"Useful to export audit trails into other systems.",
+
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return logs of the user with this username.",
+			},
+			"action": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return logs with this action, e.g. `log` or `DashboardRestApi.get`.",
+			},
+			"since": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return logs recorded after this time, in RFC 3339 format.",
+			},
+			"until": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return logs recorded before this time, in RFC 3339 format.",
+			},
+			"max_results": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The maximum number of logs to return. Defaults to %d.", defaultLogsMaxResults),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"logs": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching logs, newest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the log entry.",
+						},
+						"action": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The logged action.",
+						},
+						"username": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The username of the user who performed the action.",
+						},
+						"dttm": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The time the action was logged, in UTC.",
+						},
+						"dashboard_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the dashboard the action relates to.",
+						},
+						"slice_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the chart the action relates to.",
+						},
+						"duration_ms": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The duration of the action in milliseconds.",
+						},
+						"referrer": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The referrer of the request.",
+						},
+						"json": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Additional details of the action as a JSON string.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *LogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data logsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := client.LogFilter{
+		Action: data.Action.ValueString(),
+	}
+
+	for _, t := range []struct {
+		name   string
+		value  types.String
+		target *string
+	}{
+		{"since", data.Since, &filter.Since},
+		{"until", data.Until, &filter.Until},
+	} {
+		if t.value.IsNull() {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, t.value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(t.name), "Invalid Time", fmt.Sprintf("Unable to parse %s as an RFC 3339 time: %s", t.name, err))
+			continue
+		}
+		*t.target = parsed.UTC().Format(supersetLogTimeFormat)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Username.IsNull() {
+		u, err := d.client.FindUser(ctx, data.Username.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user with username '%s': %s", data.Username.ValueString(), err))
+			return
+		}
+		filter.UserId = u.Id
+	}
+
+	maxResults := defaultLogsMaxResults
+	if !data.MaxResults.IsNull() {
+		maxResults = int(data.MaxResults.ValueInt64())
+	}
+
+	logs, err := d.client.ListLogs(ctx, filter, maxResults)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read logs: %s", err))
+		return
+	}
+
+	data.updateState(logs)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
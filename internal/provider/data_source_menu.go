@@ -0,0 +1,123 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &MenuDataSource{}
+
+func NewMenuDataSource() datasource.DataSource {
+	return &MenuDataSource{}
+}
+
+type MenuDataSource struct {
+	client *client.ClientWrapper
+}
+
+type menuDataSourceModel struct {
+	menuBaseModel
+}
+
+func (d *MenuDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_menu"
+}
+
+func (d *MenuDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	menuItemAttributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The internal name of the menu item, which maps to the permission name.",
+		},
+		"label": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The display label of the menu item.",
+		},
+		"icon": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The icon name of the menu item.",
+		},
+		"url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The URL of the menu item.",
+		},
+	}
+
+	itemAttributes := map[string]schema.Attribute{
+		"children": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The child items of the menu item.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: menuItemAttributes,
+			},
+		},
+	}
+	for k, v := range menuItemAttributes {
+		itemAttributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the menu structure visible to the authenticated user. The menu reflects the effective permissions of the provider account.",
+
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The top level menu items.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: itemAttributes,
+				},
+			},
+			"names": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of all menu items, including children, without separators.",
+			},
+		},
+	}
+}
+
+func (d *MenuDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *MenuDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data menuDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	menu, err := d.client.GetMenu(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read menu: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.updateState(menu)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,169 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &ObjectsByOwnerDataSource{}
+
+func NewObjectsByOwnerDataSource() datasource.DataSource {
+	return &ObjectsByOwnerDataSource{}
+}
+
+type ObjectsByOwnerDataSource struct {
+	client *client.ClientWrapper
+}
+
+type objectsByOwnerDataSourceModel struct {
+	Username     types.String `tfsdk:"username"`
+	UserId       types.Int64  `tfsdk:"user_id"`
+	Dashboards   types.List   `tfsdk:"dashboards"`
+	Charts       types.List   `tfsdk:"charts"`
+	Datasets     types.List   `tfsdk:"datasets"`
+	SavedQueries types.List   `tfsdk:"saved_queries"`
+}
+
+// ownedObjectAttrTypes are the attribute types of an object of the superset_objects_by_owner data source.
+var ownedObjectAttrTypes = map[string]attr.Type{
+	"id":        types.Int64Type,
+	"name":      types.StringType,
+	"owner_ids": types.ListType{ElemType: types.Int64Type},
+}
+
+// objects returns the attribute holding the objects of kind.
+func (model *objectsByOwnerDataSourceModel) objects(kind string) *types.List {
+	switch kind {
+	case client.AssetKindDashboard:
+		return &model.Dashboards
+	case client.AssetKindChart:
+		return &model.Charts
+	case client.AssetKindDataset:
+		return &model.Datasets
+	default:
+		return &model.SavedQueries
+	}
+}
+
+// ownedObjectsValue returns assets as a list of objects of the superset_objects_by_owner data source.
+func ownedObjectsValue(assets []client.OwnedAsset) types.List {
+	objType := types.ObjectType{AttrTypes: ownedObjectAttrTypes}
+	elems := make([]attr.Value, 0, len(assets))
+	for _, a := range assets {
+		ownerIds := make([]attr.Value, 0, len(a.Owners))
+		for _, id := range a.Owners {
+			ownerIds = append(ownerIds, types.Int64Value(int64(id)))
+		}
+		elems = append(elems, types.ObjectValueMust(ownedObjectAttrTypes, map[string]attr.Value{
+			"id":        types.Int64Value(int64(a.Id)),
+			"name":      types.StringValue(a.Name),
+			"owner_ids": types.ListValueMust(types.Int64Type, ownerIds),
+		}))
+	}
+	return types.ListValueMust(objType, elems)
+}
+
+func (d *ObjectsByOwnerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objects_by_owner"
+}
+
+func (d *ObjectsByOwnerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	objects := func(description string) schema.ListNestedAttribute {
+		return schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: description,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "The ID of the object.",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The title of the dashboard, the name of the chart, the table name of the dataset or the label of the saved query.",
+					},
+					"owner_ids": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.Int64Type,
+						MarkdownDescription: "The IDs of all owners of the object, or of the creator of a saved query.",
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the dashboards, charts, datasets and saved queries owned by a user, e.g. to audit ownership before a `superset_owner_transfer` or the removal of the user. " +
+			"Saved queries are owned by the user who created them.",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The username of the owner.",
+			},
+			"user_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the owner.",
+			},
+			"dashboards":    objects("The dashboards owned by the user, ordered by ID."),
+			"charts":        objects("The charts owned by the user, ordered by ID."),
+			"datasets":      objects("The datasets owned by the user, ordered by ID."),
+			"saved_queries": objects("The saved queries created by the user, ordered by ID."),
+		},
+	}
+}
+
+func (d *ObjectsByOwnerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ObjectsByOwnerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data objectsByOwnerDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := d.client.FindUser(ctx, data.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user with username %s: %s", data.Username.ValueString(), err))
+		return
+	}
+	data.UserId = types.Int64Value(int64(user.Id))
+
+	for _, kind := range []string{client.AssetKindDashboard, client.AssetKindChart, client.AssetKindDataset, client.AssetKindSavedQuery} {
+		assets, err := d.client.ListOwnedAssets(ctx, kind, user.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list the %ss owned by %s: %s", kind, data.Username.ValueString(), err))
+			return
+		}
+		*data.objects(kind) = ownedObjectsValue(assets)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,180 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &PermissionsDataSource{}
+
+func NewPermissionsDataSource() datasource.DataSource {
+	return &PermissionsDataSource{}
+}
+
+type PermissionsDataSource struct {
+	client *client.ClientWrapper
+}
+
+type permissionsDataSourceModel struct {
+	PermissionName types.String                `tfsdk:"permission_name"`
+	ViewMenuName   types.String                `tfsdk:"view_menu_name"`
+	Permissions    []permissionDataSourceModel `tfsdk:"permissions"`
+	Ids            types.Set                   `tfsdk:"ids"`
+}
+
+type permissionDataSourceModel struct {
+	Id             types.Int64  `tfsdk:"id"`
+	PermissionName types.String `tfsdk:"permission_name"`
+	ViewMenuName   types.String `tfsdk:"view_menu_name"`
+}
+
+// wildcardPattern compiles a pattern in which `*` matches any sequence of characters and `?` any single
+// character. Everything else matches literally, as view menu names such as `[db].[schema]` contain
+// characters special to other pattern syntaxes.
+func wildcardPattern(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+func (d *PermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+func (d *PermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the permissions of the Superset instance, e.g. to generate the `permissions` of a `superset_role_permissions` resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"permission_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the permissions with this name, e.g. `datasource_access`. `*` matches any sequence of characters and `?` any single character. Defaults to all permissions.",
+			},
+			"view_menu_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the permissions on this view menu, e.g. `[examples].[public].*`. `*` matches any sequence of characters and `?` any single character. Defaults to all view menus.",
+			},
+			"permissions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The permissions, ordered by view menu and permission name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the permission.",
+						},
+						"permission_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the permission.",
+						},
+						"view_menu_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the view menu.",
+						},
+					},
+				},
+			},
+			"ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the permissions.",
+			},
+		},
+	}
+}
+
+func (d *PermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *PermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data permissionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, err := d.client.ListPermissions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions: %s", err))
+		return
+	}
+
+	permissionName := wildcardPattern("*")
+	if !data.PermissionName.IsNull() {
+		permissionName = wildcardPattern(data.PermissionName.ValueString())
+	}
+	viewMenuName := wildcardPattern("*")
+	if !data.ViewMenuName.IsNull() {
+		viewMenuName = wildcardPattern(data.ViewMenuName.ValueString())
+	}
+
+	var matches []client.SupersetPermissionApiGetList
+	for _, p := range permissions {
+		if permissionName.MatchString(p.Permission.Name) && viewMenuName.MatchString(p.ViewMenu.Name) {
+			matches = append(matches, p)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].ViewMenu.Name != matches[j].ViewMenu.Name {
+			return matches[i].ViewMenu.Name < matches[j].ViewMenu.Name
+		}
+		return matches[i].Permission.Name < matches[j].Permission.Name
+	})
+
+	data.Permissions = make([]permissionDataSourceModel, 0, len(matches))
+	ids := make([]int64, 0, len(matches))
+	for _, p := range matches {
+		data.Permissions = append(data.Permissions, permissionDataSourceModel{
+			Id:             types.Int64Value(int64(p.Id)),
+			PermissionName: types.StringValue(p.Permission.Name),
+			ViewMenuName:   types.StringValue(p.ViewMenu.Name),
+		})
+		ids = append(ids, int64(p.Id))
+	}
+
+	idsValue, diags := types.SetValueFrom(ctx, types.Int64Type, ids)
+	resp.Diagnostics.Append(diags...)
+	data.Ids = idsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,29 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestWildcardPattern(t *testing.T) {
+	cases := []struct {
+		pattern string
+		value   string
+		want    bool
+	}{
+		{"datasource_access", "datasource_access", true},
+		{"datasource_access", "database_access", false},
+		{"*_access", "schema_access", true},
+		{"can_?ead", "can_read", true},
+		{"[examples].[public].*", "[examples].[public].[orders](id:3)", true},
+		{"[examples].[public].*", "[examples].[sales].[orders](id:4)", false},
+		{"[examples].[public]", "e", false},
+		{"*", "", true},
+	}
+
+	for _, c := range cases {
+		if got := wildcardPattern(c.pattern).MatchString(c.value); got != c.want {
+			t.Errorf("wildcardPattern(%q).MatchString(%q) = %t, want %t", c.pattern, c.value, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &RolesDataSource{}
+
+func NewRolesDataSource() datasource.DataSource {
+	return &RolesDataSource{}
+}
+
+type RolesDataSource struct {
+	client *client.ClientWrapper
+}
+
+type rolesDataSourceModel struct {
+	NameContains types.String          `tfsdk:"name_contains"`
+	Roles        []roleDataSourceModel `tfsdk:"roles"`
+	Ids          types.Map             `tfsdk:"ids"`
+}
+
+func (d *RolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+func (d *RolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the roles of the Superset instance.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_contains": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the roles whose name contains this string, case-insensitively. Defaults to all roles.",
+			},
+			"roles": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The roles, ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the role.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the role.",
+						},
+					},
+				},
+			},
+			"ids": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the roles, keyed by name.",
+			},
+		},
+	}
+}
+
+func (d *RolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data rolesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := d.client.ListRoles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list roles: %s", err))
+		return
+	}
+
+	// ListRoles may return the cached list, so sort a copy.
+	roles = append([]client.SupersetRoleApiGetList{}, roles...)
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+
+	filter := strings.ToLower(data.NameContains.ValueString())
+	data.Roles = make([]roleDataSourceModel, 0, len(roles))
+	ids := make(map[string]int64, len(roles))
+	for _, role := range roles {
+		if !strings.Contains(strings.ToLower(role.Name), filter) {
+			continue
+		}
+		data.Roles = append(data.Roles, roleDataSourceModel{
+			Id:   types.Int64Value(int64(role.Id)),
+			Name: types.StringValue(role.Name),
+		})
+		ids[role.Name] = int64(role.Id)
+	}
+
+	idsValue, diags := types.MapValueFrom(ctx, types.Int64Type, ids)
+	resp.Diagnostics.Append(diags...)
+	data.Ids = idsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
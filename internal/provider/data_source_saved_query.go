@@ -0,0 +1,132 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &SavedQueryDataSource{}
+
+func NewSavedQueryDataSource() datasource.DataSource {
+	return &SavedQueryDataSource{}
+}
+
+type SavedQueryDataSource struct {
+	client *client.ClientWrapper
+}
+
+type savedQueryDataSourceModel struct {
+	Id           types.Int64  `tfsdk:"id"`
+	Label        types.String `tfsdk:"label"`
+	DatabaseName types.String `tfsdk:"database_name"`
+	DatabaseId   types.Int64  `tfsdk:"database_id"`
+	Catalog      types.String `tfsdk:"catalog"`
+	Schema       types.String `tfsdk:"schema"`
+	Sql          types.String `tfsdk:"sql"`
+	Description  types.String `tfsdk:"description"`
+}
+
+func (model *savedQueryDataSourceModel) updateState(sq *client.SavedQueryRestApiGetList) {
+	model.Id = types.Int64Value(int64(sq.Id))
+	model.Label = nullableStringValue(sq.Label)
+	model.DatabaseName = types.StringValue(sq.Database.DatabaseName)
+	model.DatabaseId = types.Int64Value(int64(sq.Database.Id))
+	model.Catalog = nullableStringValue(sq.Catalog)
+	model.Schema = nullableStringValue(sq.Schema)
+	model.Sql = nullableStringValue(sq.Sql)
+	model.Description = nullableStringValue(sq.Description)
+}
+
+func (d *SavedQueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_saved_query"
+}
+
+func (d *SavedQueryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up a saved query by label, e.g. to use its SQL as the `sql` of a virtual dataset so the shared SQL has a single source of truth. " +
+			"Only the saved queries visible to the provider account can be found.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the saved query.",
+			},
+			"label": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The label of the saved query.",
+			},
+			"database_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the database of the saved query. Required when saved queries of several databases share the label.",
+			},
+			"database_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the database of the saved query.",
+			},
+			"catalog": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The catalog of the saved query.",
+			},
+			"schema": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The schema of the saved query.",
+			},
+			"sql": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SQL of the saved query.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The description of the saved query.",
+			},
+		},
+	}
+}
+
+func (d *SavedQueryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *SavedQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data savedQueryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sq, err := d.client.FindSavedQuery(ctx, data.Label.ValueString(), data.DatabaseName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find saved query with label %s: %s", data.Label.ValueString(), err))
+		return
+	}
+
+	data.updateState(sq)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
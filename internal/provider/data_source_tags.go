@@ -0,0 +1,149 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &TagsDataSource{}
+
+func NewTagsDataSource() datasource.DataSource {
+	return &TagsDataSource{}
+}
+
+type TagsDataSource struct {
+	client *client.ClientWrapper
+}
+
+type tagsDataSourceModel struct {
+	NameContains      types.String         `tfsdk:"name_contains"`
+	IncludeSystemTags types.Bool           `tfsdk:"include_system_tags"`
+	Tags              []tagDataSourceModel `tfsdk:"tags"`
+	Ids               types.Map            `tfsdk:"ids"`
+}
+
+// systemTagPrefixes are the name prefixes of the tags Superset maintains itself, for the owners, the type
+// and the users who favorited an object.
+var systemTagPrefixes = []string{"owner:", "type:", "favorited_by:"}
+
+func isSystemTag(name string) bool {
+	for _, prefix := range systemTagPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *TagsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tags"
+}
+
+func (d *TagsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the tags of the Superset instance.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_contains": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the tags whose name contains this string, case-insensitively. Defaults to all tags.",
+			},
+			"include_system_tags": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to also return the tags Superset maintains itself, such as `owner:1` and `type:dashboard`. Defaults to `false`.",
+			},
+			"tags": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The tags, ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the tag.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the tag.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The description of the tag.",
+						},
+					},
+				},
+			},
+			"ids": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the tags, keyed by name.",
+			},
+		},
+	}
+}
+
+func (d *TagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *TagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data tagsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := d.client.ListTags(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list tags: %s", err))
+		return
+	}
+
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Name < tags[j].Name })
+
+	filter := strings.ToLower(data.NameContains.ValueString())
+	includeSystemTags := data.IncludeSystemTags.ValueBool()
+	data.Tags = make([]tagDataSourceModel, 0, len(tags))
+	ids := make(map[string]int64, len(tags))
+	for _, tag := range tags {
+		if !strings.Contains(strings.ToLower(tag.Name), filter) || (!includeSystemTags && isSystemTag(tag.Name)) {
+			continue
+		}
+		var model tagDataSourceModel
+		model.updateState(&tag)
+		data.Tags = append(data.Tags, model)
+		ids[tag.Name] = int64(tag.Id)
+	}
+
+	idsValue, diags := types.MapValueFrom(ctx, types.Int64Type, ids)
+	resp.Diagnostics.Append(diags...)
+	data.Ids = idsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
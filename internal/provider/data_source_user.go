@@ -0,0 +1,184 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &UserDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &UserDataSource{}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+type UserDataSource struct {
+	client *client.ClientWrapper
+}
+
+type userDataSourceModel struct {
+	Id         types.Int64  `tfsdk:"id"`
+	Username   types.String `tfsdk:"username"`
+	Email      types.String `tfsdk:"email"`
+	FirstName  types.String `tfsdk:"first_name"`
+	LastName   types.String `tfsdk:"last_name"`
+	Active     types.Bool   `tfsdk:"active"`
+	RoleNames  types.Set    `tfsdk:"role_names"`
+	GroupNames types.Set    `tfsdk:"group_names"`
+}
+
+func (model *userDataSourceModel) updateState(ctx context.Context, u *client.SupersetUserApiGetList) diag.Diagnostics {
+	model.Id = types.Int64Value(int64(u.Id))
+	model.Username = types.StringValue(u.Username)
+	model.Email = types.StringValue(u.Email)
+	model.FirstName = types.StringValue(u.FirstName)
+	model.LastName = types.StringValue(u.LastName)
+	model.Active = types.BoolValue(nullableBoolValue(u.Active))
+
+	roleNames := make([]string, 0, len(u.Roles))
+	for _, r := range u.Roles {
+		roleNames = append(roleNames, r.Name)
+	}
+	sort.Strings(roleNames)
+	groupNames := make([]string, 0, len(u.Groups))
+	for _, g := range u.Groups {
+		groupNames = append(groupNames, g.Name)
+	}
+	sort.Strings(groupNames)
+
+	var diags, d diag.Diagnostics
+	model.RoleNames, d = types.SetValueFrom(ctx, types.StringType, roleNames)
+	diags.Append(d...)
+	model.GroupNames, d = types.SetValueFrom(ctx, types.StringType, groupNames)
+	diags.Append(d...)
+	return diags
+}
+
+// userDataSourceAttributes returns the computed attributes of a user.
+func userDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the user.",
+		},
+		"username": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The username of the user.",
+		},
+		"email": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The email address of the user.",
+		},
+		"first_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The first name of the user.",
+		},
+		"last_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The last name of the user.",
+		},
+		"active": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the user is active.",
+		},
+		"role_names": schema.SetAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "The names of the roles assigned to the user.",
+		},
+		"group_names": schema.SetAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "The names of the groups the user belongs to.",
+		},
+	}
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := userDataSourceAttributes()
+	attributes["username"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The username of the user. Exactly one of `username` and `email` must be set.",
+	}
+	attributes["email"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The email address of the user. Exactly one of `username` and `email` must be set.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up an existing user by username or email address, e.g. to set the `owner_ids` of a dataset from usernames.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *UserDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("username"), path.MatchRoot("email")),
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data userDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user *client.SupersetUserApiGetList
+	var err error
+	if !data.Username.IsNull() {
+		user, err = d.client.FindUser(ctx, data.Username.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user with username %s: %s", data.Username.ValueString(), err))
+			return
+		}
+	} else {
+		user, err = d.client.FindUserByEmail(ctx, data.Email.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user with email %s: %s", data.Email.ValueString(), err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(data.updateState(ctx, user)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,264 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &UserPermissionsDataSource{}
+
+func NewUserPermissionsDataSource() datasource.DataSource {
+	return &UserPermissionsDataSource{}
+}
+
+type UserPermissionsDataSource struct {
+	client *client.ClientWrapper
+}
+
+type userPermissionsDataSourceModel struct {
+	Username           types.String                   `tfsdk:"username"`
+	UserId             types.Int64                    `tfsdk:"user_id"`
+	RoleNames          types.Set                      `tfsdk:"role_names"`
+	GroupRoleNames     types.Set                      `tfsdk:"group_role_names"`
+	EffectiveRoleNames types.Set                      `tfsdk:"effective_role_names"`
+	Permissions        []userPermissionDataSourceItem `tfsdk:"permissions"`
+	PermissionNames    types.Set                      `tfsdk:"permission_names"`
+}
+
+type userPermissionDataSourceItem struct {
+	PermissionName types.String `tfsdk:"permission_name"`
+	ViewMenuName   types.String `tfsdk:"view_menu_name"`
+	RoleNames      types.Set    `tfsdk:"role_names"`
+}
+
+// effectiveRoles returns the ids and names of the roles assigned to the user, directly and through its
+// groups, and the names of the roles only assigned through groups.
+func effectiveRoles(user *client.SupersetUserApiGetList, groups []client.SupersetGroupApiGetList) (map[int]string, []string) {
+	roles := make(map[int]string, len(user.Roles))
+	for _, r := range user.Roles {
+		roles[r.Id] = r.Name
+	}
+
+	memberOf := make(map[int]bool, len(user.Groups))
+	for _, g := range user.Groups {
+		memberOf[g.Id] = true
+	}
+
+	var groupRoleNames []string
+	for _, g := range groups {
+		if !memberOf[g.Id] {
+			continue
+		}
+		for _, r := range g.Roles {
+			if _, ok := roles[r.Id]; !ok {
+				roles[r.Id] = r.Name
+				groupRoleNames = append(groupRoleNames, r.Name)
+			}
+		}
+	}
+	sort.Strings(groupRoleNames)
+
+	return roles, groupRoleNames
+}
+
+// unionPermissions returns the permissions granted by any of the roles, ordered by view menu and permission
+// name, with the names of the roles granting each of them.
+func unionPermissions(permissionsByRole map[string][]client.SupersetRolePermissionApiGetList) ([]requiredPermission, map[requiredPermission][]string) {
+	grantedBy := make(map[requiredPermission][]string)
+	for roleName, permissions := range permissionsByRole {
+		for _, p := range permissions {
+			key := requiredPermission{p.PermissionName, p.ViewMenuName}
+			grantedBy[key] = append(grantedBy[key], roleName)
+		}
+	}
+
+	keys := make([]requiredPermission, 0, len(grantedBy))
+	for key, roleNames := range grantedBy {
+		sort.Strings(roleNames)
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].ViewMenuName != keys[j].ViewMenuName {
+			return keys[i].ViewMenuName < keys[j].ViewMenuName
+		}
+		return keys[i].PermissionName < keys[j].PermissionName
+	})
+
+	return keys, grantedBy
+}
+
+func (model *userPermissionsDataSourceModel) updateState(ctx context.Context, user *client.SupersetUserApiGetList, roles map[int]string, groupRoleNames []string, permissionsByRole map[string][]client.SupersetRolePermissionApiGetList) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	model.UserId = types.Int64Value(int64(user.Id))
+
+	roleNames := make([]string, 0, len(user.Roles))
+	for _, r := range user.Roles {
+		roleNames = append(roleNames, r.Name)
+	}
+	sort.Strings(roleNames)
+	model.RoleNames, d = types.SetValueFrom(ctx, types.StringType, roleNames)
+	diags.Append(d...)
+
+	model.GroupRoleNames, d = types.SetValueFrom(ctx, types.StringType, groupRoleNames)
+	diags.Append(d...)
+
+	effectiveRoleNames := make([]string, 0, len(roles))
+	for _, name := range roles {
+		effectiveRoleNames = append(effectiveRoleNames, name)
+	}
+	sort.Strings(effectiveRoleNames)
+	model.EffectiveRoleNames, d = types.SetValueFrom(ctx, types.StringType, effectiveRoleNames)
+	diags.Append(d...)
+
+	keys, grantedBy := unionPermissions(permissionsByRole)
+	model.Permissions = make([]userPermissionDataSourceItem, 0, len(keys))
+	permissionNames := make([]string, 0, len(keys))
+	for _, key := range keys {
+		grantingRoles, d := types.SetValueFrom(ctx, types.StringType, grantedBy[key])
+		diags.Append(d...)
+		model.Permissions = append(model.Permissions, userPermissionDataSourceItem{
+			PermissionName: types.StringValue(key.PermissionName),
+			ViewMenuName:   types.StringValue(key.ViewMenuName),
+			RoleNames:      grantingRoles,
+		})
+		permissionNames = append(permissionNames, fmt.Sprintf("%s on %s", key.PermissionName, key.ViewMenuName))
+	}
+	model.PermissionNames, d = types.SetValueFrom(ctx, types.StringType, permissionNames)
+	diags.Append(d...)
+
+	return diags
+}
+
+func (d *UserPermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_permissions"
+}
+
+func (d *UserPermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Compute the effective permissions of a user: the union of the permissions of the roles assigned to the user " +
+			"directly and through its groups, e.g. to generate access reviews as Terraform outputs.",
+
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The username of the user.",
+			},
+			"user_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user.",
+			},
+			"role_names": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the roles assigned to the user directly.",
+			},
+			"group_role_names": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the roles the user only has through its groups.",
+			},
+			"effective_role_names": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of all the roles of the user, assigned directly or through its groups.",
+			},
+			"permissions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The effective permissions of the user, ordered by view menu and permission name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the permission, e.g. `can_read`.",
+						},
+						"view_menu_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the view menu, e.g. `Dashboard`.",
+						},
+						"role_names": schema.SetAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The names of the roles of the user granting the permission.",
+						},
+					},
+				},
+			},
+			"permission_names": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The effective permissions of the user in the `<permission_name> on <view_menu_name>` format.",
+			},
+		},
+	}
+}
+
+func (d *UserPermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *UserPermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data userPermissionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := d.client.FindUser(ctx, data.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user with username %s: %s", data.Username.ValueString(), err))
+		return
+	}
+
+	var groups []client.SupersetGroupApiGetList
+	if len(user.Groups) > 0 {
+		groups, err = d.client.ListGroups(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list groups: %s", err))
+			return
+		}
+	}
+
+	roles, groupRoleNames := effectiveRoles(user, groups)
+
+	permissionsByRole := make(map[string][]client.SupersetRolePermissionApiGetList, len(roles))
+	for id, name := range roles {
+		permissions, err := d.client.ListRolePermissions(ctx, id)
+		// A role without permissions is reported as not found.
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", id, err))
+			return
+		}
+		permissionsByRole[name] = permissions
+	}
+
+	resp.Diagnostics.Append(data.updateState(ctx, user, roles, groupRoleNames, permissionsByRole)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
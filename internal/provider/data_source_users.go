@@ -0,0 +1,157 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ datasource.DataSource = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+type UsersDataSource struct {
+	client *client.ClientWrapper
+}
+
+type usersDataSourceModel struct {
+	Usernames types.Set             `tfsdk:"usernames"`
+	RoleName  types.String          `tfsdk:"role_name"`
+	GroupName types.String          `tfsdk:"group_name"`
+	Active    types.Bool            `tfsdk:"active"`
+	Users     []userDataSourceModel `tfsdk:"users"`
+	Ids       types.Map             `tfsdk:"ids"`
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the users of the Superset instance, e.g. to resolve the `owner_ids` of datasets and dashboards from usernames. " +
+			"The filters are combined, so only the users matching all of them are returned.",
+
+		Attributes: map[string]schema.Attribute{
+			"usernames": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return the users with these usernames. Defaults to all users.",
+			},
+			"role_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the users assigned to this role.",
+			},
+			"group_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the users belonging to this group.",
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the active, or the inactive, users.",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The users, ordered by username.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userDataSourceAttributes(),
+				},
+			},
+			"ids": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the users, keyed by username.",
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+// matches reports whether the user matches the filters of the data source.
+func (model *usersDataSourceModel) matches(ctx context.Context, u *client.SupersetUserApiGetList) bool {
+	if !model.Usernames.IsNull() {
+		var usernames []string
+		model.Usernames.ElementsAs(ctx, &usernames, false)
+		if !slices.Contains(usernames, u.Username) {
+			return false
+		}
+	}
+	if !model.RoleName.IsNull() && !slices.ContainsFunc(u.Roles, func(r client.SupersetUserApiGetListRole) bool {
+		return r.Name == model.RoleName.ValueString()
+	}) {
+		return false
+	}
+	if !model.GroupName.IsNull() && !slices.ContainsFunc(u.Groups, func(g client.SupersetUserApiGetListGroup) bool {
+		return g.Name == model.GroupName.ValueString()
+	}) {
+		return false
+	}
+	if !model.Active.IsNull() && nullableBoolValue(u.Active) != model.Active.ValueBool() {
+		return false
+	}
+	return true
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data usersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.ListUsers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users: %s", err))
+		return
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Username < users[j].Username })
+
+	data.Users = make([]userDataSourceModel, 0, len(users))
+	ids := make(map[string]int64, len(users))
+	for i := range users {
+		if !data.matches(ctx, &users[i]) {
+			continue
+		}
+		var user userDataSourceModel
+		resp.Diagnostics.Append(user.updateState(ctx, &users[i])...)
+		data.Users = append(data.Users, user)
+		ids[users[i].Username] = int64(users[i].Id)
+	}
+
+	idsValue, diags := types.MapValueFrom(ctx, types.Int64Type, ids)
+	resp.Diagnostics.Append(diags...)
+	data.Ids = idsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
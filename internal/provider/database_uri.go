@@ -0,0 +1,166 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// databaseUriProblems are the problems found in a SQLAlchemy URI. Errors fail the plan, warnings are
+// only reported.
+type databaseUriProblems struct {
+	backend  string
+	errors   []string
+	warnings []string
+}
+
+func (p *databaseUriProblems) errorf(format string, args ...interface{}) {
+	p.errors = append(p.errors, fmt.Sprintf(format, args...))
+}
+
+func (p *databaseUriProblems) warnf(format string, args ...interface{}) {
+	p.warnings = append(p.warnings, fmt.Sprintf(format, args...))
+}
+
+// databaseUriValidator checks the SQLAlchemy URI of a backend for mistakes its driver would only report
+// when the server connects to the database.
+type databaseUriValidator func(u *url.URL, problems *databaseUriProblems)
+
+// databaseUriValidators are the validators of the SQLAlchemy URIs of well-known backends, by the scheme
+// of the URI without the driver, e.g. `postgresql` for `postgresql+psycopg2`. URIs of other backends
+// are not checked.
+var databaseUriValidators = map[string]databaseUriValidator{
+	"postgres":   validatePostgresSchemeUri,
+	"postgresql": validatePostgresqlUri,
+	"trino":      validateTrinoUri,
+	"bigquery":   validateBigqueryUri,
+	"snowflake":  validateSnowflakeUri,
+}
+
+// postgresqlSslModes are the values of the sslmode option of libpq.
+var postgresqlSslModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+// validateDatabaseUri checks a SQLAlchemy URI with the validator of its backend. URIs that do not parse
+// as URLs are not checked, since SQLAlchemy is more lenient, e.g. about unescaped characters in
+// passwords, and the server reports the ones it cannot parse either.
+func validateDatabaseUri(uri string) databaseUriProblems {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return databaseUriProblems{}
+	}
+
+	backend, _, _ := strings.Cut(u.Scheme, "+")
+	problems := databaseUriProblems{backend: backend}
+	validator, ok := databaseUriValidators[backend]
+	if !ok {
+		return problems
+	}
+
+	if port := u.Port(); port != "" {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			problems.errorf("the port %s is not between 1 and 65535", port)
+		}
+	}
+	validator(u, &problems)
+	return problems
+}
+
+// databaseUriPath returns the segments of the path of u, e.g. the catalog and schema of a Trino URI.
+func databaseUriPath(u *url.URL) []string {
+	p := strings.Trim(u.Path, "/")
+	if p == "" {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
+
+func validatePostgresSchemeUri(u *url.URL, problems *databaseUriProblems) {
+	problems.errorf("SQLAlchemy no longer supports the postgres scheme, use postgresql instead")
+}
+
+func validatePostgresqlUri(u *url.URL, problems *databaseUriProblems) {
+	query := u.Query()
+	if u.Hostname() == "" && query.Get("host") == "" {
+		problems.errorf("the host is missing")
+	}
+	switch segments := databaseUriPath(u); {
+	case len(segments) == 0:
+		problems.warnf("no database is given, so the database named after the user is used")
+	case len(segments) > 1:
+		problems.errorf("only the database can follow the host, got %q", strings.Join(segments, "/"))
+	}
+
+	sslMode := query.Get("sslmode")
+	if sslMode != "" && !slices.Contains(postgresqlSslModes, sslMode) {
+		problems.errorf("the sslmode %q is not one of %s", sslMode, strings.Join(postgresqlSslModes, ", "))
+	}
+	if query.Get("sslrootcert") != "" && sslMode != "verify-ca" && sslMode != "verify-full" {
+		problems.warnf("sslrootcert is only used to verify the server with sslmode verify-ca or verify-full")
+	}
+}
+
+func validateTrinoUri(u *url.URL, problems *databaseUriProblems) {
+	if u.Hostname() == "" {
+		problems.errorf("the host is missing")
+	}
+
+	_, hasPassword := u.User.Password()
+	if hasPassword && u.User.Username() == "" {
+		problems.errorf("a password is given without a username")
+	}
+	switch scheme := u.Query().Get("http_scheme"); scheme {
+	case "", "https":
+	case "http":
+		if hasPassword {
+			problems.errorf("a password can only be sent over https, but http_scheme is http")
+		}
+	default:
+		problems.errorf("the http_scheme %q is neither http nor https", scheme)
+	}
+
+	switch segments := databaseUriPath(u); {
+	case len(segments) == 0:
+		problems.warnf("no catalog is given, so tables have to be qualified with their catalog")
+	case len(segments) > 2:
+		problems.errorf("only the catalog and the schema can follow the host, got %q", strings.Join(segments, "/"))
+	}
+}
+
+func validateBigqueryUri(u *url.URL, problems *databaseUriProblems) {
+	if u.User != nil {
+		problems.errorf("BigQuery authenticates with a service account, e.g. the credentials_info parameter, not with a user in the URI")
+	}
+	if u.Port() != "" {
+		problems.errorf("the project cannot have a port")
+	}
+	if u.Hostname() == "" {
+		problems.warnf("no project is given, so the project of the credentials is used")
+	}
+	if segments := databaseUriPath(u); len(segments) > 1 {
+		problems.errorf("only the default dataset can follow the project, got %q", strings.Join(segments, "/"))
+	}
+}
+
+func validateSnowflakeUri(u *url.URL, problems *databaseUriProblems) {
+	if u.User == nil || u.User.Username() == "" {
+		problems.errorf("the user is missing")
+	}
+	switch account := u.Hostname(); {
+	case account == "":
+		problems.errorf("the account identifier is missing")
+	case strings.HasSuffix(account, ".snowflakecomputing.com"):
+		problems.warnf("the account identifier is expected without the .snowflakecomputing.com domain, e.g. %s", strings.TrimSuffix(account, ".snowflakecomputing.com"))
+	}
+
+	if segments := databaseUriPath(u); len(segments) > 2 {
+		problems.errorf("only the database and the schema can follow the account, got %q", strings.Join(segments, "/"))
+	}
+	if u.Query().Get("warehouse") == "" {
+		problems.warnf("no warehouse is given, so queries use the default warehouse of the user")
+	}
+}
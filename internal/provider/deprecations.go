@@ -0,0 +1,104 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// renamedAttribute is an attribute renamed from `from` to `to`. The old name is kept in the schema as a
+// deprecated alias until the next major version, and both names hold the same value in the state, so
+// that configurations can move to the new name without a diff.
+//
+// To rename an attribute of a resource:
+//   - add the new attribute as Optional and Computed, and mark the old one with deprecationMessage and as
+//     Optional and Computed, conflicting with the new one;
+//   - bump the schema version, and upgrade the state of the prior version with upgradeRenamedAttributes;
+//   - plan both names with planRenamedAttribute, or keep both names in sync for computed attributes.
+type renamedAttribute struct {
+	from string
+	to   string
+}
+
+// deprecationMessage is the DeprecationMessage of the old attribute.
+func (a renamedAttribute) deprecationMessage() string {
+	return fmt.Sprintf("Use `%s` instead. `%s` will be removed in the next major version of the provider.", a.to, a.from)
+}
+
+// upgradeRenamedAttributes returns a state upgrader from the prior schema version, which sets the new
+// attributes to the values of their old names.
+func upgradeRenamedAttributes(renamed ...renamedAttribute) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil {
+				return
+			}
+
+			upgraded, err := renameStateAttributes(req.RawState.JSON, renamed)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					fmt.Sprintf("Unable to rename attributes of the prior state: %s", err),
+				)
+				return
+			}
+
+			resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+		},
+	}
+}
+
+// renameStateAttributes copies the value of each old attribute of the JSON state to its new name, and
+// keeps the old one for the deprecated alias.
+func renameStateAttributes(state []byte, renamed []renamedAttribute) ([]byte, error) {
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(state, &attributes); err != nil {
+		return nil, err
+	}
+
+	for _, a := range renamed {
+		if v, ok := attributes[a.from]; ok {
+			if _, exists := attributes[a.to]; !exists {
+				attributes[a.to] = v
+			}
+		}
+	}
+
+	return json.Marshal(attributes)
+}
+
+// planRenamedAttribute plans a renamed string attribute, which must be Optional and Computed under both
+// names: both are set to the configured value, of the new name or else of the old one. Changing the value
+// requires the replacement of the resource when requiresReplace is set, but moving it to the new name does not.
+func planRenamedAttribute(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, a renamedAttribute, requiresReplace bool) {
+	var value, alias types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(a.to), &value)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(a.from), &alias)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if value.IsNull() {
+		value = alias
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(a.to), value)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(a.from), value)...)
+
+	if !requiresReplace || req.State.Raw.IsNull() {
+		return
+	}
+
+	var current types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(a.to), &current)...)
+	if !value.Equal(current) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root(a.to))
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRenameStateAttributes(t *testing.T) {
+	renamed := []renamedAttribute{
+		{from: "bootstrap_database_name", to: "creation_database_name"},
+		{from: "bootstrap_database_id", to: "creation_database_id"},
+	}
+
+	upgraded, err := renameStateAttributes([]byte(`{"id": 1, "bootstrap_database_name": "bootstrap", "bootstrap_database_id": null}`), renamed)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var state map[string]any
+	if err := json.Unmarshal(upgraded, &state); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if state["creation_database_name"] != "bootstrap" || state["bootstrap_database_name"] != "bootstrap" {
+		t.Errorf("expected the name under both attributes, got %v", state)
+	}
+	if v, ok := state["creation_database_id"]; !ok || v != nil {
+		t.Errorf("expected a null creation_database_id, got %v", state)
+	}
+	if state["id"] != float64(1) {
+		t.Errorf("expected the other attributes to be kept, got %v", state)
+	}
+
+	if _, err := renameStateAttributes([]byte(`[]`), renamed); err == nil {
+		t.Error("expected an error for a state that is not an object")
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// hclValue is a value that can be rendered as HCL native syntax.
+type hclValue interface {
+	render(indent int) string
+}
+
+// hclRaw is an expression rendered as is, e.g. a number or a reference to another resource.
+type hclRaw string
+
+func (v hclRaw) render(int) string {
+	return string(v)
+}
+
+func hclString(s string) hclRaw {
+	return hclRaw(quoteHCLString(s))
+}
+
+func hclInt(i int64) hclRaw {
+	return hclRaw(strconv.FormatInt(i, 10))
+}
+
+func hclBool(b bool) hclRaw {
+	return hclRaw(strconv.FormatBool(b))
+}
+
+type hclAttribute struct {
+	name  string
+	value hclValue
+}
+
+// hclObject renders as an object constructor. With quoteKeys set, the attribute names are rendered as
+// quoted map keys.
+type hclObject struct {
+	attributes []hclAttribute
+	quoteKeys  bool
+}
+
+func (o *hclObject) set(name string, value hclValue) {
+	o.attributes = append(o.attributes, hclAttribute{name, value})
+}
+
+func (o *hclObject) render(indent int) string {
+	if len(o.attributes) == 0 {
+		return "{}"
+	}
+
+	return "{\n" + renderHCLAttributes(o.attributes, indent+1, "=", o.quoteKeys) + strings.Repeat("  ", indent) + "}"
+}
+
+type hclTuple []hclValue
+
+func (t hclTuple) render(indent int) string {
+	if len(t) == 0 {
+		return "[]"
+	}
+
+	multiline := false
+	for _, v := range t {
+		if _, ok := v.(*hclObject); ok {
+			multiline = true
+		}
+	}
+
+	if !multiline {
+		items := make([]string, 0, len(t))
+		for _, v := range t {
+			items = append(items, v.render(indent))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	}
+
+	var b strings.Builder
+	b.WriteString("[\n")
+	for _, v := range t {
+		b.WriteString(strings.Repeat("  ", indent+1) + v.render(indent+1) + ",\n")
+	}
+	b.WriteString(strings.Repeat("  ", indent) + "]")
+	return b.String()
+}
+
+// hclBlock is a top level block such as a resource.
+type hclBlock struct {
+	blockType string
+	labels    []string
+	body      hclObject
+}
+
+func (b *hclBlock) render() string {
+	header := b.blockType
+	for _, l := range b.labels {
+		header += " " + quoteHCLString(l)
+	}
+
+	return header + " " + b.body.render(0) + "\n"
+}
+
+// renderHCLAttributes renders one attribute per line, aligning the equals signs of consecutive single
+// line attributes like terraform fmt does.
+func renderHCLAttributes(attributes []hclAttribute, indent int, sep string, quoteKeys bool) string {
+	keys := make([]string, len(attributes))
+	values := make([]string, len(attributes))
+	for i, a := range attributes {
+		keys[i] = a.name
+		if quoteKeys {
+			keys[i] = quoteHCLString(a.name)
+		}
+		values[i] = a.value.render(indent)
+	}
+
+	var b strings.Builder
+	prefix := strings.Repeat("  ", indent)
+	for start := 0; start < len(attributes); {
+		end := start + 1
+		if !strings.Contains(values[start], "\n") {
+			for end < len(attributes) && !strings.Contains(values[end], "\n") {
+				end++
+			}
+		}
+
+		width := 0
+		for i := start; i < end; i++ {
+			width = max(width, len(keys[i]))
+		}
+		for i := start; i < end; i++ {
+			fmt.Fprintf(&b, "%s%-*s %s %s\n", prefix, width, keys[i], sep, values[i])
+		}
+		start = end
+	}
+
+	return b.String()
+}
+
+// quoteHCLString quotes s as an HCL string literal, escaping template sequences so that the value is
+// taken literally.
+func quoteHCLString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, r := range s {
+		switch {
+		case r == '"':
+			b.WriteString(`\"`)
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i+1:], "{"):
+			b.WriteRune(r)
+			b.WriteRune(r)
+		case !unicode.IsPrint(r):
+			fmt.Fprintf(&b, `\u%04x`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+var hclInvalidIdentifierChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// hclIdentifier converts name to a valid resource name.
+func hclIdentifier(name string) string {
+	id := strings.Trim(hclInvalidIdentifierChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if id == "" || !unicode.IsLetter(rune(id[0])) && id[0] != '_' {
+		id = "_" + id
+	}
+	return id
+}
@@ -0,0 +1,64 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestQuoteHCLString(t *testing.T) {
+	cases := map[string]string{
+		`plain`:              `"plain"`,
+		"SELECT \"a\"\nFROM": `"SELECT \"a\"\nFROM"`,
+		`C:\path`:            `"C:\\path"`,
+		`${var}`:             `"$${var}"`,
+		`%{if}`:              `"%%{if}"`,
+		`$1 and 100%`:        `"$1 and 100%"`,
+	}
+	for in, want := range cases {
+		if got := quoteHCLString(in); got != want {
+			t.Errorf("quoteHCLString(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestHclIdentifier(t *testing.T) {
+	cases := map[string]string{
+		"orders":          "orders",
+		"Sales Report":    "sales_report",
+		"2024_revenue":    "_2024_revenue",
+		"public.my-table": "public_my-table",
+	}
+	for in, want := range cases {
+		if got := hclIdentifier(in); got != want {
+			t.Errorf("hclIdentifier(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestHclBlockRender(t *testing.T) {
+	column := &hclObject{}
+	column.set("column_name", hclString("id"))
+	column.set("is_dttm", hclBool(false))
+	columns := &hclObject{quoteKeys: true}
+	columns.set("id", column)
+
+	block := &hclBlock{blockType: "resource", labels: []string{"superset_dataset_columns", "orders"}}
+	block.body.set("dataset_name", hclRaw("superset_dataset.orders.table_name"))
+	block.body.set("columns", columns)
+	block.body.set("owner_ids", hclTuple{hclInt(1), hclInt(2)})
+
+	want := `resource "superset_dataset_columns" "orders" {
+  dataset_name = superset_dataset.orders.table_name
+  columns = {
+    "id" = {
+      column_name = "id"
+      is_dttm     = false
+    }
+  }
+  owner_ids = [1, 2]
+}
+`
+	if got := block.render(); got != want {
+		t.Errorf("unexpected rendering:\n%s\nwant:\n%s", got, want)
+	}
+}
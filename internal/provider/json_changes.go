@@ -0,0 +1,132 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// maxListedJSONChanges is the number of keys listed per kind of change in the summary of a JSON change.
+const maxListedJSONChanges = 20
+
+// jsonChangeSet holds the keys of a JSON object that a change adds, removes or changes. The keys of nested
+// objects are joined with dots, e.g. `adhoc_filters` or `color_scheme.name`.
+type jsonChangeSet struct {
+	Added   []string
+	Removed []string
+	Changed []string
+}
+
+func (c jsonChangeSet) empty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Changed) == 0
+}
+
+// jsonChanges returns the changes from the JSON object prior to the JSON object planned, and false when
+// either is not a JSON object.
+func jsonChanges(prior string, planned string) (jsonChangeSet, bool) {
+	var before, after map[string]any
+	if json.Unmarshal([]byte(prior), &before) != nil || json.Unmarshal([]byte(planned), &after) != nil || before == nil || after == nil {
+		return jsonChangeSet{}, false
+	}
+
+	var changes jsonChangeSet
+	diffJSONObjects("", before, after, &changes)
+	sort.Strings(changes.Added)
+	sort.Strings(changes.Removed)
+	sort.Strings(changes.Changed)
+	return changes, true
+}
+
+func diffJSONObjects(prefix string, before map[string]any, after map[string]any, changes *jsonChangeSet) {
+	for key, a := range after {
+		b, ok := before[key]
+		switch {
+		case !ok:
+			changes.Added = append(changes.Added, prefix+key)
+		case reflect.DeepEqual(a, b):
+		default:
+			bObj, bOk := b.(map[string]any)
+			aObj, aOk := a.(map[string]any)
+			if bOk && aOk {
+				diffJSONObjects(prefix+key+".", bObj, aObj, changes)
+			} else {
+				changes.Changed = append(changes.Changed, prefix+key)
+			}
+		}
+	}
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			changes.Removed = append(changes.Removed, prefix+key)
+		}
+	}
+}
+
+// summary describes the changes, e.g. "adds keys a; changes keys b, c".
+func (c jsonChangeSet) summary() string {
+	if c.empty() {
+		return "only reformats the JSON, the values are the same"
+	}
+
+	list := func(keys []string) string {
+		if len(keys) > maxListedJSONChanges {
+			return fmt.Sprintf("%s and %d more", strings.Join(keys[:maxListedJSONChanges], ", "), len(keys)-maxListedJSONChanges)
+		}
+		return strings.Join(keys, ", ")
+	}
+	var parts []string
+	if len(c.Added) > 0 {
+		parts = append(parts, "adds keys "+list(c.Added))
+	}
+	if len(c.Removed) > 0 {
+		parts = append(parts, "removes keys "+list(c.Removed))
+	}
+	if len(c.Changed) > 0 {
+		parts = append(parts, "changes keys "+list(c.Changed))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// jsonChangeSummaryModifier reports the keys a change of a JSON object attribute adds, removes or changes
+// as a warning, as the plan only shows the whole string.
+type jsonChangeSummaryModifier struct{}
+
+// summarizeJSONChanges returns a plan modifier for string attributes holding a JSON object, e.g. the form
+// data of a chart, which lists the changed keys of the object in the plan.
+func summarizeJSONChanges() planmodifier.String {
+	return jsonChangeSummaryModifier{}
+}
+
+func (m jsonChangeSummaryModifier) Description(ctx context.Context) string {
+	return "Lists the keys of the JSON object that the change adds, removes or changes."
+}
+
+func (m jsonChangeSummaryModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m jsonChangeSummaryModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	if req.StateValue.ValueString() == req.PlanValue.ValueString() {
+		return
+	}
+
+	changes, ok := jsonChanges(req.StateValue.ValueString(), req.PlanValue.ValueString())
+	if !ok {
+		return
+	}
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		"JSON Attribute Changes",
+		fmt.Sprintf("The change of %s %s.", req.Path, changes.summary()),
+	)
+}
@@ -0,0 +1,45 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestJSONChanges(t *testing.T) {
+	prior := `{"viz_type": "table", "row_limit": 100, "color_scheme": {"name": "bnbColors", "dark": false}, "groupby": ["region"]}`
+	planned := `{"viz_type": "table", "row_limit": 500, "color_scheme": {"name": "supersetColors", "dark": false}, "metrics": ["count"]}`
+
+	changes, ok := jsonChanges(prior, planned)
+	if !ok {
+		t.Fatal("expected JSON objects")
+	}
+	if !slices.Equal(changes.Added, []string{"metrics"}) {
+		t.Errorf("added = %v", changes.Added)
+	}
+	if !slices.Equal(changes.Removed, []string{"groupby"}) {
+		t.Errorf("removed = %v", changes.Removed)
+	}
+	if !slices.Equal(changes.Changed, []string{"color_scheme.name", "row_limit"}) {
+		t.Errorf("changed = %v", changes.Changed)
+	}
+	if got, want := changes.summary(), "adds keys metrics; removes keys groupby; changes keys color_scheme.name, row_limit"; got != want {
+		t.Errorf("summary = %q, want %q", got, want)
+	}
+
+	// A reformatted object has no changes.
+	changes, ok = jsonChanges(`{"a": 1, "b": [1, 2]}`, "{\n  \"b\": [1, 2],\n  \"a\": 1\n}")
+	if !ok || !changes.empty() {
+		t.Errorf("expected no changes, got %+v", changes)
+	}
+
+	// Values other than objects are not summarized.
+	if _, ok := jsonChanges(`[1]`, `[2]`); ok {
+		t.Error("expected arrays not to be summarized")
+	}
+	if _, ok := jsonChanges(`{"a": 1}`, `not json`); ok {
+		t.Error("expected invalid JSON not to be summarized")
+	}
+}
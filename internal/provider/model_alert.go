@@ -0,0 +1,80 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+// Alert validator types, see the validator_type attribute.
+const (
+	alertValidatorNotNull  = "not null"
+	alertValidatorOperator = "operator"
+)
+
+type alertBaseModel struct {
+	reportScheduleBaseModel
+	DatabaseId     types.Int64     `tfsdk:"database_id"`
+	Sql            types.String    `tfsdk:"sql"`
+	ValidatorType  types.String    `tfsdk:"validator_type"`
+	Condition      *alertCondition `tfsdk:"condition"`
+	GracePeriod    types.Int64     `tfsdk:"grace_period"`
+	WorkingTimeout types.Int64     `tfsdk:"working_timeout"`
+}
+
+type alertCondition struct {
+	Operator  types.String  `tfsdk:"operator"`
+	Threshold types.Float64 `tfsdk:"threshold"`
+}
+
+func (model *alertBaseModel) request(ctx context.Context) (client.ReportScheduleRequest, diag.Diagnostics) {
+	req, diags := model.reportScheduleBaseModel.request(ctx, client.ReportScheduleTypeAlert)
+	req.Database = int(model.DatabaseId.ValueInt64())
+	req.Sql = model.Sql.ValueString()
+	req.ValidatorType = model.ValidatorType.ValueString()
+	req.ValidatorConfigJson = &client.ReportValidatorConfig{}
+	if model.Condition != nil {
+		threshold := model.Condition.Threshold.ValueFloat64()
+		req.ValidatorConfigJson.Op = model.Condition.Operator.ValueString()
+		req.ValidatorConfigJson.Threshold = &threshold
+	}
+	req.GracePeriod = int(model.GracePeriod.ValueInt64())
+	req.WorkingTimeout = int(model.WorkingTimeout.ValueInt64())
+	return req, diags
+}
+
+func (model *alertBaseModel) updateState(ctx context.Context, r *client.ReportSchedule) diag.Diagnostics {
+	diags := model.reportScheduleBaseModel.updateState(ctx, r)
+
+	model.DatabaseId = types.Int64Null()
+	if r.Database != nil {
+		model.DatabaseId = types.Int64Value(int64(r.Database.Id))
+	}
+	model.Sql = nullableStringValue(r.Sql)
+	model.ValidatorType = nullableStringValue(r.ValidatorType)
+	model.GracePeriod = nullableInt64Value(r.GracePeriod)
+	model.WorkingTimeout = nullableInt64Value(r.WorkingTimeout)
+
+	model.Condition = nil
+	if model.ValidatorType.ValueString() == alertValidatorOperator {
+		config, err := r.ValidatorConfig()
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read the condition of alert %d: %s", r.Id, err))
+			return diags
+		}
+		model.Condition = &alertCondition{
+			Operator:  types.StringValue(config.Op),
+			Threshold: types.Float64Null(),
+		}
+		if config.Threshold != nil {
+			model.Condition.Threshold = types.Float64Value(*config.Threshold)
+		}
+	}
+	return diags
+}
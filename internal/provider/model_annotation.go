@@ -0,0 +1,79 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+	"github.com/oapi-codegen/nullable"
+)
+
+// annotationTimeLayout is the format of the start and end time of annotations. Superset stores them
+// without a timezone.
+const annotationTimeLayout = "2006-01-02T15:04:05"
+
+type annotationBaseModel struct {
+	Id           types.Int64  `tfsdk:"id"`
+	LayerId      types.Int64  `tfsdk:"layer_id"`
+	ShortDescr   types.String `tfsdk:"short_descr"`
+	LongDescr    types.String `tfsdk:"long_descr"`
+	StartDttm    types.String `tfsdk:"start_dttm"`
+	EndDttm      types.String `tfsdk:"end_dttm"`
+	JsonMetadata types.String `tfsdk:"json_metadata"`
+}
+
+func (model *annotationBaseModel) postRequest() client.AnnotationRestApiPost {
+	put := model.putRequest()
+	return client.AnnotationRestApiPost{
+		ShortDescr:   put.ShortDescr,
+		LongDescr:    put.LongDescr,
+		StartDttm:    put.StartDttm,
+		EndDttm:      put.EndDttm,
+		JsonMetadata: put.JsonMetadata,
+	}
+}
+
+// putRequest returns the request body of the annotation. Cleared optional values are sent as null.
+func (model *annotationBaseModel) putRequest() client.AnnotationRestApiPut {
+	req := client.AnnotationRestApiPut{
+		ShortDescr:   model.ShortDescr.ValueString(),
+		LongDescr:    nullable.NewNullNullable[string](),
+		StartDttm:    model.StartDttm.ValueString(),
+		EndDttm:      model.EndDttm.ValueString(),
+		JsonMetadata: nullable.NewNullNullable[string](),
+	}
+	if !model.LongDescr.IsNull() {
+		req.LongDescr = nullable.NewNullableWithValue(model.LongDescr.ValueString())
+	}
+	if !model.JsonMetadata.IsNull() {
+		req.JsonMetadata = nullable.NewNullableWithValue(model.JsonMetadata.ValueString())
+	}
+	return req
+}
+
+func (model *annotationBaseModel) updateState(a *client.AnnotationRestApiGet) {
+	model.Id = types.Int64Value(int64(a.Id))
+	model.LayerId = types.Int64Value(int64(a.Layer.Id))
+	model.ShortDescr = nullableStringValue(a.ShortDescr)
+	model.LongDescr = emptyAsNull(nullableStringValue(a.LongDescr))
+	model.StartDttm = normalizeAnnotationTime(nullableStringValue(a.StartDttm))
+	model.EndDttm = normalizeAnnotationTime(nullableStringValue(a.EndDttm))
+	model.JsonMetadata = emptyAsNull(nullableStringValue(a.JsonMetadata))
+}
+
+// normalizeAnnotationTime formats a time returned by Superset in annotationTimeLayout, dropping
+// fractional seconds.
+func normalizeAnnotationTime(v types.String) types.String {
+	if v.IsNull() {
+		return v
+	}
+	for _, layout := range []string{annotationTimeLayout, "2006-01-02T15:04:05.999999", time.RFC3339Nano} {
+		if t, err := time.Parse(layout, v.ValueString()); err == nil {
+			return types.StringValue(t.Format(annotationTimeLayout))
+		}
+	}
+	return v
+}
@@ -0,0 +1,30 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+	"github.com/oapi-codegen/nullable"
+)
+
+type annotationLayerBaseModel struct {
+	Id    types.Int64  `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Descr types.String `tfsdk:"descr"`
+}
+
+// request returns the request body of the annotation layer. An empty description clears it.
+func (model *annotationLayerBaseModel) request() client.AnnotationLayerRestApiPost {
+	return client.AnnotationLayerRestApiPost{
+		Name:  model.Name.ValueString(),
+		Descr: nullable.NewNullableWithValue(model.Descr.ValueString()),
+	}
+}
+
+func (model *annotationLayerBaseModel) updateState(l *client.AnnotationLayerRestApiGet) {
+	model.Id = types.Int64Value(int64(l.Id))
+	model.Name = nullableStringValue(l.Name)
+	model.Descr = emptyAsNull(nullableStringValue(l.Descr))
+}
@@ -0,0 +1,111 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type assetPromotionBaseModel struct {
+	BundlePath        types.String `tfsdk:"bundle_path"`
+	BundleBase64      types.String `tfsdk:"bundle_base64"`
+	UuidMapping       types.Map    `tfsdk:"uuid_mapping"`
+	DatabaseOverrides types.Map    `tfsdk:"database_overrides"`
+	BundleSha256      types.String `tfsdk:"bundle_sha256"`
+	Assets            types.Map    `tfsdk:"assets"`
+}
+
+type assetPromotionDatabase struct {
+	DatabaseName  types.String `tfsdk:"database_name"`
+	SqlalchemyUri types.String `tfsdk:"sqlalchemy_uri"`
+	Password      types.String `tfsdk:"password"`
+}
+
+// isKnown reports whether everything the rewritten bundle depends on is known. Passwords are not
+// part of the bundle, so they may still be unknown.
+func (model *assetPromotionBaseModel) isKnown(ctx context.Context) bool {
+	if model.BundlePath.IsUnknown() || model.BundleBase64.IsUnknown() || model.UuidMapping.IsUnknown() || model.DatabaseOverrides.IsUnknown() {
+		return false
+	}
+	for _, v := range model.UuidMapping.Elements() {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+
+	databases := make(map[string]assetPromotionDatabase)
+	if diags := model.DatabaseOverrides.ElementsAs(ctx, &databases, true); diags.HasError() {
+		return false
+	}
+	for _, d := range databases {
+		if d.DatabaseName.IsUnknown() || d.SqlalchemyUri.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+func (model *assetPromotionBaseModel) overrides(ctx context.Context) (assetBundleOverrides, diag.Diagnostics) {
+	o := assetBundleOverrides{
+		UuidMapping: make(map[string]string),
+		Databases:   make(map[string]databaseOverride),
+	}
+
+	var diags diag.Diagnostics
+	if !model.UuidMapping.IsNull() {
+		diags.Append(model.UuidMapping.ElementsAs(ctx, &o.UuidMapping, false)...)
+	}
+	databases := make(map[string]assetPromotionDatabase)
+	if !model.DatabaseOverrides.IsNull() {
+		diags.Append(model.DatabaseOverrides.ElementsAs(ctx, &databases, false)...)
+	}
+	for name, d := range databases {
+		o.Databases[name] = databaseOverride{
+			DatabaseName:  d.DatabaseName.ValueString(),
+			SqlalchemyUri: d.SqlalchemyUri.ValueString(),
+			Password:      d.Password.ValueString(),
+		}
+	}
+	return o, diags
+}
+
+// source names the bundle in messages: its path, or bundle_base64 for an inline bundle.
+func (model *assetPromotionBaseModel) source() string {
+	if !model.BundleBase64.IsNull() {
+		return "bundle_base64"
+	}
+	return model.BundlePath.ValueString()
+}
+
+// bundle reads the bundle and applies the overrides to it.
+func (model *assetPromotionBaseModel) bundle(ctx context.Context) (*assetBundle, diag.Diagnostics) {
+	overrides, diags := model.overrides(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var bundle *assetBundle
+	var err error
+	if !model.BundleBase64.IsNull() {
+		bundle, err = decodeAssetBundle(model.BundleBase64.ValueString(), overrides)
+	} else {
+		bundle, err = readAssetBundle(model.BundlePath.ValueString(), overrides)
+	}
+	if err != nil {
+		diags.AddError("Bundle Error", fmt.Sprintf("Unable to read bundle '%s': %s", model.source(), err))
+		return nil, diags
+	}
+	return bundle, diags
+}
+
+func (model *assetPromotionBaseModel) updateState(ctx context.Context, bundle *assetBundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+	model.BundleSha256 = types.StringValue(bundle.sha256())
+	model.Assets, diags = types.MapValueFrom(ctx, types.StringType, bundle.Assets)
+	return diags
+}
@@ -0,0 +1,74 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+type cacheWarmupBaseModel struct {
+	DashboardIds   types.Set  `tfsdk:"dashboard_ids"`
+	ChartIds       types.Set  `tfsdk:"chart_ids"`
+	Datasets       types.Set  `tfsdk:"datasets"`
+	Triggers       types.Map  `tfsdk:"triggers"`
+	FailOnError    types.Bool `tfsdk:"fail_on_error"`
+	WarmedChartIds types.Set  `tfsdk:"warmed_chart_ids"`
+	FailedChartIds types.Set  `tfsdk:"failed_chart_ids"`
+}
+
+// cacheWarmupDataset is a dataset whose charts are warmed up, by its database and table name.
+type cacheWarmupDataset struct {
+	DatabaseName types.String `tfsdk:"database_name"`
+	TableName    types.String `tfsdk:"table_name"`
+}
+
+// cacheWarmupResults collects the results of a warm-up: the charts whose cache was warmed up, and the
+// error of each chart whose query failed.
+type cacheWarmupResults struct {
+	warmed   map[int]bool
+	failures map[int]string
+}
+
+func newCacheWarmupResults() *cacheWarmupResults {
+	return &cacheWarmupResults{warmed: make(map[int]bool), failures: make(map[int]string)}
+}
+
+// add records results. A chart warmed up with several filters, e.g. on two dashboards, counts as failed
+// when one of its queries failed.
+func (r *cacheWarmupResults) add(results []client.CacheWarmUpResult) {
+	for _, result := range results {
+		if result.VizError != "" {
+			r.failures[result.ChartId] = result.VizError
+			delete(r.warmed, result.ChartId)
+			continue
+		}
+		if _, failed := r.failures[result.ChartId]; !failed {
+			r.warmed[result.ChartId] = true
+		}
+	}
+}
+
+// failedChartIds returns the IDs of the charts whose query failed, in ascending order.
+func (r *cacheWarmupResults) failedChartIds() []int {
+	return slices.Sorted(maps.Keys(r.failures))
+}
+
+func (model *cacheWarmupBaseModel) setResults(ctx context.Context, results *cacheWarmupResults) {
+	toSet := func(ids []int) types.Set {
+		values := make([]int64, 0, len(ids))
+		for _, id := range ids {
+			values = append(values, int64(id))
+		}
+		s, _ := types.SetValueFrom(ctx, types.Int64Type, values)
+		return s
+	}
+
+	model.WarmedChartIds = toSet(slices.Sorted(maps.Keys(results.warmed)))
+	model.FailedChartIds = toSet(results.failedChartIds())
+}
@@ -0,0 +1,32 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+func TestCacheWarmupResults(t *testing.T) {
+	results := newCacheWarmupResults()
+	results.add([]client.CacheWarmUpResult{
+		{ChartId: 1, VizStatus: "success"},
+		{ChartId: 2, VizError: "relation \"orders\" does not exist"},
+	})
+	// The same charts warmed up again, e.g. with the filters of another dashboard.
+	results.add([]client.CacheWarmUpResult{
+		{ChartId: 1, VizError: "timeout"},
+		{ChartId: 2, VizStatus: "success"},
+		{ChartId: 3, VizStatus: "success"},
+	})
+
+	if got := results.failedChartIds(); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("expected charts with a failed query to be failed, got %v", got)
+	}
+	if len(results.warmed) != 1 || !results.warmed[3] {
+		t.Errorf("expected only chart 3 to be warmed, got %v", results.warmed)
+	}
+}
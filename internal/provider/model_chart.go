@@ -0,0 +1,81 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+type chartBaseModel struct {
+	Id            types.Int64  `tfsdk:"id"`
+	SliceName     types.String `tfsdk:"slice_name"`
+	VizType       types.String `tfsdk:"viz_type"`
+	DatasetId     types.Int64  `tfsdk:"dataset_id"`
+	DatasetName   types.String `tfsdk:"dataset_name"`
+	DatasetSchema types.String `tfsdk:"dataset_schema"`
+	DatabaseName  types.String `tfsdk:"database_name"`
+	Params        types.String `tfsdk:"params"`
+	QueryContext  types.String `tfsdk:"query_context"`
+	Owners        types.Set    `tfsdk:"owners"`
+	Dashboards    types.Set    `tfsdk:"dashboards"`
+	CacheTimeout  types.Int64  `tfsdk:"cache_timeout"`
+	Description   types.String `tfsdk:"description"`
+	Url           types.String `tfsdk:"url"`
+}
+
+func (model *chartBaseModel) updateState(c *client.Chart) {
+	model.Id = types.Int64Value(int64(c.Id))
+	model.SliceName = types.StringValue(c.SliceName)
+	model.VizType = types.StringValue(c.VizType)
+	model.DatasetId = types.Int64Value(int64(c.DatasourceId))
+	model.Params = emptyAsNull(nullableStringValue(c.Params))
+	model.QueryContext = emptyAsNull(nullableStringValue(c.QueryContext))
+	model.Description = emptyAsNull(nullableStringValue(c.Description))
+	model.CacheTimeout = nullableInt64Value(c.CacheTimeout)
+	model.Url = types.StringValue(c.Url)
+
+	owners := make([]int64, 0, len(c.Owners))
+	for _, o := range c.Owners {
+		owners = append(owners, int64(o.Id))
+	}
+	model.Owners, _ = types.SetValueFrom(context.Background(), types.Int64Type, owners)
+
+	if len(c.Dashboards) == 0 {
+		model.Dashboards = types.SetNull(types.Int64Type)
+	} else {
+		dashboards := make([]int64, 0, len(c.Dashboards))
+		for _, d := range c.Dashboards {
+			dashboards = append(dashboards, int64(d.Id))
+		}
+		model.Dashboards, _ = types.SetValueFrom(context.Background(), types.Int64Type, dashboards)
+	}
+}
+
+func (model *chartBaseModel) datasetQualifier() client.DatasetQualifier {
+	return client.DatasetQualifier{
+		TableName:    model.DatasetName.ValueString(),
+		Schema:       model.DatasetSchema.ValueString(),
+		DatabaseName: model.DatabaseName.ValueString(),
+	}
+}
+
+func int64SetToInts(s types.Set) []int {
+	var ids []int
+	for _, v := range s.Elements() {
+		if i, ok := v.(types.Int64); ok {
+			ids = append(ids, int(i.ValueInt64()))
+		}
+	}
+	return ids
+}
+
+func emptyAsNull(s types.String) types.String {
+	if s.ValueString() == "" {
+		return types.StringNull()
+	}
+	return s
+}
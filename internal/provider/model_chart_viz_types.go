@@ -0,0 +1,85 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// builtinVizTypes are the visualization types of the plugins bundled with recent Superset releases.
+var builtinVizTypes = []string{
+	"ag-grid-table", "big_number", "big_number_total", "box_plot", "bubble", "bubble_v2", "bullet",
+	"cal_heatmap", "cartodiagram", "chord", "compare", "country_map", "deck_arc", "deck_contour",
+	"deck_geojson", "deck_grid", "deck_heatmap", "deck_hex", "deck_multi", "deck_path", "deck_polygon",
+	"deck_scatter", "deck_screengrid", "echarts_area", "echarts_timeseries", "echarts_timeseries_bar",
+	"echarts_timeseries_line", "echarts_timeseries_scatter", "echarts_timeseries_smooth",
+	"echarts_timeseries_step", "funnel", "gantt_chart", "gauge_chart", "graph_chart", "handlebars",
+	"heatmap_v2", "histogram_v2", "horizon", "mapbox", "mixed_timeseries", "paired_ttest", "para",
+	"partition", "pie", "pivot_table_v2", "pop_kpi", "radar", "rose", "sankey_v2", "sunburst_v2",
+	"table", "time_pivot", "time_table", "tree_chart", "treemap_v2", "waterfall", "word_cloud", "world_map",
+}
+
+type chartVizTypesBaseModel struct {
+	Required       types.Set `tfsdk:"required"`
+	VizTypes       types.Set `tfsdk:"viz_types"`
+	CustomVizTypes types.Set `tfsdk:"custom_viz_types"`
+	ChartCounts    types.Map `tfsdk:"chart_counts"`
+}
+
+// availableVizTypes returns the built-in visualization types and the ones charts use, e.g. of custom plugins.
+func availableVizTypes(counts map[string]int) map[string]bool {
+	available := make(map[string]bool, len(builtinVizTypes)+len(counts))
+	for _, vizType := range builtinVizTypes {
+		available[vizType] = true
+	}
+	for vizType := range counts {
+		available[vizType] = true
+	}
+	return available
+}
+
+func (model *chartVizTypesBaseModel) updateState(counts map[string]int) {
+	var vizTypes, custom []string
+	for vizType := range availableVizTypes(counts) {
+		vizTypes = append(vizTypes, vizType)
+	}
+	builtin := make(map[string]bool, len(builtinVizTypes))
+	for _, vizType := range builtinVizTypes {
+		builtin[vizType] = true
+	}
+
+	countValues := make(map[string]attr.Value, len(counts))
+	for vizType, n := range counts {
+		countValues[vizType] = types.Int64Value(int64(n))
+		if !builtin[vizType] {
+			custom = append(custom, vizType)
+		}
+	}
+
+	model.VizTypes = stringSetValue(vizTypes)
+	model.CustomVizTypes = stringSetValue(custom)
+	model.ChartCounts, _ = types.MapValue(types.Int64Type, countValues)
+}
+
+// missingRequired returns the required visualization types that are not available, sorted by name.
+func (model *chartVizTypesBaseModel) missingRequired(counts map[string]int) []string {
+	available := availableVizTypes(counts)
+
+	var missing []string
+	for _, v := range model.Required.Elements() {
+		name, ok := v.(types.String)
+		if !ok || name.IsNull() || name.IsUnknown() {
+			continue
+		}
+		if !available[name.ValueString()] {
+			missing = append(missing, name.ValueString())
+		}
+	}
+
+	sort.Strings(missing)
+	return missing
+}
@@ -0,0 +1,29 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestChartVizTypesUpdateState(t *testing.T) {
+	counts := map[string]int{"table": 3, "my_custom_plugin": 1}
+
+	model := chartVizTypesBaseModel{Required: stringSetValue([]string{"table", "my_custom_plugin", "missing_plugin"})}
+	if got, want := model.missingRequired(counts), []string{"missing_plugin"}; !slices.Equal(got, want) {
+		t.Errorf("missingRequired() = %v, want %v", got, want)
+	}
+
+	model.updateState(counts)
+	if got := model.CustomVizTypes.String(); got != `["my_custom_plugin"]` {
+		t.Errorf("custom_viz_types = %s", got)
+	}
+	if n := len(model.VizTypes.Elements()); n != len(builtinVizTypes)+1 {
+		t.Errorf("len(viz_types) = %d, want %d", n, len(builtinVizTypes)+1)
+	}
+	if got := model.ChartCounts.String(); got != `{"my_custom_plugin":1,"table":3}` {
+		t.Errorf("chart_counts = %s", got)
+	}
+}
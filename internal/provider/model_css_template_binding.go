@@ -0,0 +1,55 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// cssTemplateVariablePattern matches the `{{ name }}` placeholders of a CSS template.
+var cssTemplateVariablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+type cssTemplateBindingBaseModel struct {
+	DashboardId  types.Int64  `tfsdk:"dashboard_id"`
+	TemplateName types.String `tfsdk:"template_name"`
+	TemplateId   types.Int64  `tfsdk:"template_id"`
+	Variables    types.Map    `tfsdk:"variables"`
+	Css          types.String `tfsdk:"css"`
+}
+
+// renderCssTemplate replaces the placeholders of css with variables. It returns the names of the
+// placeholders without a variable, which are left as is.
+func renderCssTemplate(css string, variables map[string]string) (string, []string) {
+	missing := make(map[string]bool)
+	rendered := cssTemplateVariablePattern.ReplaceAllStringFunc(css, func(placeholder string) string {
+		name := cssTemplateVariablePattern.FindStringSubmatch(placeholder)[1]
+		value, ok := variables[name]
+		if !ok {
+			missing[name] = true
+			return placeholder
+		}
+		return value
+	})
+
+	names := make([]string, 0, len(missing))
+	for name := range missing {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return rendered, names
+}
+
+func (model *cssTemplateBindingBaseModel) variables(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	variables := make(map[string]string)
+	if model.Variables.IsNull() {
+		return variables, nil
+	}
+	diags := model.Variables.ElementsAs(ctx, &variables, false)
+	return variables, diags
+}
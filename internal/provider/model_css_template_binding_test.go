@@ -0,0 +1,26 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRenderCssTemplate(t *testing.T) {
+	css := ".header { color: {{ color }}; }\n.footer { color: {{color}}; border: {{ border }}; margin: {{margin}}; }"
+
+	got, missing := renderCssTemplate(css, map[string]string{"color": "#1f3a5f"})
+	if want := ".header { color: #1f3a5f; }\n.footer { color: #1f3a5f; border: {{ border }}; margin: {{margin}}; }"; got != want {
+		t.Errorf("renderCssTemplate() = %q, want %q", got, want)
+	}
+	if want := []string{"border", "margin"}; !reflect.DeepEqual(missing, want) {
+		t.Errorf("missing = %q, want %q", missing, want)
+	}
+
+	got, missing = renderCssTemplate(css, map[string]string{"color": "red", "border": "none", "margin": "0"})
+	if want := ".header { color: red; }\n.footer { color: red; border: none; margin: 0; }"; got != want || len(missing) > 0 {
+		t.Errorf("renderCssTemplate() = %q, %q, want %q", got, missing, want)
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+type dashboardCertifiedBaseModel struct {
+	DashboardId          types.Int64  `tfsdk:"dashboard_id"`
+	DashboardTitle       types.String `tfsdk:"dashboard_title"`
+	CertifiedBy          types.String `tfsdk:"certified_by"`
+	CertificationDetails types.String `tfsdk:"certification_details"`
+}
+
+func (model *dashboardCertifiedBaseModel) updateState(d *client.DashboardGetResponseSchema) {
+	model.DashboardId = types.Int64Value(int64(d.Id))
+	model.DashboardTitle = types.StringValue(d.DashboardTitle)
+	model.CertifiedBy = types.StringValue(d.CertifiedBy)
+	model.CertificationDetails = types.StringValue(d.CertificationDetails)
+}
@@ -0,0 +1,153 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dashboardMetadata holds the keys of the json_metadata of a dashboard describing its filters.
+type dashboardMetadata struct {
+	NativeFilterConfiguration []json.RawMessage `json:"native_filter_configuration"`
+	CrossFiltersEnabled       *bool             `json:"cross_filters_enabled"`
+}
+
+// nativeFilter is an entry of the native_filter_configuration of a dashboard, a filter or a divider.
+type nativeFilter struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	FilterType  string `json:"filterType"`
+	Description string `json:"description"`
+	Targets     []struct {
+		DatasetId *int `json:"datasetId"`
+		Column    *struct {
+			Name string `json:"name"`
+		} `json:"column"`
+	} `json:"targets"`
+	CascadeParentIds []string `json:"cascadeParentIds"`
+	Scope            struct {
+		RootPath []string `json:"rootPath"`
+		Excluded []int64  `json:"excluded"`
+	} `json:"scope"`
+	ChartsInScope   []int64         `json:"chartsInScope"`
+	ControlValues   json.RawMessage `json:"controlValues"`
+	DefaultDataMask json.RawMessage `json:"defaultDataMask"`
+}
+
+type dashboardFilterStateBaseModel struct {
+	DashboardId               types.Int64                  `tfsdk:"dashboard_id"`
+	DashboardTitle            types.String                 `tfsdk:"dashboard_title"`
+	CrossFiltersEnabled       types.Bool                   `tfsdk:"cross_filters_enabled"`
+	NativeFilterConfiguration types.String                 `tfsdk:"native_filter_configuration"`
+	Filters                   []dashboardNativeFilterModel `tfsdk:"filters"`
+}
+
+type dashboardNativeFilterModel struct {
+	Id               types.String                       `tfsdk:"id"`
+	Name             types.String                       `tfsdk:"name"`
+	Type             types.String                       `tfsdk:"type"`
+	FilterType       types.String                       `tfsdk:"filter_type"`
+	Description      types.String                       `tfsdk:"description"`
+	Targets          []dashboardNativeFilterTargetModel `tfsdk:"targets"`
+	CascadeParentIds types.List                         `tfsdk:"cascade_parent_ids"`
+	ScopeRootPath    types.List                         `tfsdk:"scope_root_path"`
+	ScopeExcluded    types.List                         `tfsdk:"scope_excluded"`
+	ChartsInScope    types.List                         `tfsdk:"charts_in_scope"`
+	ControlValues    types.String                       `tfsdk:"control_values"`
+	DefaultDataMask  types.String                       `tfsdk:"default_data_mask"`
+	Configuration    types.String                       `tfsdk:"configuration"`
+}
+
+type dashboardNativeFilterTargetModel struct {
+	DatasetId  types.Int64  `tfsdk:"dataset_id"`
+	ColumnName types.String `tfsdk:"column_name"`
+}
+
+// updateState sets the filters from jsonMetadata, the json_metadata of the dashboard. A dashboard without
+// json_metadata has no filters.
+func (model *dashboardFilterStateBaseModel) updateState(ctx context.Context, id int, title string, jsonMetadata string) error {
+	model.DashboardId = types.Int64Value(int64(id))
+	model.DashboardTitle = types.StringValue(title)
+
+	var metadata dashboardMetadata
+	if jsonMetadata != "" {
+		if err := json.Unmarshal([]byte(jsonMetadata), &metadata); err != nil {
+			return fmt.Errorf("invalid json_metadata: %w", err)
+		}
+	}
+
+	model.CrossFiltersEnabled = types.BoolNull()
+	if metadata.CrossFiltersEnabled != nil {
+		model.CrossFiltersEnabled = types.BoolValue(*metadata.CrossFiltersEnabled)
+	}
+
+	if metadata.NativeFilterConfiguration == nil {
+		metadata.NativeFilterConfiguration = []json.RawMessage{}
+	}
+	b, err := json.Marshal(metadata.NativeFilterConfiguration)
+	if err != nil {
+		return err
+	}
+	model.NativeFilterConfiguration = types.StringValue(string(b))
+
+	model.Filters = make([]dashboardNativeFilterModel, 0, len(metadata.NativeFilterConfiguration))
+	for i, raw := range metadata.NativeFilterConfiguration {
+		var f nativeFilter
+		if err := json.Unmarshal(raw, &f); err != nil {
+			return fmt.Errorf("invalid native filter at index %d: %w", i, err)
+		}
+		model.Filters = append(model.Filters, newDashboardNativeFilterModel(ctx, f, raw))
+	}
+	return nil
+}
+
+func newDashboardNativeFilterModel(ctx context.Context, f nativeFilter, raw json.RawMessage) dashboardNativeFilterModel {
+	filter := dashboardNativeFilterModel{
+		Id:              types.StringValue(f.Id),
+		Name:            types.StringValue(f.Name),
+		Type:            types.StringValue(f.Type),
+		FilterType:      emptyAsNull(types.StringValue(f.FilterType)),
+		Description:     emptyAsNull(types.StringValue(f.Description)),
+		Targets:         make([]dashboardNativeFilterTargetModel, 0, len(f.Targets)),
+		ControlValues:   rawJSONValue(f.ControlValues),
+		DefaultDataMask: rawJSONValue(f.DefaultDataMask),
+		Configuration:   types.StringValue(string(raw)),
+	}
+	for _, t := range f.Targets {
+		target := dashboardNativeFilterTargetModel{DatasetId: types.Int64Null(), ColumnName: types.StringNull()}
+		if t.DatasetId != nil {
+			target.DatasetId = types.Int64Value(int64(*t.DatasetId))
+		}
+		if t.Column != nil {
+			target.ColumnName = types.StringValue(t.Column.Name)
+		}
+		filter.Targets = append(filter.Targets, target)
+	}
+	filter.CascadeParentIds, _ = types.ListValueFrom(ctx, types.StringType, nonNil(f.CascadeParentIds))
+	filter.ScopeRootPath, _ = types.ListValueFrom(ctx, types.StringType, nonNil(f.Scope.RootPath))
+	filter.ScopeExcluded, _ = types.ListValueFrom(ctx, types.Int64Type, nonNil(f.Scope.Excluded))
+	filter.ChartsInScope, _ = types.ListValueFrom(ctx, types.Int64Type, nonNil(f.ChartsInScope))
+	return filter
+}
+
+// rawJSONValue returns raw as a string, and null when it is missing or null.
+func rawJSONValue(raw json.RawMessage) types.String {
+	if len(raw) == 0 || string(raw) == "null" {
+		return types.StringNull()
+	}
+	return types.StringValue(string(raw))
+}
+
+// nonNil returns s, or an empty slice when s is nil, so that a missing list is an empty list.
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
@@ -0,0 +1,58 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDashboardFilterStateUpdateState(t *testing.T) {
+	metadata := `{
+		"cross_filters_enabled": true,
+		"native_filter_configuration": [
+			{"id": "NATIVE_FILTER-1", "name": "Region", "type": "NATIVE_FILTER", "filterType": "filter_select",
+			 "targets": [{"datasetId": 3, "column": {"name": "region"}}],
+			 "controlValues": {"multiSelect": true}, "defaultDataMask": {"filterState": {}},
+			 "cascadeParentIds": [], "scope": {"rootPath": ["ROOT_ID"], "excluded": [7]}, "chartsInScope": [5, 6]},
+			{"id": "NATIVE_FILTER_DIVIDER-2", "name": "Time", "type": "DIVIDER"}
+		]
+	}`
+
+	var model dashboardFilterStateBaseModel
+	if err := model.updateState(context.Background(), 12, "Sales", metadata); err != nil {
+		t.Fatal(err)
+	}
+
+	if !model.CrossFiltersEnabled.ValueBool() {
+		t.Errorf("cross_filters_enabled = %s, want true", model.CrossFiltersEnabled)
+	}
+	if len(model.Filters) != 2 {
+		t.Fatalf("expected 2 filters, got %d", len(model.Filters))
+	}
+	region := model.Filters[0]
+	if region.FilterType.ValueString() != "filter_select" || len(region.Targets) != 1 ||
+		region.Targets[0].DatasetId.ValueInt64() != 3 || region.Targets[0].ColumnName.ValueString() != "region" {
+		t.Errorf("unexpected filter: %+v", region)
+	}
+	if region.ControlValues.ValueString() != `{"multiSelect": true}` {
+		t.Errorf("control_values = %s", region.ControlValues)
+	}
+	if len(region.ScopeExcluded.Elements()) != 1 || len(region.ChartsInScope.Elements()) != 2 {
+		t.Errorf("unexpected scope: %s, %s", region.ScopeExcluded, region.ChartsInScope)
+	}
+
+	divider := model.Filters[1]
+	if !divider.FilterType.IsNull() || !divider.ControlValues.IsNull() || len(divider.Targets) != 0 {
+		t.Errorf("unexpected divider: %+v", divider)
+	}
+
+	// A dashboard without json_metadata has no filters.
+	if err := model.updateState(context.Background(), 12, "Sales", ""); err != nil {
+		t.Fatal(err)
+	}
+	if len(model.Filters) != 0 || model.NativeFilterConfiguration.ValueString() != "[]" || !model.CrossFiltersEnabled.IsNull() {
+		t.Errorf("unexpected state of a dashboard without filters: %+v", model)
+	}
+}
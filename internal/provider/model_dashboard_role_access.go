@@ -0,0 +1,62 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+type dashboardRoleAccessBaseModel struct {
+	DashboardId    types.Int64  `tfsdk:"dashboard_id"`
+	DashboardTitle types.String `tfsdk:"dashboard_title"`
+	RoleNames      types.Set    `tfsdk:"role_names"`
+	RoleIds        types.Set    `tfsdk:"role_ids"`
+}
+
+func (model *dashboardRoleAccessBaseModel) roleNames() []string {
+	var names []string
+	for _, v := range model.RoleNames.Elements() {
+		if name, ok := v.(types.String); ok {
+			names = append(names, name.ValueString())
+		}
+	}
+	return names
+}
+
+func (model *dashboardRoleAccessBaseModel) updateState(ctx context.Context, d *client.DashboardGetResponseSchema) {
+	names := make([]string, 0, len(d.Roles))
+	ids := make([]int64, 0, len(d.Roles))
+	for _, r := range d.Roles {
+		names = append(names, r.Name)
+		ids = append(ids, int64(r.Id))
+	}
+
+	model.DashboardId = types.Int64Value(int64(d.Id))
+	model.DashboardTitle = types.StringValue(d.DashboardTitle)
+	model.RoleNames = stringSetValue(names)
+	model.RoleIds, _ = types.SetValueFrom(ctx, types.Int64Type, ids)
+}
+
+// resolveRoleNames returns the IDs of the roles with the given names, and the names of no role.
+func resolveRoleNames(roles []client.SupersetRoleApiGetList, names []string) ([]int, []string) {
+	byName := make(map[string]int, len(roles))
+	for _, r := range roles {
+		byName[r.Name] = r.Id
+	}
+
+	ids := make([]int, 0, len(names))
+	var notFound []string
+	for _, name := range names {
+		id, ok := byName[name]
+		if !ok {
+			notFound = append(notFound, name)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, notFound
+}
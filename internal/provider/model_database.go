@@ -2,3 +2,343 @@
 // SPDX-License-Identifier: MPL-2.0
 
 package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+	"github.com/oapi-codegen/nullable"
+)
+
+// databasePasswordMask is the value the server returns in place of a password.
+const databasePasswordMask = "XXXXXXXXXX"
+
+type databaseBaseModel struct {
+	Id              types.Int64           `tfsdk:"id"`
+	Uuid            types.String          `tfsdk:"uuid"`
+	DatabaseName    types.String          `tfsdk:"database_name"`
+	SqlalchemyUri   types.String          `tfsdk:"sqlalchemy_uri"`
+	Engine          types.String          `tfsdk:"engine"`
+	Driver          types.String          `tfsdk:"driver"`
+	Parameters      types.Map             `tfsdk:"parameters"`
+	ExposeInSqllab  types.Bool            `tfsdk:"expose_in_sqllab"`
+	AllowCtas       types.Bool            `tfsdk:"allow_ctas"`
+	AllowCvas       types.Bool            `tfsdk:"allow_cvas"`
+	AllowDml        types.Bool            `tfsdk:"allow_dml"`
+	AllowFileUpload types.Bool            `tfsdk:"allow_file_upload"`
+	AllowRunAsync   types.Bool            `tfsdk:"allow_run_async"`
+	SshTunnel       *databaseSshTunnel    `tfsdk:"ssh_tunnel"`
+	EncryptedExtra  types.String          `tfsdk:"encrypted_extra"`
+	OAuth2Client    *databaseOAuth2Client `tfsdk:"oauth2_client"`
+	ChangedOn       types.String          `tfsdk:"changed_on"`
+	ChangedBy       types.String          `tfsdk:"changed_by"`
+}
+
+type databaseSshTunnel struct {
+	ServerAddress      types.String `tfsdk:"server_address"`
+	ServerPort         types.Int64  `tfsdk:"server_port"`
+	Username           types.String `tfsdk:"username"`
+	Password           types.String `tfsdk:"password"`
+	PrivateKey         types.String `tfsdk:"private_key"`
+	PrivateKeyPassword types.String `tfsdk:"private_key_password"`
+}
+
+// databaseOAuth2Client is the OAuth2 client users authorize the connections of the database with, stored in
+// the oauth2_client_info key of the encrypted extra of the database.
+type databaseOAuth2Client struct {
+	ClientId                types.String `tfsdk:"client_id"`
+	ClientSecret            types.String `tfsdk:"client_secret"`
+	Scope                   types.String `tfsdk:"scope"`
+	RedirectUri             types.String `tfsdk:"redirect_uri"`
+	AuthorizationRequestUri types.String `tfsdk:"authorization_request_uri"`
+	TokenRequestUri         types.String `tfsdk:"token_request_uri"`
+}
+
+// oauth2ClientInfo is the oauth2_client_info of the encrypted extra of a database.
+type oauth2ClientInfo struct {
+	Id                      string `json:"id"`
+	Secret                  string `json:"secret"`
+	Scope                   string `json:"scope,omitempty"`
+	RedirectUri             string `json:"redirect_uri,omitempty"`
+	AuthorizationRequestUri string `json:"authorization_request_uri,omitempty"`
+	TokenRequestUri         string `json:"token_request_uri,omitempty"`
+}
+
+const encryptedExtraOAuth2ClientInfo = "oauth2_client_info"
+
+// encryptedExtra returns the encrypted extra of the model, the configured encrypted_extra with the
+// oauth2_client_info of the OAuth2 client, and an empty string when neither is configured.
+func (model *databaseBaseModel) encryptedExtra() (string, error) {
+	if model.EncryptedExtra.IsNull() && model.OAuth2Client == nil {
+		return "", nil
+	}
+
+	extra := map[string]interface{}{}
+	if !model.EncryptedExtra.IsNull() {
+		if err := json.Unmarshal([]byte(model.EncryptedExtra.ValueString()), &extra); err != nil || extra == nil {
+			return "", errors.New("encrypted_extra is not a JSON object")
+		}
+	}
+	if model.OAuth2Client != nil {
+		if _, ok := extra[encryptedExtraOAuth2ClientInfo]; ok {
+			return "", fmt.Errorf("encrypted_extra must not contain %s when oauth2_client is set", encryptedExtraOAuth2ClientInfo)
+		}
+		extra[encryptedExtraOAuth2ClientInfo] = oauth2ClientInfo{
+			Id:                      model.OAuth2Client.ClientId.ValueString(),
+			Secret:                  model.OAuth2Client.ClientSecret.ValueString(),
+			Scope:                   model.OAuth2Client.Scope.ValueString(),
+			RedirectUri:             model.OAuth2Client.RedirectUri.ValueString(),
+			AuthorizationRequestUri: model.OAuth2Client.AuthorizationRequestUri.ValueString(),
+			TokenRequestUri:         model.OAuth2Client.TokenRequestUri.ValueString(),
+		}
+	}
+
+	b, err := json.Marshal(extra)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// updateOAuth2Client sets the OAuth2 client from the masked encrypted extra returned by the server. The
+// server masks the client secret, so the configured one is kept. The configured encrypted_extra is kept
+// as well, as its secrets are masked too.
+func (model *databaseBaseModel) updateOAuth2Client(maskedEncryptedExtra string) {
+	var extra struct {
+		OAuth2ClientInfo *oauth2ClientInfo `json:"oauth2_client_info"`
+	}
+	if json.Unmarshal([]byte(maskedEncryptedExtra), &extra) != nil || extra.OAuth2ClientInfo == nil {
+		model.OAuth2Client = nil
+		return
+	}
+	if !model.EncryptedExtra.IsNull() && model.OAuth2Client == nil {
+		// The client is configured in encrypted_extra.
+		return
+	}
+
+	if model.OAuth2Client == nil {
+		// An imported database or a client added outside Terraform; the secret has to be set in the configuration.
+		model.OAuth2Client = &databaseOAuth2Client{ClientSecret: types.StringNull()}
+	}
+	info := extra.OAuth2ClientInfo
+	model.OAuth2Client.ClientId = types.StringValue(info.Id)
+	model.OAuth2Client.Scope = emptyAsNull(types.StringValue(info.Scope))
+	model.OAuth2Client.RedirectUri = emptyAsNull(types.StringValue(info.RedirectUri))
+	model.OAuth2Client.AuthorizationRequestUri = emptyAsNull(types.StringValue(info.AuthorizationRequestUri))
+	model.OAuth2Client.TokenRequestUri = emptyAsNull(types.StringValue(info.TokenRequestUri))
+}
+
+func (tunnel *databaseSshTunnel) request() client.DatabaseSSHTunnel {
+	return client.DatabaseSSHTunnel{
+		ServerAddress:      tunnel.ServerAddress.ValueString(),
+		ServerPort:         int(tunnel.ServerPort.ValueInt64()),
+		Username:           tunnel.Username.ValueString(),
+		Password:           tunnel.Password.ValueString(),
+		PrivateKey:         tunnel.PrivateKey.ValueString(),
+		PrivateKeyPassword: tunnel.PrivateKeyPassword.ValueString(),
+	}
+}
+
+func (model *databaseBaseModel) updateState(db *client.DatabaseConnectionSchema) {
+	model.Id = types.Int64Value(int64(db.Id))
+	model.Uuid = types.StringValue(db.Uuid)
+	model.DatabaseName = nullableStringValue(db.DatabaseName)
+	model.Engine = nullableStringValue(db.Backend)
+	model.Driver = nullableStringValue(db.Driver)
+	model.ExposeInSqllab = types.BoolValue(db.ExposeInSqllab)
+	model.AllowCtas = types.BoolValue(db.AllowCtas)
+	model.AllowCvas = types.BoolValue(db.AllowCvas)
+	model.AllowDml = types.BoolValue(db.AllowDml)
+	model.AllowFileUpload = types.BoolValue(db.AllowFileUpload)
+	model.AllowRunAsync = types.BoolValue(db.AllowRunAsync)
+
+	switch {
+	case !model.Parameters.IsNull():
+		model.Parameters = model.withServerParameters(db.Parameters)
+	case model.SqlalchemyUri.IsNull() && db.ConfigurationMethod == client.DatabaseConfigurationDynamicForm:
+		// An imported database configured with the dynamic form.
+		model.Parameters = model.withServerParameters(db.Parameters)
+	case model.SqlalchemyUri.IsNull():
+		// An imported database; the password is masked and has to be set in the configuration.
+		model.SqlalchemyUri = types.StringValue(db.SqlalchemyUri)
+	}
+	// Otherwise the configured SQLAlchemy URI is kept, as the server masks its password.
+
+	model.updateSshTunnel(db.SshTunnel)
+	masked, _ := db.MaskedEncryptedExtra.Get()
+	model.updateOAuth2Client(masked)
+}
+
+// updateSshTunnel sets the SSH tunnel returned by the server. The server masks the password and the
+// private key, so the configured ones are kept.
+func (model *databaseBaseModel) updateSshTunnel(server nullable.Nullable[client.DatabaseSSHTunnel]) {
+	tunnel, err := server.Get()
+	if err != nil {
+		model.SshTunnel = nil
+		return
+	}
+
+	if model.SshTunnel == nil {
+		// An imported database or a tunnel added outside Terraform; the secrets have to be set in the configuration.
+		model.SshTunnel = &databaseSshTunnel{
+			Password:           types.StringNull(),
+			PrivateKey:         types.StringNull(),
+			PrivateKeyPassword: types.StringNull(),
+		}
+	}
+	model.SshTunnel.ServerAddress = types.StringValue(tunnel.ServerAddress)
+	model.SshTunnel.ServerPort = types.Int64Value(int64(tunnel.ServerPort))
+	model.SshTunnel.Username = types.StringValue(tunnel.Username)
+}
+
+// updateLastModified sets when and by whom the database was last changed, which only the list of
+// databases returns.
+func (model *databaseBaseModel) updateLastModified(db *client.DatabaseRestApiGetList) {
+	model.ChangedOn = nullableStringValue(db.ChangedOn)
+	model.ChangedBy = emptyAsNull(types.StringValue(strings.TrimSpace(db.ChangedBy.FirstName + " " + db.ChangedBy.LastName)))
+}
+
+// withServerParameters returns the parameters returned by the server for the configured parameters, or all
+// of them when none are configured. Masked passwords and parameters the server does not return keep
+// their configured values.
+func (model *databaseBaseModel) withServerParameters(server map[string]interface{}) types.Map {
+	values := map[string]attr.Value{}
+	if model.Parameters.IsNull() || model.Parameters.IsUnknown() {
+		for key, v := range server {
+			if s, ok := parameterString(v); ok && s != databasePasswordMask {
+				values[key] = types.StringValue(s)
+			}
+		}
+		return types.MapValueMust(types.StringType, values)
+	}
+
+	for key, configured := range model.Parameters.Elements() {
+		values[key] = configured
+		if s, ok := parameterString(server[key]); ok && s != databasePasswordMask {
+			values[key] = types.StringValue(s)
+		}
+	}
+	return types.MapValueMust(types.StringType, values)
+}
+
+// parameterString formats a parameter returned by the server the way it is configured: strings as is,
+// and other values as JSON.
+func parameterString(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(b), true
+	}
+}
+
+// databaseParameters converts the configured parameters of a database to the types of the parameters
+// JSON schema of its engine, and returns the problems of the configuration, such as unknown parameters,
+// missing required parameters and values of the wrong type.
+func databaseParameters(schema map[string]interface{}, values map[string]string) (map[string]interface{}, []string) {
+	properties, _ := schema["properties"].(map[string]interface{})
+	known := make([]string, 0, len(properties))
+	for key := range properties {
+		known = append(known, key)
+	}
+	sort.Strings(known)
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var problems []string
+	params := make(map[string]interface{}, len(values))
+	for _, key := range keys {
+		property, ok := properties[key].(map[string]interface{})
+		if !ok {
+			problems = append(problems, fmt.Sprintf("unknown parameter %q, expected one of: %s", key, strings.Join(known, ", ")))
+			continue
+		}
+		v, err := parameterValue(property, values[key])
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("parameter %q: %s", key, err))
+			continue
+		}
+		params[key] = v
+	}
+
+	required, _ := schema["required"].([]interface{})
+	for _, r := range required {
+		key, ok := r.(string)
+		if !ok {
+			continue
+		}
+		if _, set := values[key]; !set {
+			problems = append(problems, fmt.Sprintf("missing required parameter %q", key))
+		}
+	}
+
+	return params, problems
+}
+
+// parameterValue converts value to the type of property, a JSON schema whose type may also allow null.
+func parameterValue(property map[string]interface{}, value string) (interface{}, error) {
+	var typ string
+	switch t := property["type"].(type) {
+	case string:
+		typ = t
+	case []interface{}:
+		for _, v := range t {
+			if s, ok := v.(string); ok && s != "null" {
+				typ = s
+				break
+			}
+		}
+	}
+
+	switch typ {
+	case "integer":
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("expected an integer, got %q", value)
+		}
+		if minimum, ok := property["minimum"].(float64); ok && float64(i) < minimum {
+			return nil, fmt.Errorf("expected at least %v, got %d", minimum, i)
+		}
+		if maximum, ok := property["maximum"].(float64); ok && float64(i) > maximum {
+			return nil, fmt.Errorf("expected at most %v, got %d", maximum, i)
+		}
+		return i, nil
+	case "number":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected a number, got %q", value)
+		}
+		return f, nil
+	case "boolean":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("expected true or false, got %q", value)
+		}
+		return b, nil
+	case "object", "array":
+		var v interface{}
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return nil, fmt.Errorf("expected a JSON %s, e.g. from jsonencode(): %s", typ, err)
+		}
+		return v, nil
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+// catalogAccessPermissionName is the permission Superset generates for each catalog of a multi-catalog database.
+const catalogAccessPermissionName = "catalog_access"
+
+type databaseCatalogPermissionsBaseModel struct {
+	RoleId       types.Int64  `tfsdk:"role_id"`
+	RoleName     types.String `tfsdk:"role_name"`
+	DatabaseId   types.Int64  `tfsdk:"database_id"`
+	DatabaseName types.String `tfsdk:"database_name"`
+	Catalogs     types.Set    `tfsdk:"catalogs"`
+	Permissions  types.Set    `tfsdk:"permissions"`
+}
+
+func (model *databaseCatalogPermissionsBaseModel) updateState(ctx context.Context, role *client.SupersetRoleApiGetList, database *client.SupersetDatabaseApiGetList, permissions []client.SupersetRolePermissionApiGetList) diag.Diagnostics {
+	model.RoleId = types.Int64Value(int64(role.Id))
+	model.RoleName = types.StringValue(role.Name)
+	model.DatabaseId = types.Int64Value(int64(database.Id))
+	model.DatabaseName = types.StringValue(database.DatabaseName)
+	model.Permissions = (&rolePermissionBaseModel{}).flattenPermissionsToList(permissions)
+
+	catalogs := make([]string, 0, len(permissions))
+	for _, p := range permissions {
+		catalogs = append(catalogs, catalogFromViewMenuName(database, p.ViewMenuName))
+	}
+	sort.Strings(catalogs)
+
+	var diags diag.Diagnostics
+	model.Catalogs, diags = types.SetValueFrom(ctx, types.StringType, catalogs)
+	return diags
+}
+
+// catalogAccessViewMenuName returns the view menu name of the catalog_access permission for catalog of database.
+func catalogAccessViewMenuName(database *client.SupersetDatabaseApiGetList, catalog string) string {
+	return fmt.Sprintf("[%s].[%s]", database.DatabaseName, catalog)
+}
+
+// catalogFromViewMenuName returns the catalog of a catalog_access view menu name of database, or "" when the
+// view menu belongs to another database.
+func catalogFromViewMenuName(database *client.SupersetDatabaseApiGetList, viewMenuName string) string {
+	catalog, ok := strings.CutPrefix(viewMenuName, "["+database.DatabaseName+"].[")
+	if !ok {
+		return ""
+	}
+	catalog, ok = strings.CutSuffix(catalog, "]")
+	if !ok {
+		return ""
+	}
+	return catalog
+}
+
+// catalogPermissions returns the catalog_access permissions of database among permissions.
+func catalogPermissions(database *client.SupersetDatabaseApiGetList, permissions []client.SupersetRolePermissionApiGetList) []client.SupersetRolePermissionApiGetList {
+	var catalogs []client.SupersetRolePermissionApiGetList
+	for _, p := range permissions {
+		if p.PermissionName == catalogAccessPermissionName && catalogFromViewMenuName(database, p.ViewMenuName) != "" {
+			catalogs = append(catalogs, p)
+		}
+	}
+	return catalogs
+}
+
+// resolvePermissions returns the catalog_access permissions to grant, and the catalogs Superset has no
+// permission for.
+func (model *databaseCatalogPermissionsBaseModel) resolvePermissions(sourcePermissions []client.SupersetPermissionApiGetList, database *client.SupersetDatabaseApiGetList) ([]client.SupersetRolePermissionApiGetList, []string) {
+	sourcePermissionIdMap := make(map[requiredPermission]int, len(sourcePermissions))
+	for _, p := range sourcePermissions {
+		sourcePermissionIdMap[requiredPermission{p.Permission.Name, p.ViewMenu.Name}] = p.Id
+	}
+
+	var permissions []client.SupersetRolePermissionApiGetList
+	notFoundCatalogs := make([]string, 0)
+	for _, v := range model.Catalogs.Elements() {
+		catalog, ok := v.(types.String)
+		if !ok {
+			continue
+		}
+		p := requiredPermission{catalogAccessPermissionName, catalogAccessViewMenuName(database, catalog.ValueString())}
+		id, exists := sourcePermissionIdMap[p]
+		if !exists {
+			notFoundCatalogs = append(notFoundCatalogs, catalog.ValueString())
+			continue
+		}
+		permissions = append(permissions, client.SupersetRolePermissionApiGetList{Id: id, PermissionName: p.PermissionName, ViewMenuName: p.ViewMenuName})
+	}
+
+	return permissions, notFoundCatalogs
+}
+
+// revokedCatalogPermissions returns the catalog_access permissions of database among current that are not in grants.
+func revokedCatalogPermissions(database *client.SupersetDatabaseApiGetList, current, grants []client.SupersetRolePermissionApiGetList) []requiredPermission {
+	granted := make(map[int]bool, len(grants))
+	for _, p := range grants {
+		granted[p.Id] = true
+	}
+
+	var revoke []requiredPermission
+	for _, p := range catalogPermissions(database, current) {
+		if !granted[p.Id] {
+			revoke = append(revoke, requiredPermission{p.PermissionName, p.ViewMenuName})
+		}
+	}
+	return revoke
+}
@@ -9,28 +9,44 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/htamakos/terraform-provider-superset/internal/client"
+	"github.com/oapi-codegen/nullable"
 )
 
 type datasetBaseModel struct {
 	Id                    types.Int64  `tfsdk:"id"`
 	DatabaseId            types.Int64  `tfsdk:"database_id"`
 	DatabaseName          types.String `tfsdk:"database_name"`
+	CreationDatabaseId    types.Int64  `tfsdk:"creation_database_id"`
+	CreationDatabaseName  types.String `tfsdk:"creation_database_name"`
 	BootstrapDatabaseId   types.Int64  `tfsdk:"bootstrap_database_id"`
 	BootstrapDatabaseName types.String `tfsdk:"bootstrap_database_name"`
 	Catalog               types.String `tfsdk:"catalog"`
 	Schema                types.String `tfsdk:"schema"`
 	TableName             types.String `tfsdk:"table_name"`
 	Sql                   types.String `tfsdk:"sql"`
+	SavedQueryLabel       types.String `tfsdk:"saved_query_label"`
+	PinRevision           types.Bool   `tfsdk:"pin_revision"`
 	Description           types.String `tfsdk:"description"`
 	CacheTimeout          types.Int64  `tfsdk:"cache_timeout"`
 	IsManagedExternally   types.Bool   `tfsdk:"is_managed_externally"`
 	FilterSelectEnabled   types.Bool   `tfsdk:"filter_select_enabled"`
 	FetchValuesPredicate  types.String `tfsdk:"fetch_values_predicate"`
 	AlwaysFilterMainDttm  types.Bool   `tfsdk:"always_filter_main_dttm"`
+	MainDttmCol           types.String `tfsdk:"main_dttm_col"`
+	DefaultEndpoint       types.String `tfsdk:"default_endpoint"`
+	Offset                types.Int64  `tfsdk:"offset"`
+	TemplateParams        types.String `tfsdk:"template_params"`
 	NormalizeColumns      types.Bool   `tfsdk:"normalize_columns"`
 	OwnerIds              types.Set    `tfsdk:"owner_ids"`
 	CertifiedBy           types.String `tfsdk:"certified_by"`
 	CertificationDetails  types.String `tfsdk:"certification_details"`
+	CreateAccessRole      types.Bool   `tfsdk:"create_access_role"`
+	AccessRolePrefix      types.String `tfsdk:"access_role_prefix"`
+	AccessRoleId          types.Int64  `tfsdk:"access_role_id"`
+	AccessRoleName        types.String `tfsdk:"access_role_name"`
+	CreatedOn             types.String `tfsdk:"created_on"`
+	ChangedOn             types.String `tfsdk:"changed_on"`
+	ChangedBy             types.String `tfsdk:"changed_by"`
 }
 
 type datasetExtra struct {
@@ -117,6 +133,18 @@ func (model *datasetBaseModel) updateState(d *client.DatasetRestApiGet) error {
 	} else {
 		model.AlwaysFilterMainDttm = types.BoolNull()
 	}
+	model.MainDttmCol = emptyAsNull(nullableStringValue(d.MainDttmCol))
+	model.DefaultEndpoint = emptyAsNull(nullableStringValue(d.DefaultEndpoint))
+	model.TemplateParams = emptyAsNull(nullableStringValue(d.TemplateParams))
+	model.CreatedOn = nullableStringValue(d.CreatedOn)
+	model.ChangedOn = nullableStringValue(d.ChangedOn)
+	// The server returns the name of the user, not their username.
+	model.ChangedBy = emptyAsNull(types.StringValue(datasetLastModified(d).ChangedBy))
+	if d.Offset.IsNull() || !d.Offset.IsSpecified() {
+		model.Offset = types.Int64Value(0)
+	} else {
+		model.Offset = types.Int64Value(int64(d.Offset.MustGet()))
+	}
 	if !d.NormalizeColumns.IsNull() {
 		model.NormalizeColumns = types.BoolValue(d.NormalizeColumns.MustGet())
 	} else {
@@ -131,3 +159,41 @@ func (model *datasetBaseModel) updateState(d *client.DatasetRestApiGet) error {
 
 	return nil
 }
+
+// setQueryFields sets the main_dttm_col, default_endpoint, offset and template_params of model on putData.
+// default_endpoint and template_params are cleared when they are removed from prior, the state.
+func (model *datasetBaseModel) setQueryFields(putData *client.DatasetRestApiPut, prior *datasetBaseModel) {
+	if !model.MainDttmCol.IsNull() && !model.MainDttmCol.IsUnknown() {
+		putData.MainDttmCol = nullable.NewNullableWithValue(model.MainDttmCol.ValueString())
+	}
+	if !model.Offset.IsNull() && !model.Offset.IsUnknown() {
+		putData.Offset = nullable.NewNullableWithValue(int(model.Offset.ValueInt64()))
+	}
+
+	if !model.DefaultEndpoint.IsNull() {
+		putData.DefaultEndpoint = nullable.NewNullableWithValue(model.DefaultEndpoint.ValueString())
+	} else if prior != nil && !prior.DefaultEndpoint.IsNull() {
+		putData.DefaultEndpoint = nullable.NewNullNullable[string]()
+	}
+	if !model.TemplateParams.IsNull() {
+		putData.TemplateParams = nullable.NewNullableWithValue(model.TemplateParams.ValueString())
+	} else if prior != nil && !prior.TemplateParams.IsNull() {
+		putData.TemplateParams = nullable.NewNullNullable[string]()
+	}
+}
+
+// accessRoleName returns the name of the companion role created with create_access_role.
+// setCreationDatabaseId sets the ID of the creation database under its current and deprecated names.
+func (model *datasetBaseModel) setCreationDatabaseId(id int) {
+	model.CreationDatabaseId = types.Int64Value(int64(id))
+	model.BootstrapDatabaseId = model.CreationDatabaseId
+}
+
+func (model *datasetBaseModel) accessRoleName() string {
+	return model.AccessRolePrefix.ValueString() + "_" + model.TableName.ValueString()
+}
+
+// datasourceAccessViewMenuName returns the view menu name of the datasource_access permission for the dataset.
+func datasourceAccessViewMenuName(d *client.DatasetRestApiGet) string {
+	return fmt.Sprintf("[%s].[%s](id:%d)", d.Database.DatabaseName, d.TableName, d.Id)
+}
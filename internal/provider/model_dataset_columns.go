@@ -6,6 +6,7 @@ package provider
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/htamakos/terraform-provider-superset/internal/client"
@@ -15,6 +16,41 @@ type datasetColumnsBaseModel struct {
 	DatasetId   types.Int64              `tfsdk:"dataset_id"`
 	DatasetName types.String             `tfsdk:"dataset_name"`
 	Columns     map[string]datasetColumn `tfsdk:"columns"`
+	// Authoritative removes the columns of the dataset that are not configured. When false, they are
+	// kept and left out of the state.
+	Authoritative   types.Bool `tfsdk:"authoritative"`
+	PendingRemovals types.Set  `tfsdk:"pending_removals"`
+}
+
+// isAuthoritative reports whether the configured columns replace all columns of the dataset. Imported
+// resources and the HCL generation manage all columns.
+func (model *datasetColumnsBaseModel) isAuthoritative() bool {
+	return model.Authoritative.IsNull() || model.Authoritative.IsUnknown() || model.Authoritative.ValueBool()
+}
+
+// unmanagedColumns returns the columns of the dataset that are not configured, to keep them as they
+// are when the resource is not authoritative.
+func (model *datasetColumnsBaseModel) unmanagedColumns(columns []client.DatasetRestApiGetTableColumn) []client.DatasetColumnsPut {
+	if model.isAuthoritative() {
+		return nil
+	}
+
+	managed := model.columnNames()
+	var unmanaged []client.DatasetColumnsPut
+	for _, c := range columns {
+		if !managed[c.ColumnName] {
+			unmanaged = append(unmanaged, client.DatasetColumnsPut{Id: c.Id, ColumnName: c.ColumnName})
+		}
+	}
+	return unmanaged
+}
+
+func (model *datasetColumnsBaseModel) columnNames() map[string]bool {
+	names := make(map[string]bool, len(model.Columns))
+	for _, c := range model.Columns {
+		names[c.ColumnName.ValueString()] = true
+	}
+	return names
 }
 
 type datasetColumn struct {
@@ -31,6 +67,8 @@ type datasetColumn struct {
 	IsDttm               types.Bool   `tfsdk:"is_dttm"`
 	Type                 types.String `tfsdk:"type"`
 	VerboseName          types.String `tfsdk:"verbose_name"`
+	WarningMarkdown      types.String `tfsdk:"warning_markdown"`
+	Extra                types.String `tfsdk:"extra"`
 }
 
 type datasetColumnExtra struct {
@@ -42,16 +80,45 @@ type datasetColumnExtraCertification struct {
 	CertificationDetails string `json:"details"`
 }
 
+// datasetColumnManagedExtraKeys are the keys of the column extra field exposed as dedicated attributes.
+var datasetColumnManagedExtraKeys = map[string]string{
+	"certification":    "certified_by",
+	"warning_markdown": "warning_markdown",
+}
+
 func (model *datasetColumn) toExtra() (string, error) {
-	if model.CertifiedBy.IsNull() && model.CertificationDetails.IsNull() {
-		return "", nil
+	extraData := make(map[string]json.RawMessage)
+	if !model.Extra.IsNull() && !model.Extra.IsUnknown() && model.Extra.ValueString() != "" {
+		if err := json.Unmarshal([]byte(model.Extra.ValueString()), &extraData); err != nil {
+			return "", fmt.Errorf("extra of column '%s' must be a JSON object: %w", model.ColumnName.ValueString(), err)
+		}
+		for key, attribute := range datasetColumnManagedExtraKeys {
+			if _, ok := extraData[key]; ok {
+				return "", fmt.Errorf("extra of column '%s' must not contain the %q key, use the %s attribute instead", model.ColumnName.ValueString(), key, attribute)
+			}
+		}
 	}
 
-	extraData := datasetColumnExtra{
-		Certification: datasetColumnExtraCertification{
+	if !model.CertifiedBy.IsNull() || !model.CertificationDetails.IsNull() {
+		certification, err := json.Marshal(datasetColumnExtraCertification{
 			CertifiedBy:          model.CertifiedBy.ValueString(),
 			CertificationDetails: model.CertificationDetails.ValueString(),
-		},
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal extra data for column '%s': %w", model.ColumnName, err)
+		}
+		extraData["certification"] = certification
+	}
+	if !model.WarningMarkdown.IsNull() && model.WarningMarkdown.ValueString() != "" {
+		warning, err := json.Marshal(model.WarningMarkdown.ValueString())
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal extra data for column '%s': %w", model.ColumnName, err)
+		}
+		extraData["warning_markdown"] = warning
+	}
+
+	if len(extraData) == 0 {
+		return "", nil
 	}
 
 	extraBytes, err := json.Marshal(extraData)
@@ -62,30 +129,79 @@ func (model *datasetColumn) toExtra() (string, error) {
 	return string(extraBytes), nil
 }
 
-func (model *datasetColumn) parseCertification(d *client.DatasetRestApiGetTableColumn) error {
-	if !d.Extra.IsNull() && d.Extra.MustGet() != "" {
-		var extraData datasetColumnExtra
+func (model *datasetColumn) parseExtra(d *client.DatasetRestApiGetTableColumn) error {
+	model.WarningMarkdown = types.StringNull()
+	model.Extra = types.StringNull()
 
-		if err := json.Unmarshal([]byte(d.Extra.MustGet()), &extraData); err != nil {
-			return fmt.Errorf("failed to parse extra field for column '%s': %w", model.ColumnName, err)
-		}
+	if d.Extra.IsNull() || d.Extra.MustGet() == "" {
+		return nil
+	}
 
-		if extraData.Certification.CertifiedBy != "" {
-			model.CertifiedBy = types.StringValue(extraData.Certification.CertifiedBy)
+	var extraData map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(d.Extra.MustGet()), &extraData); err != nil {
+		return fmt.Errorf("failed to parse extra field for column '%s': %w", model.ColumnName, err)
+	}
+
+	if raw, ok := extraData["certification"]; ok {
+		var certification datasetColumnExtraCertification
+		if err := json.Unmarshal(raw, &certification); err != nil {
+			return fmt.Errorf("failed to parse certification of column '%s': %w", model.ColumnName, err)
+		}
+		if certification.CertifiedBy != "" {
+			model.CertifiedBy = types.StringValue(certification.CertifiedBy)
 		}
-		if extraData.Certification.CertificationDetails != "" {
-			model.CertificationDetails = types.StringValue(extraData.Certification.CertificationDetails)
+		if certification.CertificationDetails != "" {
+			model.CertificationDetails = types.StringValue(certification.CertificationDetails)
 		}
 	}
+	if raw, ok := extraData["warning_markdown"]; ok {
+		var warning string
+		if err := json.Unmarshal(raw, &warning); err != nil {
+			return fmt.Errorf("failed to parse warning_markdown of column '%s': %w", model.ColumnName, err)
+		}
+		if warning != "" {
+			model.WarningMarkdown = types.StringValue(warning)
+		}
+	}
+
+	model.Extra = unmanagedColumnExtra(extraData)
 
 	return nil
 }
 
+// unmanagedColumnExtra returns the keys of the column extra field without a dedicated attribute as a
+// JSON string, or null when there are none.
+func unmanagedColumnExtra(extraData map[string]json.RawMessage) types.String {
+	unmanaged := make(map[string]json.RawMessage, len(extraData))
+	for key, value := range extraData {
+		if _, ok := datasetColumnManagedExtraKeys[key]; !ok {
+			unmanaged[key] = value
+		}
+	}
+	if len(unmanaged) == 0 {
+		return types.StringNull()
+	}
+
+	b, err := json.Marshal(unmanaged)
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(string(b))
+}
+
 func (model *datasetColumnsBaseModel) updateState(d *client.DatasetRestApiGet) error {
 	model.DatasetId = types.Int64Value(int64(d.Id))
 	model.DatasetName = types.StringValue(d.TableName)
+	if model.Authoritative.IsNull() {
+		// An imported resource, which manages all columns of the dataset.
+		model.Authoritative = types.BoolValue(true)
+	}
+	managed := model.columnNames()
 	columns := make(map[string]datasetColumn)
 	for _, column := range d.Columns {
+		if !model.isAuthoritative() && !managed[column.ColumnName] {
+			continue
+		}
 		var c datasetColumn
 		if err := c.updateState(&column); err != nil {
 			return err
@@ -144,14 +260,17 @@ func (model *datasetColumn) updateState(d *client.DatasetRestApiGetTableColumn)
 	} else {
 		model.VerboseName = types.StringValue(d.VerboseName.MustGet())
 	}
-	if err := model.parseCertification(d); err != nil {
+	if err := model.parseExtra(d); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (model *datasetColumnsBaseModel) resovleColumns(columns []client.DatasetRestApiGetTableColumn) []datasetColumn {
+// resovleColumns sets the IDs of the configured columns from the columns of the dataset. A configured
+// column missing from the dataset is a new calculated column, which is created without an ID, so it
+// needs an expression.
+func (model *datasetColumnsBaseModel) resovleColumns(columns []client.DatasetRestApiGetTableColumn) ([]datasetColumn, error) {
 	var resolvedColumns []datasetColumn
 
 	mapColumnNameToColumn := make(map[string]client.DatasetRestApiGetTableColumn)
@@ -163,11 +282,46 @@ func (model *datasetColumnsBaseModel) resovleColumns(columns []client.DatasetRes
 		columnName := column.ColumnName.ValueString()
 		if c, ok := mapColumnNameToColumn[columnName]; ok {
 			column.Id = types.Int64Value(int64(c.Id))
-			resolvedColumns = append(resolvedColumns, column)
+			if column.Type.IsUnknown() && !c.Type.IsNull() {
+				column.Type = types.StringValue(c.Type.MustGet())
+			}
+			if column.Extra.IsUnknown() {
+				// Preserve the keys set outside of Terraform when extra is not configured.
+				var extraData map[string]json.RawMessage
+				if !c.Extra.IsNull() && json.Unmarshal([]byte(c.Extra.MustGet()), &extraData) == nil {
+					column.Extra = unmanagedColumnExtra(extraData)
+				}
+			}
 		} else {
-			resolvedColumns = append(resolvedColumns, column)
+			if column.Expression.IsNull() || column.Expression.IsUnknown() || column.Expression.ValueString() == "" {
+				return nil, fmt.Errorf("column '%s' does not exist in the dataset, set its expression to create it as a calculated column", columnName)
+			}
+			// The ID of the state belongs to a column deleted outside of Terraform.
+			column.Id = types.Int64Null()
 		}
+		resolvedColumns = append(resolvedColumns, column)
+	}
+
+	return resolvedColumns, nil
+}
+
+// checkApplied returns an error when a configured column is missing from d, the dataset returned by
+// the update, so that a calculated column Superset did not create is not recorded in the state.
+func (model *datasetColumnsBaseModel) checkApplied(d *client.DatasetRestApiGet) error {
+	ids := make(map[string]int, len(d.Columns))
+	for _, c := range d.Columns {
+		ids[c.ColumnName] = c.Id
 	}
 
-	return resolvedColumns
+	var missing []string
+	for _, column := range model.Columns {
+		if ids[column.ColumnName.ValueString()] == 0 {
+			missing = append(missing, column.ColumnName.ValueString())
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("the dataset has no columns %v after the update", missing)
+	}
+	return nil
 }
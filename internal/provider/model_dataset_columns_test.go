@@ -0,0 +1,182 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+	"github.com/oapi-codegen/nullable"
+)
+
+func TestDatasetColumnExtraRoundTrip(t *testing.T) {
+	extra := `{"certification":{"certified_by":"Data Team","details":"Reviewed"},"group":{"name":"dimensions","order":2},"warning_markdown":"Deprecated"}`
+
+	var c datasetColumn
+	c.ColumnName = types.StringValue("region")
+	if err := c.parseExtra(&client.DatasetRestApiGetTableColumn{Extra: nullable.NewNullableWithValue(extra)}); err != nil {
+		t.Fatalf("parseExtra: %s", err)
+	}
+
+	if got := c.CertifiedBy.ValueString(); got != "Data Team" {
+		t.Errorf("certified_by = %q, want %q", got, "Data Team")
+	}
+	if got := c.WarningMarkdown.ValueString(); got != "Deprecated" {
+		t.Errorf("warning_markdown = %q, want %q", got, "Deprecated")
+	}
+	if got, want := c.Extra.ValueString(), `{"group":{"name":"dimensions","order":2}}`; got != want {
+		t.Errorf("extra = %s, want %s", got, want)
+	}
+
+	got, err := c.toExtra()
+	if err != nil {
+		t.Fatalf("toExtra: %s", err)
+	}
+	if got != extra {
+		t.Errorf("toExtra() = %s, want %s", got, extra)
+	}
+}
+
+func TestDatasetColumnExtraRejectsManagedKeys(t *testing.T) {
+	c := datasetColumn{
+		ColumnName: types.StringValue("region"),
+		Extra:      types.StringValue(`{"warning_markdown":"Deprecated"}`),
+	}
+	if _, err := c.toExtra(); err == nil {
+		t.Error("toExtra() succeeded, want an error for the warning_markdown key")
+	}
+}
+
+func TestDatasetColumnExtraEmpty(t *testing.T) {
+	c := datasetColumn{
+		ColumnName:           types.StringValue("region"),
+		CertifiedBy:          types.StringNull(),
+		CertificationDetails: types.StringNull(),
+		WarningMarkdown:      types.StringNull(),
+		Extra:                types.StringNull(),
+	}
+	got, err := c.toExtra()
+	if err != nil {
+		t.Fatalf("toExtra: %s", err)
+	}
+	if got != "" {
+		t.Errorf("toExtra() = %s, want an empty string", got)
+	}
+}
+
+func TestResolveColumnsCalculatedColumn(t *testing.T) {
+	model := datasetColumnsBaseModel{
+		Columns: map[string]datasetColumn{
+			"region": {
+				Id:         types.Int64Unknown(),
+				ColumnName: types.StringValue("region"),
+				Expression: types.StringNull(),
+				Type:       types.StringUnknown(),
+				Extra:      types.StringNull(),
+			},
+			"order_year": {
+				// A stale ID of a column deleted outside of Terraform.
+				Id:         types.Int64Value(7),
+				ColumnName: types.StringValue("order_year"),
+				Expression: types.StringValue("EXTRACT(YEAR FROM order_date)"),
+				Type:       types.StringUnknown(),
+				Extra:      types.StringNull(),
+			},
+		},
+	}
+	existing := []client.DatasetRestApiGetTableColumn{
+		{Id: 3, ColumnName: "region", Type: nullable.NewNullableWithValue("VARCHAR")},
+	}
+
+	columns, err := model.resovleColumns(existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range columns {
+		switch c.ColumnName.ValueString() {
+		case "region":
+			if c.Id.ValueInt64() != 3 || c.Type.ValueString() != "VARCHAR" {
+				t.Errorf("region = id %s, type %s, want the existing column", c.Id, c.Type)
+			}
+		case "order_year":
+			if !c.Id.IsNull() || !c.Type.IsUnknown() {
+				t.Errorf("order_year = id %s, type %s, want a new column", c.Id, c.Type)
+			}
+		}
+	}
+
+	if err := model.checkApplied(&client.DatasetRestApiGet{Columns: existing}); err == nil {
+		t.Error("checkApplied succeeded, want an error for the missing calculated column")
+	}
+	created := append(existing, client.DatasetRestApiGetTableColumn{Id: 8, ColumnName: "order_year"})
+	if err := model.checkApplied(&client.DatasetRestApiGet{Columns: created}); err != nil {
+		t.Error(err)
+	}
+
+	model.Columns["margin"] = datasetColumn{ColumnName: types.StringValue("margin"), Expression: types.StringNull()}
+	if _, err := model.resovleColumns(existing); err == nil {
+		t.Error("resovleColumns succeeded, want an error for a new column without expression")
+	}
+}
+
+func TestDatasetColumnsNotAuthoritative(t *testing.T) {
+	model := datasetColumnsBaseModel{
+		Authoritative: types.BoolValue(false),
+		Columns: map[string]datasetColumn{
+			"region": {ColumnName: types.StringValue("region"), Expression: types.StringNull()},
+		},
+	}
+	d := &client.DatasetRestApiGet{
+		Id:        1,
+		TableName: "orders",
+		Columns: []client.DatasetRestApiGetTableColumn{
+			testTableColumn(3, "region"),
+			testTableColumn(4, "amount"),
+		},
+	}
+
+	unmanaged := model.unmanagedColumns(d.Columns)
+	if len(unmanaged) != 1 || unmanaged[0].Id != 4 || unmanaged[0].ColumnName != "amount" {
+		t.Errorf("unmanagedColumns = %v, want the amount column", unmanaged)
+	}
+
+	if err := model.updateState(d); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := model.Columns["amount"]; ok || len(model.Columns) != 1 {
+		t.Errorf("columns = %v, want only the managed region column", slices.Collect(maps.Keys(model.Columns)))
+	}
+
+	model.Authoritative = types.BoolValue(true)
+	if unmanaged := model.unmanagedColumns(d.Columns); unmanaged != nil {
+		t.Errorf("unmanagedColumns = %v, want none for an authoritative resource", unmanaged)
+	}
+	if err := model.updateState(d); err != nil {
+		t.Fatal(err)
+	}
+	if len(model.Columns) != 2 {
+		t.Errorf("columns = %v, want all columns", slices.Collect(maps.Keys(model.Columns)))
+	}
+}
+
+// testTableColumn returns a column of a dataset as read from the API, with its optional fields null.
+func testTableColumn(id int, name string) client.DatasetRestApiGetTableColumn {
+	return client.DatasetRestApiGetTableColumn{
+		Id:               id,
+		ColumnName:       name,
+		AdvancedDataType: nullable.NewNullNullable[string](),
+		Description:      nullable.NewNullNullable[string](),
+		Expression:       nullable.NewNullNullable[string](),
+		Extra:            nullable.NewNullNullable[string](),
+		Filterable:       nullable.NewNullNullable[bool](),
+		Groupby:          nullable.NewNullNullable[bool](),
+		IsActive:         nullable.NewNullNullable[bool](),
+		IsDttm:           nullable.NewNullNullable[bool](),
+		Type:             nullable.NewNullNullable[string](),
+		VerboseName:      nullable.NewNullNullable[string](),
+	}
+}
@@ -15,9 +15,10 @@ import (
 )
 
 type datasetFolderBaseModel struct {
-	DatasetId   types.Int64          `tfsdk:"dataset_id"`
-	DatasetName types.String         `tfsdk:"dataset_name"`
-	Folders     []datasetFolderModel `tfsdk:"folders"`
+	DatasetId       types.Int64          `tfsdk:"dataset_id"`
+	DatasetName     types.String         `tfsdk:"dataset_name"`
+	Folders         []datasetFolderModel `tfsdk:"folders"`
+	PendingRemovals types.Set            `tfsdk:"pending_removals"`
 }
 
 type datasetFolderModel struct {
@@ -156,6 +157,101 @@ func (model *datasetFolderModel) resolveColumns(d *client.DatasetRestApiGet) {
 	}
 }
 
+// missingChildren returns the columns and metrics of the folders that are not in d, e.g. as they are created
+// by a superset_dataset_columns or superset_dataset_metrics resource applied at the same time.
+func (model *datasetFolderBaseModel) missingChildren(d *client.DatasetRestApiGet) []string {
+	var missing []string
+	for _, folder := range model.Folders {
+		for _, child := range folder.Children {
+			name := child.Name.ValueString()
+			switch child.Type.ValueString() {
+			case string(client.FolderTypeColumn):
+				if findColumnByName(d.Columns, name) == nil {
+					missing = append(missing, fmt.Sprintf("column %q", name))
+				}
+			case string(client.FolderTypeMetric):
+				if findMetricByName(d.Metrics, name) == nil {
+					missing = append(missing, fmt.Sprintf("metric %q", name))
+				}
+			}
+		}
+	}
+	return missing
+}
+
+// pruneFolderChildren removes from folders the columns and metrics that are no longer in d, so that the
+// folders stay valid when a column or metric they list is deleted, at any depth of nested folders. It
+// reports whether a child was removed.
+func pruneFolderChildren(folders []client.Folder, d *client.DatasetRestApiGet) ([]client.Folder, bool) {
+	// Without the UUIDs of all the columns and metrics, the children of the folders cannot be checked.
+	uuids := make(map[string]bool, len(d.Columns)+len(d.Metrics))
+	for _, c := range d.Columns {
+		if !c.Uuid.IsSpecified() || c.Uuid.IsNull() {
+			return folders, false
+		}
+		uuids[c.Uuid.MustGet().String()] = true
+	}
+	for _, m := range d.Metrics {
+		if !m.Uuid.IsSpecified() || m.Uuid.IsNull() {
+			return folders, false
+		}
+		uuids[m.Uuid.MustGet().String()] = true
+	}
+
+	return pruneFolders(folders, uuids)
+}
+
+// pruneFolders removes from the children of folders, and of their nested folders, the columns and metrics
+// whose UUIDs are not in uuids.
+func pruneFolders(folders []client.Folder, uuids map[string]bool) ([]client.Folder, bool) {
+	pruned := false
+	for i, folder := range folders {
+		if folder.Children.IsNull() || !folder.Children.IsSpecified() {
+			continue
+		}
+		children := folder.Children.MustGet()
+		kept := make([]client.Folder, 0, len(children))
+		for _, child := range children {
+			switch child.Type {
+			case client.FolderTypeColumn, client.FolderTypeMetric:
+				if !uuids[child.Uuid.String()] {
+					pruned = true
+					continue
+				}
+			case client.FolderTypeFolder:
+				nested, nestedPruned := pruneFolders([]client.Folder{child}, uuids)
+				child = nested[0]
+				pruned = pruned || nestedPruned
+			}
+			kept = append(kept, child)
+		}
+		folders[i].Children = nullable.NewNullableWithValue(kept)
+	}
+	return folders, pruned
+}
+
+// resolveFolderUuids keeps the UUIDs of the root level folders of current, the folders of the dataset,
+// matched by name, so that reordering the folders updates them instead of replacing them with new ones.
+func (model *datasetFolderBaseModel) resolveFolderUuids(current []client.Folder) {
+	uuids := make(map[string]string, len(current))
+	for _, f := range current {
+		if _, ok := uuids[f.Name]; !ok {
+			uuids[f.Name] = f.Uuid.String()
+		}
+	}
+
+	for i, folder := range model.Folders {
+		if !folder.Uuid.IsNull() && !folder.Uuid.IsUnknown() && folder.Uuid.ValueString() != "" {
+			continue
+		}
+		if u, ok := uuids[folder.Name.ValueString()]; ok {
+			model.Folders[i].Uuid = types.StringValue(u)
+			// A folder configured twice only keeps the UUID once.
+			delete(uuids, folder.Name.ValueString())
+		}
+	}
+}
+
 func (model *datasetFolderBaseModel) toFolders() ([]client.Folder, error) {
 	var folders []client.Folder
 	for _, folderModel := range model.Folders {
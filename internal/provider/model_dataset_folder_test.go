@@ -0,0 +1,137 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+	"github.com/oapi-codegen/nullable"
+)
+
+func TestDatasetFolderKeepsOrder(t *testing.T) {
+	var folders interface{}
+	err := json.Unmarshal([]byte(`[
+		{"name": "Sales", "type": "folder", "uuid": "5b0e6c1a-0000-4000-8000-000000000001", "children": [
+			{"name": "revenue", "type": "metric", "uuid": "5b0e6c1a-0000-4000-8000-000000000011"},
+			{"name": "order_date", "type": "column", "uuid": "5b0e6c1a-0000-4000-8000-000000000012"},
+			{"name": "count", "type": "metric", "uuid": "5b0e6c1a-0000-4000-8000-000000000013"}
+		]},
+		{"name": "Customers", "type": "folder", "uuid": "5b0e6c1a-0000-4000-8000-000000000002", "children": [
+			{"name": "customer_id", "type": "column", "uuid": "5b0e6c1a-0000-4000-8000-000000000021"}
+		]}
+	]`), &folders)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var model datasetFolderBaseModel
+	if err := model.updateState(&client.DatasetRestApiGet{Id: 1, TableName: "orders", Folders: nullable.NewNullableWithValue(folders)}); err != nil {
+		t.Fatal(err)
+	}
+
+	written, err := model.toFolders()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range written {
+		names = append(names, f.Name)
+		for _, c := range f.Children.MustGet() {
+			names = append(names, c.Name)
+		}
+	}
+	want := []string{"Sales", "revenue", "order_date", "count", "Customers", "customer_id"}
+	if !slices.Equal(names, want) {
+		t.Errorf("folders = %v, want %v", names, want)
+	}
+}
+
+func TestDatasetFolderResolveFolderUuids(t *testing.T) {
+	sales := uuid.MustParse("5b0e6c1a-0000-4000-8000-000000000001")
+	customers := uuid.MustParse("5b0e6c1a-0000-4000-8000-000000000002")
+	current := []client.Folder{{Name: "Sales", Uuid: sales}, {Name: "Customers", Uuid: customers}}
+
+	// The folders are reordered, and Sales is configured twice.
+	model := datasetFolderBaseModel{Folders: []datasetFolderModel{{}, {}, {}, {}}}
+	for i, name := range []string{"Customers", "Sales", "Sales", "Products"} {
+		model.Folders[i].Name = types.StringValue(name)
+		model.Folders[i].Uuid = types.StringUnknown()
+	}
+	model.resolveFolderUuids(current)
+
+	for i, want := range []string{customers.String(), sales.String(), "", ""} {
+		got := model.Folders[i].Uuid
+		if want == "" {
+			if !got.IsUnknown() {
+				t.Errorf("folders[%d].uuid = %s, want unknown", i, got)
+			}
+		} else if got.ValueString() != want {
+			t.Errorf("folders[%d].uuid = %s, want %s", i, got, want)
+		}
+	}
+}
+
+func TestPruneFolderChildren(t *testing.T) {
+	kept := uuid.MustParse("5b0e6c1a-0000-4000-8000-000000000011")
+	deleted := uuid.MustParse("5b0e6c1a-0000-4000-8000-000000000012")
+	d := &client.DatasetRestApiGet{
+		Columns: []client.DatasetRestApiGetTableColumn{{ColumnName: "order_date", Uuid: nullable.NewNullableWithValue(kept)}},
+	}
+	folders := []client.Folder{{
+		Name: "Sales",
+		Type: client.FolderTypeFolder,
+		Children: nullable.NewNullableWithValue([]client.Folder{
+			{Name: "order_date", Type: client.FolderTypeColumn, Uuid: kept},
+			{Name: "revenue", Type: client.FolderTypeMetric, Uuid: deleted},
+		}),
+	}}
+
+	folders, pruned := pruneFolderChildren(folders, d)
+	if !pruned {
+		t.Fatal("pruned = false, want true")
+	}
+	children := folders[0].Children.MustGet()
+	if len(children) != 1 || children[0].Name != "order_date" {
+		t.Errorf("children = %v, want only order_date", children)
+	}
+
+	if _, pruned := pruneFolderChildren(folders, d); pruned {
+		t.Error("pruned = true on folders without deleted children, want false")
+	}
+
+	// Nested folders are pruned too.
+	folders = []client.Folder{{
+		Name: "Sales",
+		Type: client.FolderTypeFolder,
+		Children: nullable.NewNullableWithValue([]client.Folder{{
+			Name: "Orders",
+			Type: client.FolderTypeFolder,
+			Children: nullable.NewNullableWithValue([]client.Folder{
+				{Name: "order_date", Type: client.FolderTypeColumn, Uuid: kept},
+				{Name: "revenue", Type: client.FolderTypeMetric, Uuid: deleted},
+			}),
+		}}),
+	}}
+	folders, pruned = pruneFolderChildren(folders, d)
+	if !pruned {
+		t.Fatal("pruned = false for a nested folder, want true")
+	}
+	nested := folders[0].Children.MustGet()[0].Children.MustGet()
+	if len(nested) != 1 || nested[0].Name != "order_date" {
+		t.Errorf("nested children = %v, want only order_date", nested)
+	}
+
+	model := datasetFolderBaseModel{Folders: []datasetFolderModel{{Children: []datasetFolderChildModel{
+		{Name: types.StringValue("order_date"), Type: types.StringValue("column")},
+		{Name: types.StringValue("revenue"), Type: types.StringValue("metric")},
+	}}}}
+	if missing := model.missingChildren(d); !slices.Equal(missing, []string{`metric "revenue"`}) {
+		t.Errorf("missingChildren = %v, want [metric \"revenue\"]", missing)
+	}
+}
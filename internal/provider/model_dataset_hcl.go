@@ -0,0 +1,252 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+type datasetHclBaseModel struct {
+	TableName    types.String `tfsdk:"table_name"`
+	Schema       types.String `tfsdk:"schema"`
+	DatabaseName types.String `tfsdk:"database_name"`
+	ResourceName types.String `tfsdk:"resource_name"`
+	DatasetId    types.Int64  `tfsdk:"dataset_id"`
+	Hcl          types.String `tfsdk:"hcl"`
+}
+
+func (model *datasetHclBaseModel) updateState(d *client.DatasetRestApiGet) error {
+	label := hclIdentifier(d.TableName)
+	if !model.ResourceName.IsNull() && model.ResourceName.ValueString() != "" {
+		label = model.ResourceName.ValueString()
+	}
+
+	blocks := []*hclBlock{}
+
+	dataset, err := datasetHclBlock(label, d)
+	if err != nil {
+		return err
+	}
+	blocks = append(blocks, dataset)
+
+	datasetName := hclRaw(fmt.Sprintf("superset_dataset.%s.table_name", label))
+	var dependsOn hclTuple
+
+	if len(d.Columns) > 0 {
+		columns, err := datasetColumnsHclBlock(label, datasetName, d)
+		if err != nil {
+			return err
+		}
+		blocks = append(blocks, columns)
+		dependsOn = append(dependsOn, hclRaw("superset_dataset_columns."+label))
+	}
+	if len(d.Metrics) > 0 {
+		metrics, err := datasetMetricsHclBlock(label, datasetName, d)
+		if err != nil {
+			return err
+		}
+		blocks = append(blocks, metrics)
+		dependsOn = append(dependsOn, hclRaw("superset_dataset_metrics."+label))
+	}
+
+	folders, err := datasetFolderHclBlock(label, datasetName, dependsOn, d)
+	if err != nil {
+		return err
+	}
+	if folders != nil {
+		blocks = append(blocks, folders)
+	}
+
+	rendered := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		rendered = append(rendered, b.render())
+	}
+
+	model.DatasetId = types.Int64Value(int64(d.Id))
+	model.Hcl = types.StringValue(strings.Join(rendered, "\n"))
+	return nil
+}
+
+// setHclString sets name to the value of s unless it is null.
+func setHclString(o *hclObject, name string, s types.String) {
+	if !s.IsNull() && !s.IsUnknown() {
+		o.set(name, hclString(s.ValueString()))
+	}
+}
+
+// setHclTrue sets name only when b is true, as all boolean attributes of the dataset default to false.
+func setHclTrue(o *hclObject, name string, b types.Bool) {
+	if b.ValueBool() {
+		o.set(name, hclBool(true))
+	}
+}
+
+func datasetHclBlock(label string, d *client.DatasetRestApiGet) (*hclBlock, error) {
+	var m datasetBaseModel
+	if err := m.updateState(d); err != nil {
+		return nil, err
+	}
+
+	block := &hclBlock{blockType: "resource", labels: []string{"superset_dataset", label}}
+	body := &block.body
+	body.set("database_name", hclString(m.DatabaseName.ValueString()))
+	body.set("table_name", hclString(m.TableName.ValueString()))
+	if c := nullableStringValue(d.Catalog); c.ValueString() != "" {
+		body.set("catalog", hclString(c.ValueString()))
+	}
+	if s := nullableStringValue(d.Schema); s.ValueString() != "" {
+		body.set("schema", hclString(s.ValueString()))
+	}
+	setHclString(body, "sql", m.Sql)
+	setHclString(body, "description", m.Description)
+	if !m.CacheTimeout.IsNull() {
+		body.set("cache_timeout", hclInt(m.CacheTimeout.ValueInt64()))
+	}
+	setHclTrue(body, "filter_select_enabled", m.FilterSelectEnabled)
+	setHclString(body, "fetch_values_predicate", m.FetchValuesPredicate)
+	setHclTrue(body, "always_filter_main_dttm", m.AlwaysFilterMainDttm)
+	setHclString(body, "main_dttm_col", m.MainDttmCol)
+	setHclString(body, "default_endpoint", m.DefaultEndpoint)
+	if m.Offset.ValueInt64() != 0 {
+		body.set("offset", hclInt(m.Offset.ValueInt64()))
+	}
+	setHclString(body, "template_params", m.TemplateParams)
+	setHclTrue(body, "normalize_columns", m.NormalizeColumns)
+	setHclTrue(body, "is_managed_externally", m.IsManagedExternally)
+	if len(d.Owners) > 0 {
+		owners := make([]int, 0, len(d.Owners))
+		for _, o := range d.Owners {
+			owners = append(owners, o.Id)
+		}
+		sort.Ints(owners)
+		ownerIds := make(hclTuple, 0, len(owners))
+		for _, id := range owners {
+			ownerIds = append(ownerIds, hclInt(int64(id)))
+		}
+		body.set("owner_ids", ownerIds)
+	}
+	setHclString(body, "certified_by", m.CertifiedBy)
+	setHclString(body, "certification_details", m.CertificationDetails)
+
+	return block, nil
+}
+
+func datasetColumnsHclBlock(label string, datasetName hclValue, d *client.DatasetRestApiGet) (*hclBlock, error) {
+	var m datasetColumnsBaseModel
+	if err := m.updateState(d); err != nil {
+		return nil, err
+	}
+
+	columns := &hclObject{quoteKeys: true}
+	for _, name := range sortedKeys(m.Columns) {
+		c := m.Columns[name]
+		column := &hclObject{}
+		column.set("column_name", hclString(c.ColumnName.ValueString()))
+		setHclString(column, "verbose_name", c.VerboseName)
+		setHclString(column, "description", c.Description)
+		setHclString(column, "expression", c.Expression)
+		setHclString(column, "type", c.Type)
+		setHclString(column, "advanced_data_type", c.AdvancedDataType)
+		column.set("filterable", hclBool(c.Filterable.ValueBool()))
+		column.set("groupby", hclBool(c.Groupby.ValueBool()))
+		column.set("is_active", hclBool(c.IsActive.ValueBool()))
+		column.set("is_dttm", hclBool(c.IsDttm.ValueBool()))
+		setHclString(column, "certified_by", c.CertifiedBy)
+		setHclString(column, "certification_details", c.CertificationDetails)
+		setHclString(column, "warning_markdown", c.WarningMarkdown)
+		setHclString(column, "extra", c.Extra)
+		columns.set(name, column)
+	}
+
+	block := &hclBlock{blockType: "resource", labels: []string{"superset_dataset_columns", label}}
+	block.body.set("dataset_name", datasetName)
+	block.body.set("columns", columns)
+	return block, nil
+}
+
+func datasetMetricsHclBlock(label string, datasetName hclValue, d *client.DatasetRestApiGet) (*hclBlock, error) {
+	var m datasetMetricsBaseModel
+	if err := m.updateState(d); err != nil {
+		return nil, err
+	}
+
+	metrics := &hclObject{quoteKeys: true}
+	for _, name := range sortedKeys(m.Metrics) {
+		mt := m.Metrics[name]
+		metric := &hclObject{}
+		metric.set("metric_name", hclString(mt.MetricName.ValueString()))
+		metric.set("expression", hclString(mt.Expression.ValueString()))
+		setHclString(metric, "verbose_name", mt.VerboseName)
+		setHclString(metric, "description", mt.Description)
+		setHclString(metric, "d3format", mt.D3format)
+		setHclString(metric, "warning_text", mt.WarningText)
+		if !mt.Currency.IsNull() {
+			currency := &hclObject{}
+			for _, k := range []string{"symbol", "symbol_position"} {
+				if v, ok := mt.Currency.Attributes()[k].(types.String); ok {
+					currency.set(k, hclString(v.ValueString()))
+				}
+			}
+			metric.set("currency", currency)
+		}
+		setHclString(metric, "certified_by", mt.CertifiedBy)
+		setHclString(metric, "certification_details", mt.CertificationDetails)
+		metrics.set(name, metric)
+	}
+
+	block := &hclBlock{blockType: "resource", labels: []string{"superset_dataset_metrics", label}}
+	block.body.set("dataset_name", datasetName)
+	block.body.set("metrics", metrics)
+	return block, nil
+}
+
+func datasetFolderHclBlock(label string, datasetName hclValue, dependsOn hclTuple, d *client.DatasetRestApiGet) (*hclBlock, error) {
+	var m datasetFolderBaseModel
+	if err := m.updateState(d); err != nil {
+		return nil, err
+	}
+	if len(m.Folders) == 0 {
+		return nil, nil
+	}
+
+	folders := make(hclTuple, 0, len(m.Folders))
+	for _, f := range m.Folders {
+		folder := &hclObject{}
+		folder.set("name", hclString(f.Name.ValueString()))
+		folder.set("type", hclString(f.Type.ValueString()))
+		setHclString(folder, "description", f.Description)
+		children := make(hclTuple, 0, len(f.Children))
+		for _, c := range f.Children {
+			child := &hclObject{}
+			child.set("name", hclString(c.Name.ValueString()))
+			child.set("type", hclString(c.Type.ValueString()))
+			setHclString(child, "description", c.Description)
+			children = append(children, child)
+		}
+		folder.set("children", children)
+		folders = append(folders, folder)
+	}
+
+	block := &hclBlock{blockType: "resource", labels: []string{"superset_dataset_folder", label}}
+	block.body.set("dataset_name", datasetName)
+	block.body.set("folders", folders)
+	if len(dependsOn) > 0 {
+		block.body.set("depends_on", dependsOn)
+	}
+	return block, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
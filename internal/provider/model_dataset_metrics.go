@@ -16,6 +16,41 @@ type datasetMetricsBaseModel struct {
 	DatasetId   types.Int64              `tfsdk:"dataset_id"`
 	DatasetName types.String             `tfsdk:"dataset_name"`
 	Metrics     map[string]datasetMetric `tfsdk:"metrics"`
+	// Authoritative removes the metrics of the dataset that are not configured. When false, they are
+	// kept and left out of the state.
+	Authoritative   types.Bool `tfsdk:"authoritative"`
+	PendingRemovals types.Set  `tfsdk:"pending_removals"`
+}
+
+// isAuthoritative reports whether the configured metrics replace all metrics of the dataset. Imported
+// resources manage all metrics.
+func (model *datasetMetricsBaseModel) isAuthoritative() bool {
+	return model.Authoritative.IsNull() || model.Authoritative.IsUnknown() || model.Authoritative.ValueBool()
+}
+
+// unmanagedMetrics returns the metrics of the dataset that are not configured, to keep them as they
+// are when the resource is not authoritative.
+func (model *datasetMetricsBaseModel) unmanagedMetrics(metrics []client.DatasetRestApiGetSqlMetric) []client.DatasetMetricsPut {
+	if model.isAuthoritative() {
+		return nil
+	}
+
+	managed := model.metricNames()
+	var unmanaged []client.DatasetMetricsPut
+	for _, m := range metrics {
+		if !managed[m.MetricName] {
+			unmanaged = append(unmanaged, client.DatasetMetricsPut{Id: m.Id, MetricName: m.MetricName, Expression: m.Expression})
+		}
+	}
+	return unmanaged
+}
+
+func (model *datasetMetricsBaseModel) metricNames() map[string]bool {
+	names := make(map[string]bool, len(model.Metrics))
+	for _, m := range model.Metrics {
+		names[m.MetricName.ValueString()] = true
+	}
+	return names
 }
 
 var currencyAttrTypes = map[string]attr.Type{
@@ -119,10 +154,18 @@ func (model *datasetMetric) parseCertification(d *client.DatasetRestApiGetSqlMet
 func (model *datasetMetricsBaseModel) updateState(d *client.DatasetRestApiGet) error {
 	model.DatasetId = types.Int64Value(int64(d.Id))
 	model.DatasetName = types.StringValue(d.TableName)
+	if model.Authoritative.IsNull() {
+		// An imported resource, which manages all metrics of the dataset.
+		model.Authoritative = types.BoolValue(true)
+	}
 
+	managed := model.metricNames()
 	metrics := make(map[string]datasetMetric)
 
 	for _, metric := range d.Metrics {
+		if !model.isAuthoritative() && !managed[metric.MetricName] {
+			continue
+		}
 		var m datasetMetric
 		if err := m.updateState(&metric); err != nil {
 			return err
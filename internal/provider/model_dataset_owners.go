@@ -0,0 +1,75 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+type datasetOwnersBaseModel struct {
+	DatasetId      types.Int64  `tfsdk:"dataset_id"`
+	DatasetName    types.String `tfsdk:"dataset_name"`
+	OwnerUsernames types.Set    `tfsdk:"owner_usernames"`
+	OwnerIds       types.Set    `tfsdk:"owner_ids"`
+}
+
+func (model *datasetOwnersBaseModel) usernames() []string {
+	var names []string
+	for _, v := range model.OwnerUsernames.Elements() {
+		if name, ok := v.(types.String); ok {
+			names = append(names, name.ValueString())
+		}
+	}
+	return names
+}
+
+func (model *datasetOwnersBaseModel) updateState(ctx context.Context, d *client.DatasetRestApiGet, usernames []string) {
+	model.DatasetId = types.Int64Value(int64(d.Id))
+	model.DatasetName = types.StringValue(d.TableName)
+	model.OwnerUsernames = stringSetValue(usernames)
+	model.OwnerIds, _ = types.SetValueFrom(ctx, types.Int64Type, datasetOwnerIds(d))
+}
+
+// ownersChanged reports whether the owners of d differ from the owner_ids of the state.
+func (model *datasetOwnersBaseModel) ownersChanged(d *client.DatasetRestApiGet) bool {
+	if model.OwnerIds.IsNull() || model.OwnerIds.IsUnknown() {
+		return true
+	}
+	known := int64SetToInts(model.OwnerIds)
+	slices.Sort(known)
+	current := make([]int, 0, len(d.Owners))
+	for _, o := range d.Owners {
+		current = append(current, o.Id)
+	}
+	slices.Sort(current)
+	return !slices.Equal(known, current)
+}
+
+func datasetOwnerIds(d *client.DatasetRestApiGet) []int64 {
+	ids := make([]int64, 0, len(d.Owners))
+	for _, o := range d.Owners {
+		ids = append(ids, int64(o.Id))
+	}
+	return ids
+}
+
+// ownerUsernames returns the usernames of the owners of d. Owners that are not among users are skipped.
+func ownerUsernames(users []client.SupersetUserApiGetList, d *client.DatasetRestApiGet) []string {
+	byId := make(map[int]string, len(users))
+	for _, u := range users {
+		byId[u.Id] = u.Username
+	}
+
+	names := make([]string, 0, len(d.Owners))
+	for _, o := range d.Owners {
+		if name, ok := byId[o.Id]; ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,35 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+type datasetRefreshBaseModel struct {
+	DatasetId      types.Int64 `tfsdk:"dataset_id"`
+	Triggers       types.Map   `tfsdk:"triggers"`
+	Columns        types.Set   `tfsdk:"columns"`
+	AddedColumns   types.Set   `tfsdk:"added_columns"`
+	RemovedColumns types.Set   `tfsdk:"removed_columns"`
+}
+
+// datasetColumnNames returns the names of the columns of d.
+func datasetColumnNames(d *client.DatasetRestApiGet) []string {
+	names := make([]string, 0, len(d.Columns))
+	for _, c := range d.Columns {
+		names = append(names, c.ColumnName)
+	}
+	return names
+}
+
+// setResults sets the columns of refreshed, the dataset after the refresh, and the columns the refresh
+// added to or removed from before.
+func (model *datasetRefreshBaseModel) setResults(before *client.DatasetRestApiGet, refreshed *client.DatasetRestApiGet) {
+	prior, current := datasetColumnNames(before), datasetColumnNames(refreshed)
+	model.Columns = stringSetValue(current)
+	model.AddedColumns = stringSetValue(nameDifference(current, prior))
+	model.RemovedColumns = stringSetValue(nameDifference(prior, current))
+}
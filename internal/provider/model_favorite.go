@@ -0,0 +1,64 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+// favoriteObjectTypes are the types of objects a superset_favorite marks as favorites, in the order they
+// are processed.
+var favoriteObjectTypes = []string{client.FavoriteObjectTypeDashboard, client.FavoriteObjectTypeChart}
+
+type favoriteBaseModel struct {
+	Id           types.String `tfsdk:"id"`
+	Username     types.String `tfsdk:"username"`
+	DashboardIds types.Set    `tfsdk:"dashboard_ids"`
+	ChartIds     types.Set    `tfsdk:"chart_ids"`
+}
+
+// ids returns the attribute holding the IDs of the favorite objects of objectType.
+func (model *favoriteBaseModel) ids(objectType string) *types.Set {
+	if objectType == client.FavoriteObjectTypeChart {
+		return &model.ChartIds
+	}
+	return &model.DashboardIds
+}
+
+// updateState sets the IDs of the objects to the ones of the prior IDs that are still favorites, so
+// that objects unmarked or deleted outside Terraform show up as drift.
+func (model *favoriteBaseModel) updateState(ctx context.Context, favorites map[string]map[int]bool) {
+	model.Id = model.Username
+
+	for _, objectType := range favoriteObjectTypes {
+		set := model.ids(objectType)
+		if set.IsNull() {
+			continue
+		}
+
+		kept := make([]int64, 0, len(set.Elements()))
+		for _, id := range int64SetToInts(*set) {
+			if favorites[objectType][id] {
+				kept = append(kept, int64(id))
+			}
+		}
+		slices.Sort(kept)
+		*set, _ = types.SetValueFrom(ctx, types.Int64Type, kept)
+	}
+}
+
+// idDifference returns the IDs of a that are not in b.
+func idDifference(a []int, b []int) []int {
+	var diff []int
+	for _, id := range a {
+		if !slices.Contains(b, id) {
+			diff = append(diff, id)
+		}
+	}
+	return diff
+}
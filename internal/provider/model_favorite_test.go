@@ -0,0 +1,35 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFavoriteUpdateState(t *testing.T) {
+	ctx := context.Background()
+	dashboards, _ := types.SetValueFrom(ctx, types.Int64Type, []int64{12, 15, 20})
+	model := favoriteBaseModel{
+		Username:     types.StringValue("alice"),
+		DashboardIds: dashboards,
+		ChartIds:     types.SetNull(types.Int64Type),
+	}
+
+	model.updateState(ctx, map[string]map[int]bool{"dashboard": {12: true, 15: false}})
+	got := int64SetToInts(model.DashboardIds)
+	slices.Sort(got)
+	if !slices.Equal(got, []int{12}) {
+		t.Errorf("expected only the dashboards still marked as favorite, got %v", got)
+	}
+	if !model.ChartIds.IsNull() {
+		t.Errorf("expected chart_ids to stay null, got %v", model.ChartIds)
+	}
+	if model.Id.ValueString() != "alice" {
+		t.Errorf("expected the ID alice, got %s", model.Id.ValueString())
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type featureFlagsBaseModel struct {
+	Required types.Set `tfsdk:"required"`
+	Flags    types.Map `tfsdk:"flags"`
+	Enabled  types.Set `tfsdk:"enabled"`
+}
+
+func (model *featureFlagsBaseModel) updateState(flags map[string]bool) {
+	flagValues := make(map[string]attr.Value, len(flags))
+	enabled := make([]attr.Value, 0, len(flags))
+
+	for name, v := range flags {
+		flagValues[name] = types.BoolValue(v)
+		if v {
+			enabled = append(enabled, types.StringValue(name))
+		}
+	}
+
+	model.Flags, _ = types.MapValue(types.BoolType, flagValues)
+	model.Enabled, _ = types.SetValue(types.StringType, enabled)
+}
+
+// missingRequired returns the required flags that are not enabled on the server, sorted by name.
+func (model *featureFlagsBaseModel) missingRequired(flags map[string]bool) []string {
+	var missing []string
+	for _, v := range model.Required.Elements() {
+		name, ok := v.(types.String)
+		if !ok || name.IsNull() || name.IsUnknown() {
+			continue
+		}
+		if !flags[name.ValueString()] {
+			missing = append(missing, name.ValueString())
+		}
+	}
+
+	sort.Strings(missing)
+	return missing
+}
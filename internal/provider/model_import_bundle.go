@@ -0,0 +1,92 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+// importBundleObjectTypes are the object types of the per-type import endpoints.
+var importBundleObjectTypes = []string{
+	client.ImportObjectTypeChart,
+	client.ImportObjectTypeDashboard,
+	client.ImportObjectTypeDatabase,
+	client.ImportObjectTypeDataset,
+	client.ImportObjectTypeSavedQuery,
+}
+
+type importBundleBaseModel struct {
+	Path          types.String `tfsdk:"path"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	ObjectType    types.String `tfsdk:"object_type"`
+	Overwrite     types.Bool   `tfsdk:"overwrite"`
+	Passwords     types.Map    `tfsdk:"passwords"`
+	BundleSha256  types.String `tfsdk:"bundle_sha256"`
+	Assets        types.Map    `tfsdk:"assets"`
+}
+
+// source names the bundle in messages: its path, or content_base64 for an inline bundle.
+func (model *importBundleBaseModel) source() string {
+	if !model.ContentBase64.IsNull() {
+		return "content_base64"
+	}
+	return model.Path.ValueString()
+}
+
+// passwords returns the database passwords keyed by the database file of the bundle. Passwords are not
+// part of the bundle, so they may still be unknown when planning.
+func (model *importBundleBaseModel) passwords(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	values := make(map[string]types.String)
+	var diags diag.Diagnostics
+	if !model.Passwords.IsNull() && !model.Passwords.IsUnknown() {
+		diags.Append(model.Passwords.ElementsAs(ctx, &values, false)...)
+	}
+
+	passwords := make(map[string]string, len(values))
+	for name, v := range values {
+		passwords[name] = v.ValueString()
+	}
+	return passwords, diags
+}
+
+// bundle reads the bundle and checks that every password belongs to a database of the bundle.
+func (model *importBundleBaseModel) bundle(ctx context.Context) (*assetBundle, diag.Diagnostics) {
+	passwords, diags := model.passwords(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var bundle *assetBundle
+	var err error
+	if !model.ContentBase64.IsNull() {
+		bundle, err = decodeAssetBundle(model.ContentBase64.ValueString(), assetBundleOverrides{})
+	} else {
+		bundle, err = readAssetBundle(model.Path.ValueString(), assetBundleOverrides{})
+	}
+	if err != nil {
+		diags.AddError("Bundle Error", fmt.Sprintf("Unable to read bundle '%s': %s", model.source(), err))
+		return nil, diags
+	}
+
+	for _, name := range sortedKeys(passwords) {
+		if _, ok := bundle.Assets[name]; !ok || !strings.HasPrefix(name, "databases/") {
+			diags.AddError("Bundle Error", fmt.Sprintf("The password of '%s' does not match any database file of bundle '%s', e.g. databases/examples.yaml", name, model.source()))
+		}
+	}
+	bundle.Passwords = passwords
+	return bundle, diags
+}
+
+func (model *importBundleBaseModel) updateState(ctx context.Context, bundle *assetBundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+	model.BundleSha256 = types.StringValue(bundle.sha256())
+	model.Assets, diags = types.MapValueFrom(ctx, types.StringType, bundle.Assets)
+	return diags
+}
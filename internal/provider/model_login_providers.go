@@ -0,0 +1,57 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+type loginProvidersBaseModel struct {
+	Required       types.Set    `tfsdk:"required"`
+	AuthType       types.String `tfsdk:"auth_type"`
+	LoginProviders types.Set    `tfsdk:"login_providers"`
+	OAuthProviders types.List   `tfsdk:"oauth_providers"`
+}
+
+func (model *loginProvidersBaseModel) updateState(data *client.BootstrapData) {
+	model.AuthType = types.StringNull()
+	if authType, ok := data.AuthType(); ok {
+		model.AuthType = types.StringValue(authType)
+	}
+
+	providers := []attr.Value{}
+	if provider, ok := data.LoginProvider(); ok {
+		providers = append(providers, types.StringValue(provider))
+	}
+	model.LoginProviders, _ = types.SetValue(types.StringType, providers)
+
+	oauthProviders := []attr.Value{}
+	for _, name := range data.OAuthProviders() {
+		oauthProviders = append(oauthProviders, types.StringValue(name))
+	}
+	model.OAuthProviders, _ = types.ListValue(types.StringType, oauthProviders)
+}
+
+// missingRequired returns the required login providers that the server does not accept, sorted by name.
+func (model *loginProvidersBaseModel) missingRequired(data *client.BootstrapData) []string {
+	accepted, _ := data.LoginProvider()
+
+	var missing []string
+	for _, v := range model.Required.Elements() {
+		name, ok := v.(types.String)
+		if !ok || name.IsNull() || name.IsUnknown() {
+			continue
+		}
+		if name.ValueString() != accepted {
+			missing = append(missing, name.ValueString())
+		}
+	}
+
+	sort.Strings(missing)
+	return missing
+}
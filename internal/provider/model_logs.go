@@ -0,0 +1,66 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+	"github.com/oapi-codegen/nullable"
+)
+
+type logsBaseModel struct {
+	Username   types.String   `tfsdk:"username"`
+	Action     types.String   `tfsdk:"action"`
+	Since      types.String   `tfsdk:"since"`
+	Until      types.String   `tfsdk:"until"`
+	MaxResults types.Int64    `tfsdk:"max_results"`
+	Logs       []logItemModel `tfsdk:"logs"`
+}
+
+type logItemModel struct {
+	Id          types.Int64  `tfsdk:"id"`
+	Action      types.String `tfsdk:"action"`
+	Username    types.String `tfsdk:"username"`
+	Dttm        types.String `tfsdk:"dttm"`
+	DashboardId types.Int64  `tfsdk:"dashboard_id"`
+	SliceId     types.Int64  `tfsdk:"slice_id"`
+	DurationMs  types.Int64  `tfsdk:"duration_ms"`
+	Referrer    types.String `tfsdk:"referrer"`
+	Json        types.String `tfsdk:"json"`
+}
+
+func (model *logsBaseModel) updateState(logs []client.SupersetLogEntry) {
+	items := make([]logItemModel, 0, len(logs))
+	for _, l := range logs {
+		items = append(items, logItemModel{
+			Id:          types.Int64Value(int64(l.Id)),
+			Action:      nullableStringValue(l.Action),
+			Username:    types.StringValue(l.User.Username),
+			Dttm:        nullableStringValue(l.Dttm),
+			DashboardId: nullableInt64Value(l.DashboardId),
+			SliceId:     nullableInt64Value(l.SliceId),
+			DurationMs:  nullableInt64Value(l.DurationMs),
+			Referrer:    nullableStringValue(l.Referrer),
+			Json:        nullableStringValue(l.Json),
+		})
+	}
+
+	model.Logs = items
+}
+
+func nullableStringValue(v nullable.Nullable[string]) types.String {
+	s, err := v.Get()
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+func nullableInt64Value(v nullable.Nullable[int]) types.Int64 {
+	i, err := v.Get()
+	if err != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(i))
+}
@@ -0,0 +1,87 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+type menuBaseModel struct {
+	Items []menuItemModel `tfsdk:"items"`
+	Names types.Set       `tfsdk:"names"`
+}
+
+type menuItemModel struct {
+	Name     types.String         `tfsdk:"name"`
+	Label    types.String         `tfsdk:"label"`
+	Icon     types.String         `tfsdk:"icon"`
+	Url      types.String         `tfsdk:"url"`
+	Children []menuItemChildModel `tfsdk:"children"`
+}
+
+type menuItemChildModel struct {
+	Name  types.String `tfsdk:"name"`
+	Label types.String `tfsdk:"label"`
+	Icon  types.String `tfsdk:"icon"`
+	Url   types.String `tfsdk:"url"`
+}
+
+func (model *menuBaseModel) updateState(menu []client.SupersetMenuItem) diag.Diagnostics {
+	items := make([]menuItemModel, 0, len(menu))
+	names := make([]string, 0, len(menu))
+
+	for _, m := range menu {
+		item := menuItemModel{
+			Name:     types.StringValue(m.Name),
+			Label:    types.StringValue(m.Label),
+			Icon:     types.StringValue(m.Icon),
+			Url:      types.StringValue(m.Url),
+			Children: make([]menuItemChildModel, 0, len(m.Childs)),
+		}
+		if !isMenuSeparator(m.Name) {
+			names = append(names, m.Name)
+		}
+
+		for _, c := range m.Childs {
+			item.Children = append(item.Children, menuItemChildModel{
+				Name:  types.StringValue(c.Name),
+				Label: types.StringValue(c.Label),
+				Icon:  types.StringValue(c.Icon),
+				Url:   types.StringValue(c.Url),
+			})
+			if !isMenuSeparator(c.Name) {
+				names = append(names, c.Name)
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	// The same view can be listed under several menus, e.g. a child and a top-level item.
+	sort.Strings(names)
+	seen := make(map[string]bool, len(names))
+	values := make([]attr.Value, 0, len(names))
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			values = append(values, types.StringValue(name))
+		}
+	}
+
+	var diags diag.Diagnostics
+	model.Items = items
+	model.Names, diags = types.SetValue(types.StringType, values)
+	return diags
+}
+
+// isMenuSeparator reports whether name is the name of a separator, which the server returns as an
+// unnamed entry or as "-".
+func isMenuSeparator(name string) bool {
+	return name == "" || name == "-"
+}
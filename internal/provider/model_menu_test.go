@@ -0,0 +1,41 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+func TestMenuNames(t *testing.T) {
+	menu := []client.SupersetMenuItem{
+		{Name: "Data", Childs: []client.SupersetMenuItem{
+			{Name: "Databases"},
+			{Name: "-"},
+			{Name: "Datasets"},
+			{Name: ""},
+		}},
+		{Name: "Datasets"},
+		{Name: "Charts"},
+	}
+
+	var model menuBaseModel
+	if diags := model.updateState(menu); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var names []string
+	if diags := model.Names.ElementsAs(context.Background(), &names, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if want := []string{"Charts", "Data", "Databases", "Datasets"}; !slices.Equal(names, want) {
+		t.Errorf("names = %v, want %v", names, want)
+	}
+	if len(model.Items) != 3 || len(model.Items[0].Children) != 4 {
+		t.Errorf("expected the items to keep the separators, got %v", model.Items)
+	}
+}
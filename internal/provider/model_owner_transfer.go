@@ -0,0 +1,73 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+// ownerTransferAssetTypes are the asset types whose ownership can be transferred, in transfer order.
+var ownerTransferAssetTypes = []string{
+	client.AssetKindDataset,
+	client.AssetKindChart,
+	client.AssetKindDashboard,
+}
+
+type ownerTransferBaseModel struct {
+	FromUsername      types.String `tfsdk:"from_username"`
+	ToUsername        types.String `tfsdk:"to_username"`
+	FromUserId        types.Int64  `tfsdk:"from_user_id"`
+	ToUserId          types.Int64  `tfsdk:"to_user_id"`
+	AssetTypes        types.Set    `tfsdk:"asset_types"`
+	KeepPreviousOwner types.Bool   `tfsdk:"keep_previous_owner"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+	DatasetIds        types.Set    `tfsdk:"dataset_ids"`
+	ChartIds          types.Set    `tfsdk:"chart_ids"`
+	DashboardIds      types.Set    `tfsdk:"dashboard_ids"`
+}
+
+// includesAssetType reports whether assets of the given kind are transferred.
+func (model *ownerTransferBaseModel) includesAssetType(kind string) bool {
+	for _, v := range model.AssetTypes.Elements() {
+		if s, ok := v.(types.String); ok && s.ValueString() == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func (model *ownerTransferBaseModel) setTransferred(ctx context.Context, transferred map[string][]int) {
+	toSet := func(ids []int) types.Set {
+		values := make([]int64, 0, len(ids))
+		for _, id := range ids {
+			values = append(values, int64(id))
+		}
+		s, _ := types.SetValueFrom(ctx, types.Int64Type, values)
+		return s
+	}
+
+	model.DatasetIds = toSet(transferred[client.AssetKindDataset])
+	model.ChartIds = toSet(transferred[client.AssetKindChart])
+	model.DashboardIds = toSet(transferred[client.AssetKindDashboard])
+}
+
+// transferredOwners returns owners with fromUserId replaced by toUserId. With keepPrevious set,
+// toUserId is added and fromUserId stays an owner.
+func transferredOwners(owners []int, fromUserId, toUserId int, keepPrevious bool) []int {
+	result := make([]int, 0, len(owners)+1)
+	for _, id := range owners {
+		if id == fromUserId && !keepPrevious {
+			continue
+		}
+		result = append(result, id)
+	}
+	if !slices.Contains(result, toUserId) {
+		result = append(result, toUserId)
+	}
+	return result
+}
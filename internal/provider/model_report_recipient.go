@@ -0,0 +1,80 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type reportRecipientBaseModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Emails        types.Set    `tfsdk:"emails"`
+	CcEmails      types.Set    `tfsdk:"cc_emails"`
+	BccEmails     types.Set    `tfsdk:"bcc_emails"`
+	SlackChannels types.Set    `tfsdk:"slack_channels"`
+	SlackType     types.String `tfsdk:"slack_type"`
+	Recipients    types.Set    `tfsdk:"recipients"`
+}
+
+// isKnown reports whether the recipients can be computed.
+func (model *reportRecipientBaseModel) isKnown() bool {
+	for _, s := range []types.Set{model.Emails, model.CcEmails, model.BccEmails, model.SlackChannels} {
+		if s.IsUnknown() {
+			return false
+		}
+		for _, v := range s.Elements() {
+			if v.IsUnknown() {
+				return false
+			}
+		}
+	}
+	return !model.SlackType.IsUnknown()
+}
+
+// updateRecipients computes the recipients in the form expected by the recipients attribute of alerts
+// and reports: one Email recipient for the email addresses and one Slack recipient for the channels.
+func (model *reportRecipientBaseModel) updateRecipients(ctx context.Context) diag.Diagnostics {
+	model.Id = model.Name
+
+	recipients := make([]reportScheduleRecipient, 0, 2)
+	if emails := joinStringSet(model.Emails); emails != "" {
+		recipients = append(recipients, reportScheduleRecipient{
+			Type:      types.StringValue("Email"),
+			Target:    types.StringValue(emails),
+			CcTarget:  emptyAsNull(types.StringValue(joinStringSet(model.CcEmails))),
+			BccTarget: emptyAsNull(types.StringValue(joinStringSet(model.BccEmails))),
+		})
+	}
+	if channels := joinStringSet(model.SlackChannels); channels != "" {
+		recipients = append(recipients, reportScheduleRecipient{
+			Type:      model.SlackType,
+			Target:    types.StringValue(channels),
+			CcTarget:  types.StringNull(),
+			BccTarget: types.StringNull(),
+		})
+	}
+
+	var diags diag.Diagnostics
+	model.Recipients, diags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: reportScheduleRecipientAttrTypes}, recipients)
+	return diags
+}
+
+// joinStringSet returns the sorted elements of s separated by commas, the list format of recipient
+// targets.
+func joinStringSet(s types.Set) string {
+	values := make([]string, 0, len(s.Elements()))
+	for _, v := range s.Elements() {
+		if str, ok := v.(types.String); ok {
+			values = append(values, str.ValueString())
+		}
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
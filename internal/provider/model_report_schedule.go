@@ -0,0 +1,347 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+	"github.com/oapi-codegen/nullable"
+)
+
+// reportScheduleBaseModel holds the attributes shared by alerts and reports.
+type reportScheduleBaseModel struct {
+	Id              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Crontab         types.String `tfsdk:"crontab"`
+	Timezone        types.String `tfsdk:"timezone"`
+	Active          types.Bool   `tfsdk:"active"`
+	Owners          types.Set    `tfsdk:"owners"`
+	Recipients      types.Set    `tfsdk:"recipients"`
+	ChartId         types.Int64  `tfsdk:"chart_id"`
+	DashboardId     types.Int64  `tfsdk:"dashboard_id"`
+	ReportFormat    types.String `tfsdk:"report_format"`
+	EmailSubject    types.String `tfsdk:"email_subject"`
+	ForceScreenshot types.Bool   `tfsdk:"force_screenshot"`
+	LogRetention    types.Int64  `tfsdk:"log_retention"`
+	NextRunTime     types.String `tfsdk:"next_run_time"`
+}
+
+type reportScheduleRecipient struct {
+	Type      types.String `tfsdk:"type"`
+	Target    types.String `tfsdk:"target"`
+	CcTarget  types.String `tfsdk:"cc_target"`
+	BccTarget types.String `tfsdk:"bcc_target"`
+}
+
+var reportScheduleRecipientAttrTypes = map[string]attr.Type{
+	"type":       types.StringType,
+	"target":     types.StringType,
+	"cc_target":  types.StringType,
+	"bcc_target": types.StringType,
+}
+
+// reportScheduleAttributes returns the schema attributes shared by alerts and reports. noun names the
+// resource in the descriptions.
+func reportScheduleAttributes(noun string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the " + noun + ".",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The name of the " + noun + ". It must be unique among the " + noun + "s.",
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 150),
+			},
+		},
+		"description": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "The description of the " + noun + ".",
+		},
+		"crontab": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The schedule of the " + noun + " as a CRON expression, e.g. `0 9 * * 1`.",
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 1000),
+				crontabValidator{},
+			},
+		},
+		"timezone": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("UTC"),
+			MarkdownDescription: "The IANA timezone the `crontab` is evaluated in, e.g. `Asia/Tokyo`. Defaults to `UTC`.",
+			Validators: []validator.String{
+				timezoneValidator{},
+			},
+		},
+		"active": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+			MarkdownDescription: "Whether the " + noun + " is scheduled. Defaults to `true`.",
+		},
+		"owners": schema.SetAttribute{
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.Int64Type,
+			MarkdownDescription: "The user IDs of the owners of the " + noun + ". Defaults to the provider account.",
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"recipients": schema.SetNestedAttribute{
+			Required:            true,
+			MarkdownDescription: "The recipients the " + noun + " is sent to. Use the `recipients` of a `superset_report_recipient` to share recipients between schedules.",
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The type of the recipient. One of `Email`, `Slack` or `SlackV2`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("Email", "Slack", "SlackV2"),
+						},
+					},
+					"target": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "A comma separated list of email addresses, or of Slack channels.",
+					},
+					"cc_target": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "A comma separated list of email addresses to send a copy to. Only used by `Email` recipients.",
+					},
+					"bcc_target": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "A comma separated list of email addresses to send a blind copy to. Only used by `Email` recipients.",
+					},
+				},
+			},
+		},
+		"chart_id": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "The ID of the chart sent by the " + noun + ". Exactly one of `chart_id` and `dashboard_id` must be set.",
+		},
+		"dashboard_id": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "The ID of the dashboard sent by the " + noun + ". Exactly one of `chart_id` and `dashboard_id` must be set.",
+		},
+		"report_format": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("PNG"),
+			MarkdownDescription: "The format the chart or dashboard is sent in. One of `PNG`, `PDF`, `CSV` or `TEXT`; `CSV` and `TEXT` are only supported for charts. Defaults to `PNG`.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("PNG", "PDF", "CSV", "TEXT"),
+			},
+		},
+		"email_subject": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "The subject of the emails sent. Defaults to a subject derived from the name of the " + noun + ".",
+		},
+		"force_screenshot": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+			MarkdownDescription: "Whether a new screenshot is taken instead of using the cached one. Defaults to `false`.",
+		},
+		"log_retention": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(90),
+			MarkdownDescription: "How long the execution logs of the " + noun + " are kept, in days. Defaults to `90`.",
+		},
+		"next_run_time": schema.StringAttribute{
+			Computed: true,
+			MarkdownDescription: "The first time the " + noun + " runs after it is created or its `crontab` or `timezone` is changed, " +
+				"in RFC 3339 format in the `timezone`. It is computed by the provider when planning and is not refreshed afterwards.",
+		},
+	}
+}
+
+// modifyReportSchedulePlan computes next_run_time when the schedule is created or its crontab or
+// timezone changes, and keeps the prior value otherwise.
+func modifyReportSchedulePlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var crontabExpr, timezone types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("crontab"), &crontabExpr)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timezone"), &timezone)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateCrontab, stateTimezone, stateNextRunTime types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("crontab"), &stateCrontab)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timezone"), &stateTimezone)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("next_run_time"), &stateNextRunTime)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if crontabExpr.Equal(stateCrontab) && timezone.Equal(stateTimezone) && !stateNextRunTime.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_run_time"), stateNextRunTime)...)
+			return
+		}
+	}
+
+	if crontabExpr.IsUnknown() || timezone.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_run_time"), nextRunTime(crontabExpr.ValueString(), timezone.ValueString()))...)
+}
+
+// nextRunTime returns the next time the crontab matches from now in the timezone, or null when it
+// never does.
+func nextRunTime(crontabExpr, timezone string) types.String {
+	c, err := parseCrontab(crontabExpr)
+	if err != nil {
+		return types.StringNull()
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return types.StringNull()
+	}
+	next, ok := c.next(time.Now().In(loc))
+	if !ok {
+		return types.StringNull()
+	}
+	return types.StringValue(next.Format(time.RFC3339))
+}
+
+// request returns the request body for the shared attributes. Cleared optional values are sent as
+// null, so the same body is used to create and to update a schedule.
+func (model *reportScheduleBaseModel) request(ctx context.Context, scheduleType string) (client.ReportScheduleRequest, diag.Diagnostics) {
+	req := client.ReportScheduleRequest{
+		Type:            scheduleType,
+		Name:            model.Name.ValueString(),
+		Description:     nullable.NewNullNullable[string](),
+		Crontab:         model.Crontab.ValueString(),
+		Timezone:        model.Timezone.ValueString(),
+		Active:          model.Active.ValueBool(),
+		Chart:           nullable.NewNullNullable[int](),
+		Dashboard:       nullable.NewNullNullable[int](),
+		ReportFormat:    model.ReportFormat.ValueString(),
+		EmailSubject:    nullable.NewNullNullable[string](),
+		ForceScreenshot: model.ForceScreenshot.ValueBool(),
+		LogRetention:    int(model.LogRetention.ValueInt64()),
+	}
+	if !model.Description.IsNull() {
+		req.Description = nullable.NewNullableWithValue(model.Description.ValueString())
+	}
+	if !model.ChartId.IsNull() {
+		req.Chart = nullable.NewNullableWithValue(int(model.ChartId.ValueInt64()))
+	}
+	if !model.DashboardId.IsNull() {
+		req.Dashboard = nullable.NewNullableWithValue(int(model.DashboardId.ValueInt64()))
+	}
+	if !model.EmailSubject.IsNull() {
+		req.EmailSubject = nullable.NewNullableWithValue(model.EmailSubject.ValueString())
+	}
+	if !model.Owners.IsNull() && !model.Owners.IsUnknown() {
+		req.Owners = int64SetToInts(model.Owners)
+	}
+
+	var recipients []reportScheduleRecipient
+	diags := model.Recipients.ElementsAs(ctx, &recipients, false)
+	req.Recipients = make([]client.ReportRecipient, 0, len(recipients))
+	for _, r := range recipients {
+		req.Recipients = append(req.Recipients, client.ReportRecipient{
+			Type: client.ReportRecipientType(r.Type.ValueString()),
+			RecipientConfigJson: client.ReportRecipientConfigJSON{
+				Target:    r.Target.ValueString(),
+				CcTarget:  r.CcTarget.ValueString(),
+				BccTarget: r.BccTarget.ValueString(),
+			},
+		})
+	}
+	return req, diags
+}
+
+func (model *reportScheduleBaseModel) updateState(ctx context.Context, r *client.ReportSchedule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.Id = types.Int64Value(int64(r.Id))
+	model.Name = types.StringValue(r.Name)
+	model.Description = emptyAsNull(nullableStringValue(r.Description))
+	model.Crontab = types.StringValue(r.Crontab)
+	model.Timezone = types.StringValue(r.Timezone)
+	model.Active = types.BoolValue(nullableBoolValue(r.Active))
+	model.ReportFormat = nullableStringValue(r.ReportFormat)
+	model.EmailSubject = emptyAsNull(nullableStringValue(r.EmailSubject))
+	model.ForceScreenshot = types.BoolValue(nullableBoolValue(r.ForceScreenshot))
+	model.LogRetention = nullableInt64Value(r.LogRetention)
+	if model.NextRunTime.IsUnknown() || model.NextRunTime.IsNull() {
+		// The crontab or timezone was not known when planning, or the schedule was imported.
+		model.NextRunTime = nextRunTime(r.Crontab, r.Timezone)
+	}
+
+	model.ChartId = types.Int64Null()
+	if r.Chart != nil {
+		model.ChartId = types.Int64Value(int64(r.Chart.Id))
+	}
+	model.DashboardId = types.Int64Null()
+	if r.Dashboard != nil {
+		model.DashboardId = types.Int64Value(int64(r.Dashboard.Id))
+	}
+
+	owners := make([]int64, 0, len(r.Owners))
+	for _, o := range r.Owners {
+		owners = append(owners, int64(o.Id))
+	}
+	model.Owners, _ = types.SetValueFrom(ctx, types.Int64Type, owners)
+
+	recipients := make([]reportScheduleRecipient, 0, len(r.Recipients))
+	for _, rr := range r.Recipients {
+		config, err := rr.Config()
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read recipient %d of report schedule %d: %s", rr.Id, r.Id, err))
+			return diags
+		}
+		recipients = append(recipients, reportScheduleRecipient{
+			Type:      types.StringValue(rr.Type),
+			Target:    types.StringValue(config.Target),
+			CcTarget:  emptyAsNull(types.StringValue(config.CcTarget)),
+			BccTarget: emptyAsNull(types.StringValue(config.BccTarget)),
+		})
+	}
+	model.Recipients, diags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: reportScheduleRecipientAttrTypes}, recipients)
+	return diags
+}
+
+func nullableBoolValue(v nullable.Nullable[bool]) bool {
+	b, err := v.Get()
+	return err == nil && b
+}
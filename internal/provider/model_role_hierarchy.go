@@ -0,0 +1,56 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+type roleHierarchyBaseModel struct {
+	RoleId       types.Int64  `tfsdk:"role_id"`
+	RoleName     types.String `tfsdk:"role_name"`
+	IncludeRoles types.Set    `tfsdk:"include_roles"`
+	Permissions  types.Set    `tfsdk:"permissions"`
+}
+
+func (model *roleHierarchyBaseModel) updateState(roleId int, roleName string, permissions []client.SupersetRolePermissionApiGetList) {
+	model.RoleId = types.Int64Value(int64(roleId))
+	model.RoleName = types.StringValue(roleName)
+	model.Permissions = rolePermissionSetValue(permissions)
+}
+
+// unionRolePermissions returns the permissions of any of the roles, each once, sorted by name.
+func unionRolePermissions(roles ...[]client.SupersetRolePermissionApiGetList) []client.SupersetRolePermissionApiGetList {
+	seen := make(map[int]bool)
+	var union []client.SupersetRolePermissionApiGetList
+	for _, permissions := range roles {
+		for _, p := range permissions {
+			if !seen[p.Id] {
+				seen[p.Id] = true
+				union = append(union, p)
+			}
+		}
+	}
+	sort.Slice(union, func(i, j int) bool {
+		if union[i].ViewMenuName != union[j].ViewMenuName {
+			return union[i].ViewMenuName < union[j].ViewMenuName
+		}
+		return union[i].PermissionName < union[j].PermissionName
+	})
+	return union
+}
+
+func (model *roleHierarchyBaseModel) includeRoles() []string {
+	var names []string
+	for _, v := range model.IncludeRoles.Elements() {
+		if name, ok := v.(types.String); ok {
+			names = append(names, name.ValueString())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
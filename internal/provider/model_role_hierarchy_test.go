@@ -0,0 +1,36 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+func TestUnionRolePermissions(t *testing.T) {
+	gamma := []client.SupersetRolePermissionApiGetList{
+		{Id: 1, PermissionName: "can_read", ViewMenuName: "Dashboard"},
+		{Id: 2, PermissionName: "can_read", ViewMenuName: "Chart"},
+	}
+	sqlLab := []client.SupersetRolePermissionApiGetList{
+		{Id: 3, PermissionName: "can_sql_json", ViewMenuName: "Superset"},
+		{Id: 2, PermissionName: "can_read", ViewMenuName: "Chart"},
+	}
+
+	union := unionRolePermissions(gamma, sqlLab)
+	want := []int{2, 1, 3}
+	if len(union) != len(want) {
+		t.Fatalf("union = %v, want IDs %v", union, want)
+	}
+	for i, p := range union {
+		if p.Id != want[i] {
+			t.Errorf("union[%d] = %d, want %d", i, p.Id, want[i])
+		}
+	}
+
+	if !rolePermissionSetValue(unionRolePermissions(sqlLab, gamma)).Equal(rolePermissionSetValue(union)) {
+		t.Error("the union depends on the order of the roles")
+	}
+}
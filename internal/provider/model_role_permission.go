@@ -4,21 +4,188 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/htamakos/terraform-provider-superset/internal/client"
 )
 
+// datasetIdSuffixPattern matches the view menu names of dataset permissions, which end with the ID of the
+// dataset, e.g. `[examples].[public].[orders](id:3)`.
+var datasetIdSuffixPattern = regexp.MustCompile(`^(.*)\(id:\d+\)$`)
+
+// stripDatasetId returns viewMenuName without its dataset ID suffix, and whether it had one.
+func stripDatasetId(viewMenuName string) (string, bool) {
+	m := datasetIdSuffixPattern.FindStringSubmatch(viewMenuName)
+	if m == nil {
+		return viewMenuName, false
+	}
+	return m[1], true
+}
+
 type rolePermissionBaseModel struct {
 	RoleId      types.Int64  `tfsdk:"role_id"`
 	RoleName    types.String `tfsdk:"role_name"`
 	Permissions types.Set    `tfsdk:"permissions"`
+	// ExceptPermissions holds `*` and `?` wildcard patterns of permissions that are never granted, even
+	// when listed in Permissions.
+	ExceptPermissions types.Set `tfsdk:"except_permissions"`
+	// ResolveDatasetIds allows the view menu names of dataset permissions in Permissions to omit the ID
+	// suffix, which is then looked up on the server.
+	ResolveDatasetIds types.Bool `tfsdk:"resolve_dataset_ids"`
+	PendingRemovals   types.Set  `tfsdk:"pending_removals"`
 }
 
 func (model *rolePermissionBaseModel) updateState(roleId int64, roleName string, permissions []client.SupersetRolePermissionApiGetList) {
 	model.RoleId = types.Int64Value(roleId)
 	model.RoleName = types.StringValue(roleName)
-	model.Permissions = model.flattenPermissionsToList(permissions)
+	if model.ResolveDatasetIds.IsNull() {
+		// An imported resource, whose permissions are read as the server names them.
+		model.ResolveDatasetIds = types.BoolValue(false)
+	}
+	model.Permissions = model.flattenPermissionsToList(model.withExceptedPermissions(model.withConfiguredDatasetIds(permissions)))
+}
+
+// withConfiguredDatasetIds returns the permissions of the role with the view menu names of dataset
+// permissions written as in the prior Permissions, that is without the ID suffix when it was omitted.
+func (model *rolePermissionBaseModel) withConfiguredDatasetIds(permissions []client.SupersetRolePermissionApiGetList) []client.SupersetRolePermissionApiGetList {
+	if !model.ResolveDatasetIds.ValueBool() || model.Permissions.IsNull() || model.Permissions.IsUnknown() {
+		return permissions
+	}
+
+	configured := make(map[string]bool)
+	for _, p := range model.Permissions.Elements() {
+		if permissionName, viewMenuName, ok := permissionObjectNames(p); ok {
+			configured[permissionName+"_"+viewMenuName] = true
+		}
+	}
+
+	result := make([]client.SupersetRolePermissionApiGetList, 0, len(permissions))
+	for _, p := range permissions {
+		stripped, ok := stripDatasetId(p.ViewMenuName)
+		if ok && !configured[p.PermissionName+"_"+p.ViewMenuName] && configured[p.PermissionName+"_"+stripped] {
+			p.ViewMenuName = stripped
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// validateDatasetIds warns about view menu names of Permissions with a dataset ID suffix, which only
+// exists on one server, when the suffix could be resolved instead.
+func (model *rolePermissionBaseModel) validateDatasetIds() diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !model.ResolveDatasetIds.ValueBool() || model.Permissions.IsNull() || model.Permissions.IsUnknown() {
+		return diags
+	}
+
+	for _, p := range model.Permissions.Elements() {
+		permissionName, viewMenuName, ok := permissionObjectNames(p)
+		if !ok {
+			continue
+		}
+		if stripped, ok := stripDatasetId(viewMenuName); ok {
+			diags.AddAttributeWarning(
+				path.Root("permissions"),
+				"Environment-Specific Dataset ID",
+				fmt.Sprintf("The permission %s on %s contains the ID of the dataset, which differs between servers. "+
+					"With resolve_dataset_ids = true, %q is resolved to the dataset ID of the server.", permissionName, viewMenuName, stripped),
+			)
+		}
+	}
+	return diags
+}
+
+// withExceptedPermissions returns the permissions of the role as they appear in the configuration: the
+// permissions of the prior Permissions that are excepted, and so missing from the role on purpose, are
+// added back. Excepted permissions the role has anyway are dropped, so that they show up as drift.
+func (model *rolePermissionBaseModel) withExceptedPermissions(permissions []client.SupersetRolePermissionApiGetList) []client.SupersetRolePermissionApiGetList {
+	if model.ExceptPermissions.IsNull() || model.ExceptPermissions.IsUnknown() || model.Permissions.IsNull() || model.Permissions.IsUnknown() {
+		return permissions
+	}
+
+	granted := make(map[string]bool, len(permissions))
+	result := make([]client.SupersetRolePermissionApiGetList, 0, len(permissions))
+	for _, p := range permissions {
+		granted[p.PermissionName+"_"+p.ViewMenuName] = true
+		if !model.isExcepted(p.PermissionName, p.ViewMenuName) {
+			result = append(result, p)
+		}
+	}
+
+	for _, p := range model.Permissions.Elements() {
+		permissionName, viewMenuName, ok := permissionObjectNames(p)
+		if ok && model.isExcepted(permissionName, viewMenuName) && !granted[permissionName+"_"+viewMenuName] {
+			result = append(result, client.SupersetRolePermissionApiGetList{PermissionName: permissionName, ViewMenuName: viewMenuName})
+		}
+	}
+	return result
+}
+
+// isExcepted reports whether a permission matches one of the ExceptPermissions patterns.
+func (model *rolePermissionBaseModel) isExcepted(permissionName string, viewMenuName string) bool {
+	if model.ExceptPermissions.IsNull() || model.ExceptPermissions.IsUnknown() {
+		return false
+	}
+
+	for _, p := range model.ExceptPermissions.Elements() {
+		permissionPattern, viewMenuPattern, ok := permissionObjectNames(p)
+		if ok && wildcardPattern(permissionPattern).MatchString(permissionName) && wildcardPattern(viewMenuPattern).MatchString(viewMenuName) {
+			return true
+		}
+	}
+	return false
+}
+
+// pendingRemovals returns the permissions of granted, the permissions of the role, that are revoked when
+// Permissions is applied, and false when Permissions is not known yet.
+func (model *rolePermissionBaseModel) pendingRemovals(granted []client.SupersetRolePermissionApiGetList) ([]string, bool) {
+	if model.Permissions.IsUnknown() || model.ExceptPermissions.IsUnknown() || model.ResolveDatasetIds.IsUnknown() {
+		return nil, false
+	}
+
+	planned := make(map[string]bool)
+	for _, p := range model.Permissions.Elements() {
+		permissionName, viewMenuName, ok := permissionObjectNames(p)
+		if !ok {
+			return nil, false
+		}
+		if !model.isExcepted(permissionName, viewMenuName) {
+			planned[permissionName+"_"+viewMenuName] = true
+		}
+	}
+
+	var names []string
+	for _, p := range granted {
+		if planned[p.PermissionName+"_"+p.ViewMenuName] {
+			continue
+		}
+		if stripped, ok := stripDatasetId(p.ViewMenuName); ok && model.ResolveDatasetIds.ValueBool() && planned[p.PermissionName+"_"+stripped] {
+			continue
+		}
+		names = append(names, requiredPermission{p.PermissionName, p.ViewMenuName}.String())
+	}
+	return names, true
+}
+
+// permissionObjectNames returns the permission and view menu names of a permission object value.
+func permissionObjectNames(v attr.Value) (string, string, bool) {
+	obj, ok := v.(types.Object)
+	if !ok || obj.IsNull() || obj.IsUnknown() {
+		return "", "", false
+	}
+
+	permissionName, pnOk := obj.Attributes()["permission_name"].(types.String)
+	viewMenuName, vmOk := obj.Attributes()["view_menu_name"].(types.String)
+	if !pnOk || !vmOk || permissionName.IsNull() || permissionName.IsUnknown() || viewMenuName.IsNull() || viewMenuName.IsUnknown() {
+		return "", "", false
+	}
+	return permissionName.ValueString(), viewMenuName.ValueString(), true
 }
 
 func (model *rolePermissionBaseModel) resolvePermissions(sourcePermissions []client.SupersetPermissionApiGetList) ([]client.SupersetRolePermissionApiGetList, []string) {
@@ -28,8 +195,13 @@ func (model *rolePermissionBaseModel) resolvePermissions(sourcePermissions []cli
 	}
 
 	sourcePermissionNameIdMap := make(map[string]int)
+	// Permissions on datasets by name without the ID suffix, for resolve_dataset_ids.
+	datasetPermissions := make(map[string][]client.SupersetPermissionApiGetList)
 	for _, p := range sourcePermissions {
 		sourcePermissionNameIdMap[p.Permission.Name+"_"+p.ViewMenu.Name] = p.Id
+		if stripped, ok := stripDatasetId(p.ViewMenu.Name); ok {
+			datasetPermissions[p.Permission.Name+"_"+stripped] = append(datasetPermissions[p.Permission.Name+"_"+stripped], p)
+		}
 	}
 
 	notFoundPermissions := make([]string, 0)
@@ -60,8 +232,25 @@ func (model *rolePermissionBaseModel) resolvePermissions(sourcePermissions []cli
 		}
 
 		viewMenuNameAttrValue := _viewMenuNameAttrValue.ValueString()
+		if model.isExcepted(permissionNameAttrValue, viewMenuNameAttrValue) {
+			continue
+		}
 		fullPermissionName := permissionNameAttrValue + "_" + viewMenuNameAttrValue
 		sourcePermissionId, exists := sourcePermissionNameIdMap[fullPermissionName]
+		if !exists && model.ResolveDatasetIds.ValueBool() {
+			switch candidates := datasetPermissions[fullPermissionName]; len(candidates) {
+			case 0:
+			case 1:
+				sourcePermissionId, exists = candidates[0].Id, true
+			default:
+				names := make([]string, 0, len(candidates))
+				for _, c := range candidates {
+					names = append(names, c.ViewMenu.Name)
+				}
+				notFoundPermissions = append(notFoundPermissions, fmt.Sprintf("%s (ambiguous: %s)", fullPermissionName, strings.Join(names, ", ")))
+				continue
+			}
+		}
 		if !exists {
 			notFoundPermissions = append(notFoundPermissions, fullPermissionName)
 			continue
@@ -77,6 +266,11 @@ func (model *rolePermissionBaseModel) resolvePermissions(sourcePermissions []cli
 }
 
 func (model *rolePermissionBaseModel) flattenPermissionsToList(permissions []client.SupersetRolePermissionApiGetList) types.Set {
+	return rolePermissionSetValue(permissions)
+}
+
+// rolePermissionSetValue returns permissions as a set of permission_name and view_menu_name objects.
+func rolePermissionSetValue(permissions []client.SupersetRolePermissionApiGetList) types.Set {
 	permissionObjType := types.ObjectType{
 		AttrTypes: map[string]attr.Type{
 			"permission_name": types.StringType,
@@ -99,3 +293,48 @@ func (model *rolePermissionBaseModel) flattenPermissionsToList(permissions []cli
 	lv, _ := types.SetValue(permissionObjType, elems)
 	return lv
 }
+
+// rolePermissionChanges returns the permissions of planned that current, the permissions of the role,
+// misses, and the permissions of current that planned does not include.
+func rolePermissionChanges(current []client.SupersetRolePermissionApiGetList, planned []client.SupersetRolePermissionApiGetList) ([]client.SupersetRolePermissionApiGetList, []client.SupersetRolePermissionApiGetList) {
+	in := func(permissions []client.SupersetRolePermissionApiGetList) map[int]bool {
+		ids := make(map[int]bool, len(permissions))
+		for _, p := range permissions {
+			ids[p.Id] = true
+		}
+		return ids
+	}
+	currentIds, plannedIds := in(current), in(planned)
+
+	var grant, revoke []client.SupersetRolePermissionApiGetList
+	for _, p := range planned {
+		if !currentIds[p.Id] {
+			grant = append(grant, p)
+			currentIds[p.Id] = true
+		}
+	}
+	for _, p := range current {
+		if !plannedIds[p.Id] {
+			revoke = append(revoke, p)
+		}
+	}
+	return grant, revoke
+}
+
+// rolePermissionIdValues returns the IDs of permissions.
+func rolePermissionIdValues(permissions []client.SupersetRolePermissionApiGetList) []int {
+	ids := make([]int, 0, len(permissions))
+	for _, p := range permissions {
+		ids = append(ids, p.Id)
+	}
+	return ids
+}
+
+// rolePermissionNames returns the names of permissions, for logs.
+func rolePermissionNames(permissions []client.SupersetRolePermissionApiGetList) []string {
+	names := make([]string, 0, len(permissions))
+	for _, p := range permissions {
+		names = append(names, requiredPermission{p.PermissionName, p.ViewMenuName}.String())
+	}
+	return names
+}
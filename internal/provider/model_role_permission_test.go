@@ -0,0 +1,186 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+func permissionList(names ...string) []client.SupersetRolePermissionApiGetList {
+	var permissions []client.SupersetRolePermissionApiGetList
+	for i := 0; i < len(names); i += 2 {
+		permissions = append(permissions, client.SupersetRolePermissionApiGetList{PermissionName: names[i], ViewMenuName: names[i+1]})
+	}
+	return permissions
+}
+
+func permissionKeys(permissions []client.SupersetRolePermissionApiGetList) []string {
+	keys := make([]string, 0, len(permissions))
+	for _, p := range permissions {
+		keys = append(keys, p.PermissionName+" on "+p.ViewMenuName)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestRolePermissionExcept(t *testing.T) {
+	model := rolePermissionBaseModel{}
+	model.Permissions = model.flattenPermissionsToList(permissionList(
+		"can_read", "Chart",
+		"can_sql_json", "SQLLab",
+		"can_write", "Chart",
+	))
+	model.ExceptPermissions = model.flattenPermissionsToList(permissionList("can_sql_*", "*"))
+
+	sourcePermissions := []client.SupersetPermissionApiGetList{
+		{Id: 1, Permission: client.PermissionViewMenuApiGetListPermission{Name: "can_read"}, ViewMenu: client.PermissionViewMenuApiGetListViewMenu{Name: "Chart"}},
+		{Id: 2, Permission: client.PermissionViewMenuApiGetListPermission{Name: "can_sql_json"}, ViewMenu: client.PermissionViewMenuApiGetListViewMenu{Name: "SQLLab"}},
+		{Id: 3, Permission: client.PermissionViewMenuApiGetListPermission{Name: "can_write"}, ViewMenu: client.PermissionViewMenuApiGetListViewMenu{Name: "Chart"}},
+	}
+	permissions, notFound := model.resolvePermissions(sourcePermissions)
+	if len(notFound) > 0 {
+		t.Fatalf("unexpected not found permissions: %v", notFound)
+	}
+	if got := permissionKeys(permissions); len(got) != 2 || got[0] != "can_read on Chart" || got[1] != "can_write on Chart" {
+		t.Errorf("expected the excepted permission not to be granted, got %v", got)
+	}
+
+	// A role with exactly the granted permissions matches the configuration.
+	if got := permissionKeys(model.withExceptedPermissions(permissions)); len(got) != 3 {
+		t.Errorf("expected the configured permissions, got %v", got)
+	}
+
+	// An excepted permission granted outside Terraform is drift.
+	drifted := append(permissions, client.SupersetRolePermissionApiGetList{PermissionName: "can_sql_json", ViewMenuName: "SQLLab"})
+	if got := permissionKeys(model.withExceptedPermissions(drifted)); len(got) != 2 {
+		t.Errorf("expected the excepted permission granted outside Terraform to be dropped, got %v", got)
+	}
+
+	model.ExceptPermissions = types.SetNull(model.ExceptPermissions.ElementType(nil))
+	if got := permissionKeys(model.withExceptedPermissions(drifted)); len(got) != 3 {
+		t.Errorf("expected the permissions as is without except_permissions, got %v", got)
+	}
+}
+
+func TestRolePermissionResolveDatasetIds(t *testing.T) {
+	model := rolePermissionBaseModel{ResolveDatasetIds: types.BoolValue(true)}
+	model.Permissions = model.flattenPermissionsToList(permissionList(
+		"datasource_access", "[examples].[public].[orders]",
+		"datasource_access", "[examples].[public].[users](id:7)",
+	))
+
+	sourcePermissions := []client.SupersetPermissionApiGetList{
+		{Id: 1, Permission: client.PermissionViewMenuApiGetListPermission{Name: "datasource_access"}, ViewMenu: client.PermissionViewMenuApiGetListViewMenu{Name: "[examples].[public].[orders](id:3)"}},
+		{Id: 2, Permission: client.PermissionViewMenuApiGetListPermission{Name: "datasource_access"}, ViewMenu: client.PermissionViewMenuApiGetListViewMenu{Name: "[examples].[public].[users](id:7)"}},
+	}
+	permissions, notFound := model.resolvePermissions(sourcePermissions)
+	if len(notFound) > 0 {
+		t.Fatalf("unexpected not found permissions: %v", notFound)
+	}
+	if len(permissions) != 2 || permissions[0].Id != 1 || permissions[1].Id != 2 {
+		t.Errorf("expected the dataset IDs to be resolved, got %v", permissions)
+	}
+
+	granted := permissionList(
+		"datasource_access", "[examples].[public].[orders](id:3)",
+		"datasource_access", "[examples].[public].[users](id:7)",
+	)
+	got := permissionKeys(model.withConfiguredDatasetIds(granted))
+	if len(got) != 2 || got[0] != "datasource_access on [examples].[public].[orders]" || got[1] != "datasource_access on [examples].[public].[users](id:7)" {
+		t.Errorf("expected the view menu names as configured, got %v", got)
+	}
+
+	sourcePermissions = append(sourcePermissions, client.SupersetPermissionApiGetList{
+		Id: 3, Permission: client.PermissionViewMenuApiGetListPermission{Name: "datasource_access"}, ViewMenu: client.PermissionViewMenuApiGetListViewMenu{Name: "[examples].[public].[orders](id:9)"},
+	})
+	if _, notFound := model.resolvePermissions(sourcePermissions); len(notFound) != 1 {
+		t.Errorf("expected an ambiguous dataset name to fail, got %v", notFound)
+	}
+}
+
+func TestRolePermissionPendingRemovals(t *testing.T) {
+	model := rolePermissionBaseModel{ResolveDatasetIds: types.BoolValue(true)}
+	model.Permissions = model.flattenPermissionsToList(permissionList(
+		"can_read", "Chart",
+		"can_sql_json", "SQLLab",
+		"datasource_access", "[examples].[public].[orders]",
+	))
+	model.ExceptPermissions = model.flattenPermissionsToList(permissionList("can_sql_*", "*"))
+
+	granted := permissionList(
+		"can_read", "Chart",
+		"can_write", "Chart",
+		"can_sql_json", "SQLLab",
+		"datasource_access", "[examples].[public].[orders](id:3)",
+	)
+	names, known := model.pendingRemovals(granted)
+	if !known {
+		t.Fatal("expected the pending removals to be known")
+	}
+	var got []string
+	for _, v := range pendingRemovalsValue(names).Elements() {
+		if s, ok := v.(types.String); ok {
+			got = append(got, s.ValueString())
+		}
+	}
+	if len(got) != 2 || got[0] != "can_sql_json on SQLLab" || got[1] != "can_write on Chart" {
+		t.Errorf("expected the unconfigured and the excepted permissions to be removed, got %v", got)
+	}
+
+	model.Permissions = types.SetUnknown(model.Permissions.ElementType(nil))
+	if _, known := model.pendingRemovals(granted); known {
+		t.Error("expected unknown permissions to make the pending removals unknown")
+	}
+}
+
+func TestRolePermissionChanges(t *testing.T) {
+	current := permissionList("can_read", "Chart", "can_write", "Chart", "can_read", "Dashboard")
+	planned := permissionList("can_read", "Chart", "can_read", "Dashboard", "can_read", "Dataset")
+	for i := range current {
+		current[i].Id = i + 1
+	}
+	for i, id := range []int{1, 3, 4} {
+		planned[i].Id = id
+	}
+
+	grant, revoke := rolePermissionChanges(current, planned)
+	if got := permissionKeys(grant); len(got) != 1 || got[0] != "can_read on Dataset" {
+		t.Errorf("expected only the new permission to be granted, got %v", got)
+	}
+	if got := permissionKeys(revoke); len(got) != 1 || got[0] != "can_write on Chart" {
+		t.Errorf("expected only the unplanned permission to be revoked, got %v", got)
+	}
+
+	grant, revoke = rolePermissionChanges(planned, planned)
+	if len(grant) != 0 || len(revoke) != 0 {
+		t.Errorf("expected no changes, got %v and %v", grant, revoke)
+	}
+}
+
+func TestRolePermissionImportedState(t *testing.T) {
+	// An imported resource only has the role name in its state.
+	model := rolePermissionBaseModel{
+		RoleName:          types.StringValue("Analyst"),
+		Permissions:       types.SetNull(types.ObjectType{}),
+		ExceptPermissions: types.SetNull(types.ObjectType{}),
+	}
+	model.updateState(7, "Analyst", permissionList(
+		"can_read", "Chart",
+		"datasource_access", "[examples].[public].[orders](id:3)",
+	))
+
+	if model.RoleId.ValueInt64() != 7 {
+		t.Errorf("role_id = %s, want 7", model.RoleId)
+	}
+	if model.ResolveDatasetIds.IsNull() || model.ResolveDatasetIds.ValueBool() {
+		t.Errorf("resolve_dataset_ids = %s, want false", model.ResolveDatasetIds)
+	}
+	if got := len(model.Permissions.Elements()); got != 2 {
+		t.Errorf("expected the permissions of the role, got %d", got)
+	}
+}
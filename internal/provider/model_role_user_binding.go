@@ -0,0 +1,95 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+type roleUserBindingBaseModel struct {
+	RoleId    types.Int64  `tfsdk:"role_id"`
+	RoleName  types.String `tfsdk:"role_name"`
+	Usernames types.Set    `tfsdk:"usernames"`
+	// Authoritative makes Usernames the only users of the role. Otherwise users of the role that are not
+	// in Usernames are kept, and only the ones of Usernames are removed on destroy.
+	Authoritative types.Bool `tfsdk:"authoritative"`
+}
+
+// usernames returns the usernames of the model.
+func (model *roleUserBindingBaseModel) usernames() []string {
+	if model.Usernames.IsNull() || model.Usernames.IsUnknown() {
+		return nil
+	}
+
+	names := make([]string, 0, len(model.Usernames.Elements()))
+	for _, v := range model.Usernames.Elements() {
+		if s, ok := v.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			names = append(names, s.ValueString())
+		}
+	}
+	return names
+}
+
+// updateState sets the usernames to the ones of the users of the role, or, when the binding is not
+// authoritative, to the ones of the prior usernames that are users of the role, so that users that
+// left the role show up as drift and users added outside Terraform are ignored.
+func (model *roleUserBindingBaseModel) updateState(role *client.SupersetRoleApiGetList, users []client.SupersetUserApiGetList) {
+	model.RoleId = types.Int64Value(int64(role.Id))
+	model.RoleName = types.StringValue(role.Name)
+
+	prior := model.usernames()
+	var names []string
+	for _, u := range roleMembers(users, role.Id) {
+		if model.Authoritative.ValueBool() || model.Usernames.IsNull() || slices.Contains(prior, u.Username) {
+			names = append(names, u.Username)
+		}
+	}
+	model.Usernames = stringSetValue(names)
+}
+
+// resolveUserIds returns the IDs of the users of usernames, and the usernames no user has.
+func resolveUserIds(users []client.SupersetUserApiGetList, usernames []string) ([]int, []string) {
+	userIds := make(map[string]int, len(users))
+	for _, u := range users {
+		userIds[u.Username] = u.Id
+	}
+
+	var ids []int
+	var notFound []string
+	for _, name := range usernames {
+		id, ok := userIds[name]
+		if !ok {
+			notFound = append(notFound, name)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, notFound
+}
+
+// roleMembers returns the users of users that have the role with the ID roleId.
+func roleMembers(users []client.SupersetUserApiGetList, roleId int) []client.SupersetUserApiGetList {
+	var members []client.SupersetUserApiGetList
+	for _, u := range users {
+		if slices.ContainsFunc(u.Roles, func(r client.SupersetUserApiGetListRole) bool { return r.Id == roleId }) {
+			members = append(members, u)
+		}
+	}
+	return members
+}
+
+// roleUserIds returns the IDs of the users the role with the ID roleId has once the users of add are
+// added and the ones of remove are removed.
+func roleUserIds(users []client.SupersetUserApiGetList, roleId int, add []int, remove []int) []int {
+	ids := []int{}
+	for _, u := range roleMembers(users, roleId) {
+		if !slices.Contains(remove, u.Id) && !slices.Contains(add, u.Id) {
+			ids = append(ids, u.Id)
+		}
+	}
+	return append(ids, add...)
+}
@@ -0,0 +1,42 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+func TestRoleUserBinding(t *testing.T) {
+	role := &client.SupersetRoleApiGetList{Id: 3, Name: "Analyst"}
+	member := []client.SupersetUserApiGetListRole{{Id: 3, Name: "Analyst"}}
+	users := []client.SupersetUserApiGetList{
+		{Id: 1, Username: "alice", Roles: member},
+		{Id: 2, Username: "bob", Roles: member},
+		{Id: 3, Username: "carol"},
+		{Id: 4, Username: "dave", Roles: member},
+	}
+
+	model := roleUserBindingBaseModel{Usernames: stringSetValue([]string{"alice", "carol"}), Authoritative: types.BoolValue(false)}
+	model.updateState(role, users)
+	if got := model.usernames(); !slices.Equal(got, []string{"alice"}) {
+		t.Errorf("expected only the configured members, got %v", got)
+	}
+
+	model.Authoritative = types.BoolValue(true)
+	model.updateState(role, users)
+	if got := model.usernames(); !slices.Equal(got, []string{"alice", "bob", "dave"}) {
+		t.Errorf("expected all members, got %v", got)
+	}
+
+	if got := roleUserIds(users, role.Id, []int{3, 1}, []int{2}); !slices.Equal(got, []int{4, 3, 1}) {
+		t.Errorf("expected the kept members and the added users, got %v", got)
+	}
+	if got := roleUserIds(users, role.Id, nil, []int{1, 2, 4}); got == nil || len(got) != 0 {
+		t.Errorf("expected an empty list, got %v", got)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var (
+	// sqlLabRequiredPermissions must all exist on the server for a role to use SQL Lab.
+	sqlLabRequiredPermissions = []requiredPermission{
+		{"menu_access", "SQL Lab"},
+		{"can_sqllab", "Superset"},
+		{"can_execute_sql_query", "SQLLab"},
+	}
+	// sqlLabOptionalPermissions are granted when they exist, as their names differ between Superset versions.
+	sqlLabOptionalPermissions = []requiredPermission{
+		{"can_read", "SQLLab"},
+		{"can_get_results", "SQLLab"},
+		{"can_format_sql", "SQLLab"},
+		{"can_estimate_query_cost", "SQLLab"},
+		{"can_sqllab_history", "Superset"},
+		{"can_read", "Query"},
+		{"can_read", "SavedQuery"},
+		{"can_write", "SavedQuery"},
+		{"can_activate", "TabStateView"},
+		{"can_get", "TabStateView"},
+		{"can_post", "TabStateView"},
+		{"can_put", "TabStateView"},
+		{"can_delete", "TabStateView"},
+		{"can_delete_query", "TabStateView"},
+		{"can_migrate_query", "TabStateView"},
+		{"menu_access", "SQL Editor"},
+		{"menu_access", "Saved Queries"},
+		{"menu_access", "Query Search"},
+	}
+	sqlLabCsvExportPermissions = []requiredPermission{
+		{"can_export_csv", "SQLLab"},
+		{"can_csv", "Superset"},
+	}
+)
+
+type sqlLabRoleGrantsBaseModel struct {
+	RoleId         types.Int64  `tfsdk:"role_id"`
+	RoleName       types.String `tfsdk:"role_name"`
+	DatabaseId     types.Int64  `tfsdk:"database_id"`
+	DatabaseName   types.String `tfsdk:"database_name"`
+	AllowCsvExport types.Bool   `tfsdk:"allow_csv_export"`
+	Permissions    types.Set    `tfsdk:"permissions"`
+}
+
+func (model *sqlLabRoleGrantsBaseModel) updateState(role *client.SupersetRoleApiGetList, database *client.SupersetDatabaseApiGetList, permissions []client.SupersetRolePermissionApiGetList) {
+	model.RoleId = types.Int64Value(int64(role.Id))
+	model.RoleName = types.StringValue(role.Name)
+	model.DatabaseId = types.Int64Value(int64(database.Id))
+	model.DatabaseName = types.StringValue(database.DatabaseName)
+	model.Permissions = (&rolePermissionBaseModel{}).flattenPermissionsToList(permissions)
+}
+
+// databaseAccessViewMenuName returns the view menu name of the database_access permission for database.
+func databaseAccessViewMenuName(database *client.SupersetDatabaseApiGetList) string {
+	return fmt.Sprintf("[%s].(id:%d)", database.DatabaseName, database.Id)
+}
+
+// resolvePermissions returns the permissions to grant for SQL Lab access to database, and the required
+// permissions that do not exist on the server.
+func (model *sqlLabRoleGrantsBaseModel) resolvePermissions(sourcePermissions []client.SupersetPermissionApiGetList, database *client.SupersetDatabaseApiGetList) ([]client.SupersetRolePermissionApiGetList, []string) {
+	sourcePermissionIdMap := make(map[requiredPermission]int, len(sourcePermissions))
+	for _, p := range sourcePermissions {
+		sourcePermissionIdMap[requiredPermission{p.Permission.Name, p.ViewMenu.Name}] = p.Id
+	}
+
+	required := append([]requiredPermission{{"database_access", databaseAccessViewMenuName(database)}}, sqlLabRequiredPermissions...)
+	optional := sqlLabOptionalPermissions
+	if model.AllowCsvExport.ValueBool() {
+		optional = append(append([]requiredPermission{}, optional...), sqlLabCsvExportPermissions...)
+	}
+
+	var permissions []client.SupersetRolePermissionApiGetList
+	notFoundPermissions := make([]string, 0)
+	for _, p := range required {
+		id, exists := sourcePermissionIdMap[p]
+		if !exists {
+			notFoundPermissions = append(notFoundPermissions, p.String())
+			continue
+		}
+		permissions = append(permissions, client.SupersetRolePermissionApiGetList{Id: id, PermissionName: p.PermissionName, ViewMenuName: p.ViewMenuName})
+	}
+	for _, p := range optional {
+		if id, exists := sourcePermissionIdMap[p]; exists {
+			permissions = append(permissions, client.SupersetRolePermissionApiGetList{Id: id, PermissionName: p.PermissionName, ViewMenuName: p.ViewMenuName})
+		}
+	}
+
+	return permissions, notFoundPermissions
+}
+
+// grantedPermissions returns the permissions recorded in the state as granted by this resource.
+func (model *sqlLabRoleGrantsBaseModel) grantedPermissions() []requiredPermission {
+	var granted []requiredPermission
+	if model.Permissions.IsNull() || model.Permissions.IsUnknown() {
+		return granted
+	}
+
+	for _, v := range model.Permissions.Elements() {
+		obj, ok := v.(types.Object)
+		if !ok {
+			continue
+		}
+		pn, _ := obj.Attributes()["permission_name"].(types.String)
+		vm, _ := obj.Attributes()["view_menu_name"].(types.String)
+		granted = append(granted, requiredPermission{pn.ValueString(), vm.ValueString()})
+	}
+
+	return granted
+}
@@ -0,0 +1,124 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+// defaultTagChunkSize is the default number of objects tagged per request.
+const defaultTagChunkSize = 100
+
+// taggedObjectTypes are the types of objects a superset_tag_bulk_assignment assigns its tag to, in the
+// order they are processed.
+var taggedObjectTypes = []string{client.TaggedObjectTypeDashboard, client.TaggedObjectTypeChart, client.TaggedObjectTypeDataset}
+
+type tagBulkAssignmentBaseModel struct {
+	Id         types.String `tfsdk:"id"`
+	Tag        types.String `tfsdk:"tag"`
+	Dashboards types.Set    `tfsdk:"dashboards"`
+	Charts     types.Set    `tfsdk:"charts"`
+	Datasets   types.Set    `tfsdk:"datasets"`
+	ChunkSize  types.Int64  `tfsdk:"chunk_size"`
+}
+
+// names returns the attribute holding the names of the objects of objectType.
+func (model *tagBulkAssignmentBaseModel) names(objectType string) *types.Set {
+	switch objectType {
+	case client.TaggedObjectTypeDashboard:
+		return &model.Dashboards
+	case client.TaggedObjectTypeChart:
+		return &model.Charts
+	default:
+		return &model.Datasets
+	}
+}
+
+// objectNames returns the names of the objects of objectType the tag is assigned to.
+func (model *tagBulkAssignmentBaseModel) objectNames(objectType string) []string {
+	set := model.names(objectType)
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	names := make([]string, 0, len(set.Elements()))
+	for _, v := range set.Elements() {
+		if s, ok := v.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			names = append(names, s.ValueString())
+		}
+	}
+	return names
+}
+
+// updateState sets the names of the objects to the ones of the prior names the tag is assigned to, so
+// that objects that lost the tag show up as drift. Objects tagged outside Terraform are ignored, except
+// on import, when no names are known and all tagged objects are listed.
+func (model *tagBulkAssignmentBaseModel) updateState(tagged []client.TaggedObject) {
+	model.Id = model.Tag
+
+	imported := true
+	for _, objectType := range taggedObjectTypes {
+		if !model.names(objectType).IsNull() {
+			imported = false
+		}
+	}
+
+	for _, objectType := range taggedObjectTypes {
+		set := model.names(objectType)
+		if set.IsNull() && !imported {
+			continue
+		}
+
+		var prior map[string]bool
+		if !imported {
+			prior = make(map[string]bool)
+			for _, name := range model.objectNames(objectType) {
+				prior[name] = true
+			}
+		}
+
+		var names []string
+		for _, o := range tagged {
+			if o.Type == objectType && (imported || prior[o.Name]) {
+				names = append(names, o.Name)
+			}
+		}
+		if imported && len(names) == 0 {
+			continue
+		}
+		*set = stringSetValue(names)
+	}
+}
+
+// stringSetValue returns names as a set, without duplicates.
+func stringSetValue(names []string) types.Set {
+	sort.Strings(names)
+	values := make([]attr.Value, 0, len(names))
+	for i, name := range names {
+		if i == 0 || names[i-1] != name {
+			values = append(values, types.StringValue(name))
+		}
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+// nameDifference returns the names of a that are not in b.
+func nameDifference(a []string, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, name := range b {
+		in[name] = true
+	}
+
+	var diff []string
+	for _, name := range a {
+		if !in[name] {
+			diff = append(diff, name)
+		}
+	}
+	return diff
+}
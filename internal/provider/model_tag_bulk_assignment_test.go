@@ -0,0 +1,56 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+func TestTagBulkAssignmentUpdateState(t *testing.T) {
+	tagged := []client.TaggedObject{
+		{Type: client.TaggedObjectTypeDashboard, Id: 1, Name: "Revenue"},
+		{Type: client.TaggedObjectTypeDashboard, Id: 2, Name: "Tagged outside Terraform"},
+		{Type: client.TaggedObjectTypeChart, Id: 3, Name: "Orders"},
+	}
+
+	model := tagBulkAssignmentBaseModel{
+		Tag:        types.StringValue("domain:finance"),
+		Dashboards: stringSetValue([]string{"Revenue", "Forecast"}),
+		Charts:     types.SetNull(types.StringType),
+		Datasets:   types.SetNull(types.StringType),
+	}
+	model.updateState(tagged)
+
+	if got := model.objectNames(client.TaggedObjectTypeDashboard); !slices.Equal(got, []string{"Revenue"}) {
+		t.Errorf("expected only the configured dashboard that has the tag, got %v", got)
+	}
+	if !model.Charts.IsNull() {
+		t.Errorf("expected the unconfigured charts to stay null, got %v", model.Charts)
+	}
+
+	imported := tagBulkAssignmentBaseModel{
+		Tag:        types.StringValue("domain:finance"),
+		Dashboards: types.SetNull(types.StringType),
+		Charts:     types.SetNull(types.StringType),
+		Datasets:   types.SetNull(types.StringType),
+	}
+	imported.updateState(tagged)
+
+	if got := imported.objectNames(client.TaggedObjectTypeDashboard); !slices.Equal(got, []string{"Revenue", "Tagged outside Terraform"}) {
+		t.Errorf("expected all tagged dashboards on import, got %v", got)
+	}
+	if got := imported.objectNames(client.TaggedObjectTypeChart); !slices.Equal(got, []string{"Orders"}) {
+		t.Errorf("expected all tagged charts on import, got %v", got)
+	}
+	if !imported.Datasets.IsNull() {
+		t.Errorf("expected no datasets on import, got %v", imported.Datasets)
+	}
+	if imported.Id.ValueString() != "domain:finance" {
+		t.Errorf("expected the ID to be the tag name, got %q", imported.Id.ValueString())
+	}
+}
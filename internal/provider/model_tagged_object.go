@@ -0,0 +1,53 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+type taggedObjectBaseModel struct {
+	Id         types.String `tfsdk:"id"`
+	ObjectType types.String `tfsdk:"object_type"`
+	ObjectId   types.Int64  `tfsdk:"object_id"`
+	Tags       types.Set    `tfsdk:"tags"`
+}
+
+func (model *taggedObjectBaseModel) object() client.TaggedObject {
+	return client.TaggedObject{Type: model.ObjectType.ValueString(), Id: int(model.ObjectId.ValueInt64())}
+}
+
+func (model *taggedObjectBaseModel) tags() []string {
+	var names []string
+	for _, v := range model.Tags.Elements() {
+		if name, ok := v.(types.String); ok {
+			names = append(names, name.ValueString())
+		}
+	}
+	return names
+}
+
+// updateState sets the tags to the ones of the prior tags assigned to the object, so that tags removed
+// outside Terraform show up as drift. Other tags of the object are ignored, except on import, when no
+// tags are known and all tags of the object are listed.
+func (model *taggedObjectBaseModel) updateState(assigned []string) {
+	model.Id = types.StringValue(fmt.Sprintf("%s/%d", model.ObjectType.ValueString(), model.ObjectId.ValueInt64()))
+
+	if model.Tags.IsNull() {
+		model.Tags = stringSetValue(assigned)
+		return
+	}
+
+	kept := make([]string, 0, len(assigned))
+	for _, name := range model.tags() {
+		if slices.Contains(assigned, name) {
+			kept = append(kept, name)
+		}
+	}
+	model.Tags = stringSetValue(kept)
+}
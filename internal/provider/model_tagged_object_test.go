@@ -0,0 +1,32 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTaggedObjectUpdateState(t *testing.T) {
+	model := taggedObjectBaseModel{
+		ObjectType: types.StringValue("dashboard"),
+		ObjectId:   types.Int64Value(7),
+		Tags:       stringSetValue([]string{"domain:finance", "tier:gold"}),
+	}
+	model.updateState([]string{"domain:finance", "team:analytics"})
+	if got := model.tags(); !slices.Equal(got, []string{"domain:finance"}) {
+		t.Errorf("expected only the configured tags still assigned, got %v", got)
+	}
+	if model.Id.ValueString() != "dashboard/7" {
+		t.Errorf("expected the ID dashboard/7, got %s", model.Id.ValueString())
+	}
+
+	model.Tags = types.SetNull(types.StringType)
+	model.updateState([]string{"team:analytics", "domain:finance"})
+	if got := model.tags(); !slices.Equal(got, []string{"domain:finance", "team:analytics"}) {
+		t.Errorf("expected all tags on import, got %v", got)
+	}
+}
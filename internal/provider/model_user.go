@@ -19,6 +19,9 @@ type userBaseModel struct {
 	RoleNames  types.Set    `tfsdk:"role_names"`
 	GroupNames types.Set    `tfsdk:"group_names"`
 	Active     types.Bool   `tfsdk:"active"`
+	CreatedOn  types.String `tfsdk:"created_on"`
+	ChangedOn  types.String `tfsdk:"changed_on"`
+	ChangedBy  types.String `tfsdk:"changed_by"`
 }
 
 func (model *userBaseModel) resolveGroupIDsFromNames(sourceGroups []client.SupersetGroupApiGetList) ([]int, []string) {
@@ -102,6 +105,8 @@ func (model *userBaseModel) updateState(u *client.SupersetUserApiGet, password *
 	}
 	model.RoleNames = model.flattenRoleNamesToSet(u)
 	model.GroupNames = model.flattenGroupNamesToSet(u)
+	model.CreatedOn = nullableStringValue(u.CreatedOn)
+	model.ChangedOn = nullableStringValue(u.ChangedOn)
 }
 
 func (model *userBaseModel) flattenGroupNamesToSet(u *client.SupersetUserApiGet) types.Set {
@@ -0,0 +1,36 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+type userPasswordBaseModel struct {
+	UserId            types.Int64  `tfsdk:"user_id"`
+	Username          types.String `tfsdk:"username"`
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+func (model *userPasswordBaseModel) updateState(u *client.SupersetUserApiGet) {
+	model.UserId = types.Int64Value(int64(u.Id))
+	model.Username = types.StringValue(u.Username)
+	// The password is write-only, so it is never stored.
+	model.PasswordWo = types.StringNull()
+}
+
+// randomUserPassword returns a random password for a user created without one, so that nobody can log in
+// as the user until its password is set.
+func randomUserPassword() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
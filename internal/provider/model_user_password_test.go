@@ -0,0 +1,24 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestRandomUserPassword(t *testing.T) {
+	a, err := randomUserPassword()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err := randomUserPassword()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(a) < 32 {
+		t.Errorf("expected a long password, got %d characters", len(a))
+	}
+	if a == b {
+		t.Error("expected different passwords")
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+type userRoleBindingBaseModel struct {
+	Id       types.String `tfsdk:"id"`
+	Username types.String `tfsdk:"username"`
+	RoleName types.String `tfsdk:"role_name"`
+	UserId   types.Int64  `tfsdk:"user_id"`
+	RoleId   types.Int64  `tfsdk:"role_id"`
+}
+
+func (model *userRoleBindingBaseModel) updateState(user *client.SupersetUserApiGetList, role *client.SupersetRoleApiGetList) {
+	model.Id = types.StringValue(user.Username + "/" + role.Name)
+	model.Username = types.StringValue(user.Username)
+	model.RoleName = types.StringValue(role.Name)
+	model.UserId = types.Int64Value(int64(user.Id))
+	model.RoleId = types.Int64Value(int64(role.Id))
+}
+
+// userHasRole reports whether the user has the role with the ID roleId.
+func userHasRole(user *client.SupersetUserApiGetList, roleId int) bool {
+	return slices.ContainsFunc(user.Roles, func(r client.SupersetUserApiGetListRole) bool { return r.Id == roleId })
+}
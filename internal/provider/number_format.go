@@ -0,0 +1,82 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// currencyCodes are the ISO 4217 codes of the active currencies. Superset formats metric values with the
+// Intl API of the browser, which accepts any of them, while its CURRENCIES setting only lists the ones
+// offered in the UI.
+var currencyCodes = []string{
+	"AED", "AFN", "ALL", "AMD", "ANG", "AOA", "ARS", "AUD", "AWG", "AZN", "BAM", "BBD", "BDT", "BGN", "BHD",
+	"BIF", "BMD", "BND", "BOB", "BRL", "BSD", "BTN", "BWP", "BYN", "BZD", "CAD", "CDF", "CHF", "CLP", "CNY",
+	"COP", "CRC", "CUP", "CVE", "CZK", "DJF", "DKK", "DOP", "DZD", "EGP", "ERN", "ETB", "EUR", "FJD", "FKP",
+	"GBP", "GEL", "GHS", "GIP", "GMD", "GNF", "GTQ", "GYD", "HKD", "HNL", "HTG", "HUF", "IDR", "ILS", "INR",
+	"IQD", "IRR", "ISK", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRW", "KWD", "KYD", "KZT",
+	"LAK", "LBP", "LKR", "LRD", "LSL", "LYD", "MAD", "MDL", "MGA", "MKD", "MMK", "MNT", "MOP", "MRU", "MUR",
+	"MVR", "MWK", "MXN", "MYR", "MZN", "NAD", "NGN", "NIO", "NOK", "NPR", "NZD", "OMR", "PAB", "PEN", "PGK",
+	"PHP", "PKR", "PLN", "PYG", "QAR", "RON", "RSD", "RUB", "RWF", "SAR", "SBD", "SCR", "SDG", "SEK", "SGD",
+	"SHP", "SLE", "SOS", "SRD", "SSP", "STN", "SVC", "SYP", "SZL", "THB", "TJS", "TMT", "TND", "TOP", "TRY",
+	"TTD", "TWD", "TZS", "UAH", "UGX", "USD", "UYU", "UZS", "VES", "VND", "VUV", "WST", "XAF", "XCD", "XCG",
+	"XOF", "XPF", "YER", "ZAR", "ZMW", "ZWG",
+}
+
+// supersetNumberFormats are the named number formats Superset registers besides d3 format strings.
+var supersetNumberFormats = []string{
+	"SMART_NUMBER", "SMART_NUMBER_SIGNED", "OVER_MAX_HIDDEN", "DURATION", "DURATION_SUB", "DURATION_COL",
+	"MEMORY_DECIMAL", "MEMORY_BINARY", "MEMORY_TRANSFER_RATE_DECIMAL", "MEMORY_TRANSFER_RATE_BINARY",
+}
+
+// d3FormatSpecifier is the format specifier grammar of d3-format,
+// [[fill]align][sign][symbol][0][width][,][.precision][~][type].
+var d3FormatSpecifier = regexp.MustCompile(`^(?:(.)?([<>=^]))?([+\-( ])?([$#])?(0)?(\d+)?(,)?(\.\d+)?(~)?([a-zA-Z%])?$`)
+
+// d3FormatTypes are the types d3-format implements. It formats other types as "g" without an error.
+const d3FormatTypes = "efgrs%pbodxXcn"
+
+// validateD3Format reports why format is neither a d3 format string nor a named format of Superset, which
+// the UI would otherwise display unformatted, or formatted as another type, without an error.
+func validateD3Format(format string) error {
+	if slices.Contains(supersetNumberFormats, format) {
+		return nil
+	}
+	m := d3FormatSpecifier.FindStringSubmatch(format)
+	if m == nil {
+		return fmt.Errorf("it does not match the d3 format specifier [[fill]align][sign][symbol][0][width][,][.precision][~][type]")
+	}
+	if t := m[10]; t != "" && !strings.Contains(d3FormatTypes, t) {
+		return fmt.Errorf("%q is not a d3 format type, one of %s", t, strings.Join(strings.Split(d3FormatTypes, ""), " "))
+	}
+	return nil
+}
+
+// d3FormatValidator validates that a string is a number format validateD3Format accepts.
+type d3FormatValidator struct{}
+
+func (v d3FormatValidator) Description(ctx context.Context) string {
+	return "value must be a d3 format string, e.g. ,.2f, or a named format of Superset, e.g. SMART_NUMBER"
+}
+
+func (v d3FormatValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v d3FormatValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateD3Format(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Number Format",
+			fmt.Sprintf("%q is not a valid number format: %s.", req.ConfigValue.ValueString(), err))
+	}
+}
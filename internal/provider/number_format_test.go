@@ -0,0 +1,19 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestValidateD3Format(t *testing.T) {
+	for _, format := range []string{"", ",d", ",.2f", ".3s", "+,.1%", "$,.2f", "~g", ".2~f", "0>8d", "*^12,.0f", "SMART_NUMBER", "DURATION"} {
+		if err := validateD3Format(format); err != nil {
+			t.Errorf("validateD3Format(%q) = %v, want nil", format, err)
+		}
+	}
+	for _, format := range []string{".2F", "{,.2f}", "%.2f", "smart_number", ",.2fx"} {
+		if err := validateD3Format(format); err == nil {
+			t.Errorf("validateD3Format(%q) = nil, want an error", format)
+		}
+	}
+}
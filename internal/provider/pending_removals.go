@@ -0,0 +1,203 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+// pendingRemovalsFunc returns the names of the items on the server that an apply of the plan removes,
+// because the resource replaces the full list of items and the plan does not list them. known is false
+// when the items cannot be computed at plan time, e.g. because the plan holds unknown values or the
+// object the items belong to does not exist yet.
+type pendingRemovalsFunc func(ctx context.Context, req planmodifier.SetRequest) (names []string, known bool, diags diag.Diagnostics)
+
+// pendingRemovalsModifier plans the pending_removals attribute of resources that replace a full list of
+// items on the server, so that the plan shows which items are deleted.
+//
+// The planned value is stored in the state by the apply, and the next refresh resets it to an empty set,
+// so that the attribute only shows up in a plan that removes something.
+type pendingRemovalsModifier struct {
+	removals pendingRemovalsFunc
+}
+
+// pendingRemovalsAttribute returns the computed pending_removals attribute, whose elements name the items
+// the next apply removes.
+func pendingRemovalsAttribute(items string, removals pendingRemovalsFunc) schema.SetAttribute {
+	return schema.SetAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+		MarkdownDescription: fmt.Sprintf("The %s on the server that the next apply removes because they are not configured. "+
+			"The full list is replaced on apply, so this shows in the plan exactly what is deleted. Reset to an empty set on refresh.", items),
+		PlanModifiers: []planmodifier.Set{
+			pendingRemovalsModifier{removals: removals},
+		},
+	}
+}
+
+func (m pendingRemovalsModifier) Description(ctx context.Context) string {
+	return "Plans the items on the server that the apply removes."
+}
+
+func (m pendingRemovalsModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m pendingRemovalsModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	names, known, diags := m.removals(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !known {
+		resp.PlanValue = types.SetUnknown(types.StringType)
+		return
+	}
+	resp.PlanValue = pendingRemovalsValue(names)
+}
+
+// pendingRemovalsValue returns names as a sorted set.
+func pendingRemovalsValue(names []string) types.Set {
+	sorted := append([]string(nil), names...)
+	sort.Strings(sorted)
+
+	seen := make(map[string]bool, len(sorted))
+	values := make([]attr.Value, 0, len(sorted))
+	for _, name := range sorted {
+		if !seen[name] {
+			seen[name] = true
+			values = append(values, types.StringValue(name))
+		}
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+// appliedPendingRemovals returns the value of pending_removals to store after an apply of planned.
+func appliedPendingRemovals(planned types.Set) types.Set {
+	if planned.IsUnknown() || planned.IsNull() {
+		return pendingRemovalsValue(nil)
+	}
+	return planned
+}
+
+// unplannedNames returns the names of current that are not planned.
+func unplannedNames(current []string, planned map[string]bool) []string {
+	var names []string
+	for _, name := range current {
+		if !planned[name] {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// plannedNames returns the string attribute name of the nested objects of a planned map or list, and
+// false when any of them is unknown.
+func plannedNames(elements []attr.Value, name string) (map[string]bool, bool) {
+	names := make(map[string]bool, len(elements))
+	for _, v := range elements {
+		obj, ok := v.(types.Object)
+		if !ok || obj.IsUnknown() {
+			return nil, false
+		}
+		s, ok := obj.Attributes()[name].(types.String)
+		if !ok || s.IsUnknown() {
+			return nil, false
+		}
+		names[s.ValueString()] = true
+	}
+	return names, true
+}
+
+// currentDataset returns the dataset of a dataset columns, metrics or folder resource as it is on the
+// server, and false when it does not exist yet.
+func currentDataset(ctx context.Context, c *client.ClientWrapper, req planmodifier.SetRequest) (*client.DatasetRestApiGet, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if c == nil {
+		return nil, false, diags
+	}
+
+	var datasetId types.Int64
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("dataset_id"), &datasetId)...)
+	}
+	if !datasetId.IsNull() && !datasetId.IsUnknown() {
+		dataset, err := c.GetDataset(ctx, int(datasetId.ValueInt64()))
+		if client.IsNotFound(err) {
+			return nil, false, diags
+		} else if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to get dataset with ID %d: %s", datasetId.ValueInt64(), err))
+			return nil, false, diags
+		}
+		return dataset, true, diags
+	}
+
+	var datasetName types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("dataset_name"), &datasetName)...)
+	if diags.HasError() || datasetName.IsUnknown() {
+		return nil, false, diags
+	}
+	found, err := c.FindDataset(ctx, datasetName.ValueString())
+	if client.IsNotFound(err) {
+		return nil, false, diags
+	} else if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to find dataset with name '%s': %s", datasetName.ValueString(), err))
+		return nil, false, diags
+	}
+	dataset, err := c.GetDataset(ctx, found.Id)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get dataset with ID %d: %s", found.Id, err))
+		return nil, false, diags
+	}
+	return dataset, true, diags
+}
+
+// rolePermissionsPendingRemovals returns the permissions of the role of a role permissions resource that
+// the plan revokes.
+func rolePermissionsPendingRemovals(ctx context.Context, c *client.ClientWrapper, req planmodifier.SetRequest) ([]string, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if c == nil {
+		return nil, false, diags
+	}
+
+	var model rolePermissionBaseModel
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("role_name"), &model.RoleName)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("permissions"), &model.Permissions)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("except_permissions"), &model.ExceptPermissions)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("resolve_dataset_ids"), &model.ResolveDatasetIds)...)
+	if diags.HasError() || model.RoleName.IsUnknown() {
+		return nil, false, diags
+	}
+
+	role, err := c.FindRole(ctx, model.RoleName.ValueString())
+	if client.IsNotFound(err) {
+		return nil, false, diags
+	} else if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", model.RoleName.ValueString(), err))
+		return nil, false, diags
+	}
+
+	granted, err := c.ListRolePermissions(ctx, role.Id)
+	if err != nil && !client.IsNotFound(err) {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+		return nil, false, diags
+	}
+
+	names, known := model.pendingRemovals(granted)
+	return names, known, diags
+}
@@ -0,0 +1,234 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+type requiredPermission struct {
+	PermissionName string
+	ViewMenuName   string
+}
+
+func (p requiredPermission) String() string {
+	return fmt.Sprintf("%s on %s", p.PermissionName, p.ViewMenuName)
+}
+
+var (
+	datasetPermissions = []requiredPermission{
+		{"can_read", "Dataset"},
+		{"can_write", "Dataset"},
+		{"can_read", "Database"},
+	}
+	rolePermissionsPermissions = []requiredPermission{
+		{"can_get", "Role"},
+		{"can_list_role_permissions", "Role"},
+		{"can_add_role_permissions", "Role"},
+		{"can_get", "PermissionViewMenu"},
+	}
+)
+
+// preflightPermissions lists the permissions the provider account needs to manage each resource type.
+var preflightPermissions = map[string][]requiredPermission{
+	"superset_user": {
+		{"can_get", "User"},
+		{"can_post", "User"},
+		{"can_put", "User"},
+		{"can_delete", "User"},
+		{"can_get", "Role"},
+		{"can_get", "Group"},
+	},
+	"superset_user_password": {
+		{"can_get", "User"},
+		{"can_put", "User"},
+	},
+	"superset_role": {
+		{"can_get", "Role"},
+		{"can_post", "Role"},
+		{"can_put", "Role"},
+		{"can_delete", "Role"},
+	},
+	"superset_role_permissions":             rolePermissionsPermissions,
+	"superset_public_role_permissions":      rolePermissionsPermissions,
+	"superset_role_hierarchy":               rolePermissionsPermissions,
+	"superset_sql_lab_role_grants":          append([]requiredPermission{{"can_read", "Database"}}, rolePermissionsPermissions...),
+	"superset_database_catalog_permissions": append([]requiredPermission{{"can_read", "Database"}}, rolePermissionsPermissions...),
+	"superset_database": {
+		{"can_read", "Database"},
+		{"can_write", "Database"},
+	},
+	"superset_group": {
+		{"can_get", "Group"},
+		{"can_post", "Group"},
+		{"can_put", "Group"},
+		{"can_delete", "Group"},
+	},
+	"superset_group_role_binding": {
+		{"can_get", "Group"},
+		{"can_put", "Group"},
+		{"can_get", "Role"},
+	},
+	"superset_role_user_binding": {
+		{"can_get", "Role"},
+		{"can_put", "Role"},
+		{"can_get", "User"},
+	},
+	"superset_user_role_binding": {
+		{"can_get", "User"},
+		{"can_put", "User"},
+		{"can_get", "Role"},
+	},
+	"superset_tag": {
+		{"can_read", "Tag"},
+		{"can_write", "Tag"},
+	},
+	"superset_tag_bulk_assignment": {
+		{"can_read", "Tag"},
+		{"can_write", "Tag"},
+		{"can_read", "Dashboard"},
+		{"can_read", "Chart"},
+		{"can_read", "Dataset"},
+	},
+	"superset_tagged_object": {
+		{"can_read", "Tag"},
+		{"can_write", "Tag"},
+		{"can_read", "Dashboard"},
+		{"can_read", "Chart"},
+		{"can_read", "Dataset"},
+	},
+	"superset_dataset":         datasetPermissions,
+	"superset_dataset_columns": datasetPermissions,
+	"superset_dataset_metrics": datasetPermissions,
+	"superset_dataset_folder":  datasetPermissions,
+	"superset_dataset_refresh": datasetPermissions,
+	"superset_dataset_owners": {
+		{"can_read", "Dataset"},
+		{"can_write", "Dataset"},
+		{"can_get", "User"},
+	},
+	"superset_chart": {
+		{"can_read", "Chart"},
+		{"can_write", "Chart"},
+		{"can_read", "Dataset"},
+	},
+	"superset_owner_transfer": {
+		{"can_get", "User"},
+		{"can_read", "Dataset"},
+		{"can_write", "Dataset"},
+		{"can_read", "Chart"},
+		{"can_write", "Chart"},
+		{"can_read", "Dashboard"},
+		{"can_write", "Dashboard"},
+	},
+	"superset_dashboard_certified": {
+		{"can_read", "Dashboard"},
+		{"can_write", "Dashboard"},
+	},
+	"superset_dashboard_role_access": {
+		{"can_read", "Dashboard"},
+		{"can_write", "Dashboard"},
+		{"can_get", "Role"},
+	},
+	"superset_favorite": {
+		{"can_read", "Dashboard"},
+		{"can_write", "Dashboard"},
+		{"can_read", "Chart"},
+		{"can_write", "Chart"},
+	},
+	"superset_cache_warmup": {
+		{"can_read", "Dashboard"},
+		{"can_warm_up_cache", "Chart"},
+		{"can_warm_up_cache", "Dataset"},
+	},
+	"superset_alert": {
+		{"can_read", "ReportSchedule"},
+		{"can_write", "ReportSchedule"},
+	},
+	"superset_report": {
+		{"can_read", "ReportSchedule"},
+		{"can_write", "ReportSchedule"},
+	},
+	// superset_report_recipient is only kept in the state, so it needs no permissions.
+	"superset_report_recipient": {},
+	// The import of a single object type with object_type needs can_write on the type instead.
+	"superset_import_bundle": {
+		{"can_import", "ImportExportRestApi"},
+	},
+	"superset_asset_promotion": {
+		{"can_import", "ImportExportRestApi"},
+	},
+	"superset_annotation_layer": {
+		{"can_read", "Annotation"},
+		{"can_write", "Annotation"},
+	},
+	"superset_annotation": {
+		{"can_read", "Annotation"},
+		{"can_write", "Annotation"},
+	},
+	"superset_css_template_binding": {
+		{"can_read", "CssTemplate"},
+		{"can_read", "Dashboard"},
+		{"can_write", "Dashboard"},
+	},
+}
+
+func preflightResourceTypes() []string {
+	names := make([]string, 0, len(preflightPermissions))
+	for name := range preflightPermissions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// checkPreflightPermissions verifies that the provider account holds the permissions required by the
+// given resource types and reports all missing ones in a single diagnostic.
+func checkPreflightPermissions(ctx context.Context, c *client.ClientWrapper, resourceTypes []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	granted, err := c.GetCurrentUserPermissions(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read the permissions of the provider account: %s", err))
+		return diags
+	}
+
+	grantedSet := make(map[requiredPermission]bool, len(granted))
+	for _, p := range granted {
+		grantedSet[requiredPermission{p.PermissionName, p.ViewMenuName}] = true
+	}
+
+	sort.Strings(resourceTypes)
+	var lines []string
+	for _, resourceType := range resourceTypes {
+		var missing []string
+		for _, p := range preflightPermissions[resourceType] {
+			if !grantedSet[p] {
+				missing = append(missing, p.String())
+			}
+		}
+		if len(missing) > 0 {
+			lines = append(lines, fmt.Sprintf("  - %s: %s", resourceType, strings.Join(missing, ", ")))
+		}
+	}
+
+	if len(lines) > 0 {
+		diags.AddAttributeError(
+			path.Root("preflight_permission_check"),
+			"Missing Permissions",
+			"The provider account is missing permissions required by the following resource types:\n"+
+				strings.Join(lines, "\n")+"\n"+
+				"Grant them to one of the roles of the provider account, or remove the resource types from preflight_permission_check.",
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,30 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestPreflightPermissionsCoverResources(t *testing.T) {
+	ctx := context.Background()
+	registered := make(map[string]bool)
+	for _, newResource := range (&SupersetProvider{}).Resources(ctx) {
+		var resp resource.MetadataResponse
+		newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "superset"}, &resp)
+		registered[resp.TypeName] = true
+		if _, ok := preflightPermissions[resp.TypeName]; !ok {
+			t.Errorf("%s has no entry in preflightPermissions", resp.TypeName)
+		}
+	}
+
+	for resourceType := range preflightPermissions {
+		if !registered[resourceType] {
+			t.Errorf("preflightPermissions has an entry for %s, which is not a registered resource", resourceType)
+		}
+	}
+}
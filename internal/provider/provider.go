@@ -5,16 +5,27 @@ package provider
 
 import (
 	"context"
+	"maps"
+	"net/http"
+	"net/url"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/htamakos/terraform-provider-superset/internal/client"
+	"golang.org/x/net/http/httpguts"
 )
 
 var _ provider.Provider = &SupersetProvider{}
@@ -24,10 +35,45 @@ type SupersetProvider struct {
 }
 
 type SupersetProviderModel struct {
-	ServerBaseUrl types.String `tfsdk:"server_base_url"`
-	Username      types.String `tfsdk:"username"`
-	Password      types.String `tfsdk:"password"`
-	PageSize      types.Int64  `tfsdk:"page_size"`
+	ServerBaseUrl         types.String              `tfsdk:"server_base_url"`
+	ApiBasePath           types.String              `tfsdk:"api_base_path"`
+	Username              types.String              `tfsdk:"username"`
+	Password              types.String              `tfsdk:"password"`
+	AuthProvider          types.String              `tfsdk:"auth_provider"`
+	AccessToken           types.String              `tfsdk:"access_token"`
+	CACertPEM             types.String              `tfsdk:"ca_cert_pem"`
+	CACertFile            types.String              `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify    types.Bool                `tfsdk:"insecure_skip_verify"`
+	HTTPProxy             types.String              `tfsdk:"http_proxy"`
+	HTTPSProxy            types.String              `tfsdk:"https_proxy"`
+	NoProxy               types.String              `tfsdk:"no_proxy"`
+	CustomHeaders         types.Map                 `tfsdk:"custom_headers"`
+	IngressAuth           *providerIngressAuthModel `tfsdk:"ingress_auth"`
+	PageSize              types.Int64               `tfsdk:"page_size"`
+	PageConcurrency       types.Int64               `tfsdk:"page_concurrency"`
+	MaxResponseSize       types.Int64               `tfsdk:"max_response_size"`
+	FailOnConflict        types.Bool                `tfsdk:"fail_on_conflict"`
+	PreflightCheck        types.Set                 `tfsdk:"preflight_permission_check"`
+	BulkMode              types.Bool                `tfsdk:"bulk_mode"`
+	LookupCacheTTL        types.String              `tfsdk:"lookup_cache_ttl"`
+	MaxConcurrentRequests types.Int64               `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64             `tfsdk:"requests_per_second"`
+	Burst                 types.Int64               `tfsdk:"burst"`
+	MaxRetries            types.Int64               `tfsdk:"max_retries"`
+	RetryMinDelay         types.String              `tfsdk:"retry_min_delay"`
+	RetryMaxDelay         types.String              `tfsdk:"retry_max_delay"`
+	TimeoutsDefaults      types.Map                 `tfsdk:"timeouts_defaults"`
+	Tenant                types.String              `tfsdk:"tenant"`
+	TenantRouting         types.String              `tfsdk:"tenant_routing"`
+	TenantHeader          types.String              `tfsdk:"tenant_header"`
+}
+
+type providerIngressAuthModel struct {
+	BearerToken types.String `tfsdk:"bearer_token"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	Cookies     types.Map    `tfsdk:"cookies"`
+	Header      types.String `tfsdk:"header"`
 }
 
 func (p *SupersetProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -42,19 +88,186 @@ func (p *SupersetProvider) Schema(ctx context.Context, req provider.SchemaReques
 				MarkdownDescription: "The base URL of the Superset server.",
 				Optional:            true,
 			},
+			"api_base_path": schema.StringAttribute{
+				MarkdownDescription: "The path prefix under which Superset is hosted, e.g. `/analytics`. It is joined to `server_base_url`, and leading or trailing slashes on either value are ignored. Can also be set with the `SUPERSET_API_BASE_PATH` environment variable.",
+				Optional:            true,
+			},
+			"tenant": schema.StringAttribute{
+				MarkdownDescription: "The tenant, or workspace, to manage on a multi-tenant Superset distribution. It is sent with every request, including the login, as configured by `tenant_routing`. Can also be set with the `SUPERSET_TENANT` environment variable. Defaults to no tenant.",
+				Optional:            true,
+			},
+			"tenant_routing": schema.StringAttribute{
+				MarkdownDescription: "How the distribution routes requests to the `tenant`: `header` sends it in the `tenant_header` header, `path` appends it to the path after `api_base_path`, e.g. `/analytics/<tenant>/api/v1/...`. Defaults to `header`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.TenantRoutingHeader, client.TenantRoutingPath),
+				},
+			},
+			"tenant_header": schema.StringAttribute{
+				MarkdownDescription: "The name of the header carrying the `tenant` with `header` routing. Defaults to `" + client.DefaultTenantHeader + "`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"username": schema.StringAttribute{
-				MarkdownDescription: "The username for Superset authentication.",
+				MarkdownDescription: "The username for Superset authentication. Not required with `access_token`.",
 				Optional:            true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password for Superset authentication.",
+				MarkdownDescription: "The password for Superset authentication. Not required with `access_token`.",
 				Sensitive:           true,
 				Optional:            true,
 			},
+			"auth_provider": schema.StringAttribute{
+				MarkdownDescription: "The authentication provider used to log in: `db` for the Superset user database, `ldap` for LDAP or Active Directory, or the name of a provider of a custom security manager. Can also be set with the `SUPERSET_AUTH_PROVIDER` environment variable. Defaults to `db`. " +
+					"When the login fails and the server announces its authentication type, the error tells whether it expects another provider.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "A bearer token, e.g. a JWT issued by an external identity provider, sent with every request instead of logging in with `username` and `password`. " +
+					"The provider does not refresh it, so it must stay valid for the whole run. Can also be set with the `SUPERSET_ACCESS_TOKEN` environment variable.",
+				Sensitive: true,
+				Optional:  true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates to trust in addition to the system ones, e.g. the certificate of an internal CA that issued the certificate of the server. " +
+					"The PEM may also be base64 encoded, e.g. for Terraform Cloud agents without the certificate file. Conflicts with `ca_cert_file`. Can also be set with the `SUPERSET_CA_CERT_PEM` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "The path of a file of PEM encoded CA certificates to trust in addition to the system ones. Conflicts with `ca_cert_pem`. Can also be set with the `SUPERSET_CA_CERT_FILE` environment variable.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip the verification of the certificate of the server. This makes the connection vulnerable to interception, so only use it for test servers. Defaults to `false`.",
+				Optional:            true,
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "The URL of the proxy for requests to an `http` server URL, e.g. `http://proxy.example.com:3128`. Defaults to the `HTTP_PROXY` environment variable.",
+				Optional:            true,
+			},
+			"https_proxy": schema.StringAttribute{
+				MarkdownDescription: "The URL of the proxy for requests to an `https` server URL. Defaults to the `HTTPS_PROXY` environment variable.",
+				Optional:            true,
+			},
+			"no_proxy": schema.StringAttribute{
+				MarkdownDescription: "A comma separated list of host names, domains (e.g. `.example.com`), IP addresses and CIDR ranges that are connected to without a proxy. Defaults to the `NO_PROXY` environment variable. Requests to `localhost` never use a proxy.",
+				Optional:            true,
+			},
+			"custom_headers": schema.MapAttribute{
+				MarkdownDescription: "Headers added to every request to the server, keyed by header name, e.g. the `CF-Access-Client-Id` and `CF-Access-Client-Secret` service token headers of Cloudflare Access. The `Authorization` header is set by the provider and cannot be overridden.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"ingress_auth": schema.SingleNestedAttribute{
+				MarkdownDescription: "The credentials of an ingress in front of Superset requiring its own authentication, e.g. an oauth2-proxy or an identity-aware proxy. " +
+					"They are sent with every request, including the login. Set exactly one of `bearer_token`, `username` and `cookies`.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"bearer_token": schema.StringAttribute{
+						MarkdownDescription: "A static bearer token, sent in `header`. Can also be set with the `SUPERSET_INGRESS_BEARER_TOKEN` environment variable, which is ignored when `ingress_auth` sets other credentials.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"username": schema.StringAttribute{
+						MarkdownDescription: "The username of basic authentication, sent in `header` with `password`.",
+						Optional:            true,
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "The password of basic authentication.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"cookies": schema.MapAttribute{
+						MarkdownDescription: "Cookies sent with every request, keyed by cookie name, e.g. the `_oauth2_proxy` session cookie of an oauth2-proxy.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"header": schema.StringAttribute{
+						MarkdownDescription: "The header the bearer token or the basic authentication credentials are sent in. " +
+							"It cannot be `Authorization`, which the provider authenticates to Superset with. Defaults to `Proxy-Authorization`, which identity-aware proxies such as Google IAP accept.",
+						Optional: true,
+					},
+				},
+			},
 			"page_size": schema.Int64Attribute{
 				MarkdownDescription: "The number of items to retrieve per page when paginating through API results.",
 				Optional:            true,
 			},
+			"page_concurrency": schema.Int64Attribute{
+				MarkdownDescription: "The number of pages of a long list, such as the permissions of a large instance, fetched at the same time. Set to 1 to fetch them one after the other. Defaults to 4.",
+				Optional:            true,
+			},
+			"max_response_size": schema.Int64Attribute{
+				MarkdownDescription: "The maximum size in bytes of a single API response body. Larger responses fail instead of being buffered in memory. Dashboard and asset exports are streamed to temporary files and not limited. Set to 0 to disable the limit. Defaults to 268435456 (256 MiB).",
+				Optional:            true,
+			},
+			"fail_on_conflict": schema.BoolAttribute{
+				MarkdownDescription: "Whether to fail an update when the object was modified outside Terraform since it was last read, instead of overwriting it with a warning. This gives compare-and-swap semantics for objects shared with other tools or teams. Applies to `superset_user`, `superset_dataset`, `superset_role_permissions` and the dataset columns, metrics and folder resources. Defaults to `false`.",
+				Optional:            true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of simultaneous requests the provider sends to the Superset server, regardless of Terraform's `-parallelism`. Use it to avoid exhausting the server's worker pool. Set to 0 to disable the limit. Defaults to 0.",
+				Optional:            true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "The maximum average number of requests per second the provider sends to the Superset server, e.g. to stay below the rate limit of a gateway in front of it. Requests over the limit wait instead of failing. Set to 0 to disable the limit. Defaults to 0.",
+				Optional:            true,
+			},
+			"burst": schema.Int64Attribute{
+				MarkdownDescription: "The number of requests that may be sent at once above `requests_per_second`, e.g. after an idle period. Defaults to 1.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of times a request is retried when the server responds with 429 or 5xx, e.g. while it restarts or rate limits. Requests that create objects are only retried on 429 and 503, which the server did not process. Set to 0 to disable retries. Defaults to 0.",
+				Optional:            true,
+			},
+			"retry_min_delay": schema.StringAttribute{
+				MarkdownDescription: "The delay before the first retry, as a duration such as `500ms` or `2s`. It doubles with every retry, with random jitter. Defaults to `1s`.",
+				Optional:            true,
+			},
+			"retry_max_delay": schema.StringAttribute{
+				MarkdownDescription: "The maximum delay between retries, as a duration such as `30s` or `1m`. It also caps the delay requested by a `Retry-After` header. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"timeouts_defaults": schema.MapAttribute{
+				MarkdownDescription: "The default timeouts of the operations on resources, by resource type without the `superset_` prefix, as durations such as `20m`, e.g. `{ dataset = \"20m\", database = \"5m\" }`. " +
+					"Resource types that are not listed default to `5m`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"bulk_mode": schema.BoolAttribute{
+				MarkdownDescription: "Enable a fast path for provisioning thousands of users in a single apply. " +
+					"Role, group and permission lists are fetched once and cached for the whole apply, regardless of `lookup_cache_ttl`. " +
+					"`superset_user` skips the username uniqueness check and does not read the user back after creating it, so server-side normalization is only picked up on the next refresh. " +
+					"Combine it with a higher `-parallelism` for the best results. Defaults to `false`.",
+				Optional: true,
+			},
+			"lookup_cache_ttl": schema.StringAttribute{
+				MarkdownDescription: "The time the role, group and permission lists used to resolve names to IDs are cached and shared by all resources, as a duration such as `5m`. " +
+					"Writes of roles, groups, databases and datasets through the provider clear the cache, but changes made outside Terraform are only seen once it expires. `0s` disables the cache. Defaults to `5m`.",
+				Optional: true,
+			},
+			"preflight_permission_check": schema.SetAttribute{
+				MarkdownDescription: "Resource types, such as `superset_dataset`, whose required permissions are verified when the provider is configured. All missing permissions are reported in a single error before any resource is touched. Defaults to no check.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(preflightResourceTypes()...)),
+				},
+			},
 		},
 	}
 }
@@ -62,9 +275,34 @@ func (p *SupersetProvider) Schema(ctx context.Context, req provider.SchemaReques
 func (p *SupersetProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	// Check environment variables
 	serverBaseUrl := os.Getenv("SUPERSET_SERVER_BASE_URL")
+	apiBasePath := os.Getenv("SUPERSET_API_BASE_PATH")
 	username := os.Getenv("SUPERSET_USERNAME")
 	password := os.Getenv("SUPERSET_PASSWORD")
+	authProvider := os.Getenv("SUPERSET_AUTH_PROVIDER")
+	accessToken := os.Getenv("SUPERSET_ACCESS_TOKEN")
+	caCertPEM := os.Getenv("SUPERSET_CA_CERT_PEM")
+	caCertFile := os.Getenv("SUPERSET_CA_CERT_FILE")
+	insecureSkipVerify := false
+	httpProxy := ""
+	httpsProxy := ""
+	noProxy := ""
+	customHeaders := map[string]string{}
+	ingressAuth := client.IngressAuth{}
 	pageSize := client.DefaultPageSize
+	pageConcurrency := client.DefaultPageConcurrency
+	maxResponseSize := client.DefaultMaxResponseSize
+	failOnConflict := false
+	bulkMode := false
+	lookupCacheTTL := client.DefaultLookupCacheTTL
+	maxConcurrentRequests := 0
+	requestsPerSecond := 0.0
+	burst := 1
+	maxRetries := 0
+	retryMinDelay := client.DefaultRetryMinDelay
+	retryMaxDelay := client.DefaultRetryMaxDelay
+	tenant := os.Getenv("SUPERSET_TENANT")
+	tenantRouting := client.TenantRoutingHeader
+	tenantHeader := client.DefaultTenantHeader
 
 	var data SupersetProviderModel
 
@@ -74,6 +312,10 @@ func (p *SupersetProvider) Configure(ctx context.Context, req provider.Configure
 		serverBaseUrl = data.ServerBaseUrl.ValueString()
 	}
 
+	if !data.ApiBasePath.IsNull() {
+		apiBasePath = data.ApiBasePath.ValueString()
+	}
+
 	if !data.Username.IsNull() {
 		username = data.Username.ValueString()
 	}
@@ -82,10 +324,178 @@ func (p *SupersetProvider) Configure(ctx context.Context, req provider.Configure
 		password = data.Password.ValueString()
 	}
 
+	if !data.AuthProvider.IsNull() {
+		authProvider = data.AuthProvider.ValueString()
+	}
+	if authProvider == "" {
+		authProvider = client.DefaultLoginProvider
+	}
+
+	if !data.AccessToken.IsNull() {
+		accessToken = data.AccessToken.ValueString()
+	}
+
+	if !data.CACertPEM.IsNull() {
+		caCertPEM = data.CACertPEM.ValueString()
+		caCertFile = ""
+	}
+
+	if !data.CACertFile.IsNull() {
+		caCertFile = data.CACertFile.ValueString()
+		caCertPEM = ""
+	}
+
+	if !data.InsecureSkipVerify.IsNull() {
+		insecureSkipVerify = data.InsecureSkipVerify.ValueBool()
+	}
+
+	if !data.HTTPProxy.IsNull() {
+		httpProxy = data.HTTPProxy.ValueString()
+	}
+
+	if !data.HTTPSProxy.IsNull() {
+		httpsProxy = data.HTTPSProxy.ValueString()
+	}
+
+	if !data.NoProxy.IsNull() {
+		noProxy = data.NoProxy.ValueString()
+	}
+
+	if !data.CustomHeaders.IsNull() {
+		resp.Diagnostics.Append(data.CustomHeaders.ElementsAs(ctx, &customHeaders, false)...)
+	}
+
+	if data.IngressAuth != nil {
+		ingressAuth.BearerToken = data.IngressAuth.BearerToken.ValueString()
+		ingressAuth.Username = data.IngressAuth.Username.ValueString()
+		ingressAuth.Password = data.IngressAuth.Password.ValueString()
+		ingressAuth.Header = data.IngressAuth.Header.ValueString()
+		if !data.IngressAuth.Cookies.IsNull() {
+			resp.Diagnostics.Append(data.IngressAuth.Cookies.ElementsAs(ctx, &ingressAuth.Cookies, false)...)
+		}
+	}
+	// The environment variable only provides the credentials when the configuration sets none, so that it
+	// does not conflict with basic authentication or cookies.
+	if ingressAuth.BearerToken == "" && ingressAuth.Username == "" && ingressAuth.Password == "" && len(ingressAuth.Cookies) == 0 {
+		ingressAuth.BearerToken = os.Getenv("SUPERSET_INGRESS_BEARER_TOKEN")
+	}
+
 	if !data.PageSize.IsNull() {
 		pageSize = int(data.PageSize.ValueInt64())
 	}
 
+	if !data.PageConcurrency.IsNull() {
+		pageConcurrency = int(data.PageConcurrency.ValueInt64())
+	}
+
+	if !data.MaxResponseSize.IsNull() {
+		maxResponseSize = data.MaxResponseSize.ValueInt64()
+	}
+
+	if !data.FailOnConflict.IsNull() {
+		failOnConflict = data.FailOnConflict.ValueBool()
+	}
+
+	if !data.BulkMode.IsNull() {
+		bulkMode = data.BulkMode.ValueBool()
+	}
+
+	if !data.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests = int(data.MaxConcurrentRequests.ValueInt64())
+	}
+
+	if !data.RequestsPerSecond.IsNull() {
+		requestsPerSecond = data.RequestsPerSecond.ValueFloat64()
+	}
+
+	if !data.Burst.IsNull() {
+		burst = int(data.Burst.ValueInt64())
+	}
+
+	if !data.MaxRetries.IsNull() {
+		maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	if !data.RetryMinDelay.IsNull() {
+		d, err := time.ParseDuration(data.RetryMinDelay.ValueString())
+		if err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_min_delay"),
+				"Invalid Configuration",
+				"The provider cannot create the client as the retry_min_delay is not a positive duration. "+
+					"Please set the retry_min_delay attribute in the provider configuration to a duration such as 500ms or 2s. ",
+			)
+		}
+		retryMinDelay = d
+	}
+
+	timeoutsDefaults := map[string]time.Duration{}
+	if !data.TimeoutsDefaults.IsNull() {
+		var values map[string]string
+		resp.Diagnostics.Append(data.TimeoutsDefaults.ElementsAs(ctx, &values, false)...)
+		resourceTypes := p.resourceTypes(ctx)
+		for _, resourceType := range slices.Sorted(maps.Keys(values)) {
+			if !slices.Contains(resourceTypes, resourceType) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("timeouts_defaults").AtMapKey(resourceType),
+					"Invalid Configuration",
+					"The provider cannot create the client as "+resourceType+" in timeouts_defaults is not a resource type. "+
+						"Please set the timeouts_defaults attribute in the provider configuration to resource types without the superset_ prefix, such as dataset. ",
+				)
+				continue
+			}
+			d, err := time.ParseDuration(values[resourceType])
+			if err != nil || d <= 0 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("timeouts_defaults").AtMapKey(resourceType),
+					"Invalid Configuration",
+					"The provider cannot create the client as the timeout of "+resourceType+" in timeouts_defaults is not a positive duration. "+
+						"Please set the timeouts_defaults attribute in the provider configuration to durations such as 20m. ",
+				)
+				continue
+			}
+			timeoutsDefaults[resourceType] = d
+		}
+	}
+
+	if !data.LookupCacheTTL.IsNull() {
+		d, err := time.ParseDuration(data.LookupCacheTTL.ValueString())
+		if err != nil || d < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("lookup_cache_ttl"),
+				"Invalid Configuration",
+				"The provider cannot create the client as the lookup_cache_ttl is not a duration of zero or more. "+
+					"Please set the lookup_cache_ttl attribute in the provider configuration to a duration such as 5m, or 0s to disable the cache. ",
+			)
+		}
+		lookupCacheTTL = d
+	}
+
+	if !data.RetryMaxDelay.IsNull() {
+		d, err := time.ParseDuration(data.RetryMaxDelay.ValueString())
+		if err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_delay"),
+				"Invalid Configuration",
+				"The provider cannot create the client as the retry_max_delay is not a positive duration. "+
+					"Please set the retry_max_delay attribute in the provider configuration to a duration such as 30s or 1m. ",
+			)
+		}
+		retryMaxDelay = d
+	}
+
+	if !data.Tenant.IsNull() {
+		tenant = data.Tenant.ValueString()
+	}
+
+	if !data.TenantRouting.IsNull() {
+		tenantRouting = data.TenantRouting.ValueString()
+	}
+
+	if !data.TenantHeader.IsNull() {
+		tenantHeader = data.TenantHeader.ValueString()
+	}
+
 	if serverBaseUrl == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("server_base_url"),
@@ -95,24 +505,79 @@ func (p *SupersetProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
-	if username == "" {
+	if username == "" && accessToken == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("username"),
 			"Missing Configuration",
 			"The provider cannot create the client as there is no value set for the Superset username. "+
-				"Please set the username attribute in the provider configuration or the SUPERSET_USERNAME environment variable. ",
+				"Please set the username attribute in the provider configuration or the SUPERSET_USERNAME environment variable, or set an access_token. ",
 		)
 	}
 
-	if password == "" {
+	if password == "" && accessToken == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("password"),
 			"Missing Configuration",
 			"The provider cannot create the client as there is no value set for the Superset password. "+
-				"Please set the password attribute in the provider configuration or the SUPERSET_PASSWORD environment variable. ",
+				"Please set the password attribute in the provider configuration or the SUPERSET_PASSWORD environment variable, or set an access_token. ",
 		)
 	}
 
+	if caCertFile != "" {
+		pem, err := os.ReadFile(caCertFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Invalid Configuration",
+				"The provider cannot create the client as the ca_cert_file cannot be read. "+
+					"Please set the ca_cert_file attribute in the provider configuration to a readable file. Error: "+err.Error(),
+			)
+		}
+		caCertPEM = string(pem)
+	} else if caCertPEM != "" && !strings.Contains(caCertPEM, "-----BEGIN") {
+		// Base64 encoded PEM, e.g. from a variable of a remote run that cannot hold line breaks.
+		pem, err := decodeBase64Content(caCertPEM)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_pem"),
+				"Invalid Configuration",
+				"The provider cannot create the client as the ca_cert_pem is neither PEM nor base64 encoded PEM. "+
+					"Please set the ca_cert_pem attribute in the provider configuration to the PEM encoded certificates. Error: "+err.Error(),
+			)
+		}
+		caCertPEM = string(pem)
+	}
+
+	for _, proxy := range []struct {
+		attribute string
+		value     string
+	}{{"http_proxy", httpProxy}, {"https_proxy", httpsProxy}} {
+		if proxy.value == "" {
+			continue
+		}
+		if u, err := url.Parse(proxy.value); err != nil || u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(proxy.attribute),
+				"Invalid Configuration",
+				"The provider cannot create the client as the "+proxy.attribute+" is not a valid URL. "+
+					"Please set the "+proxy.attribute+" attribute in the provider configuration to a URL such as http://proxy.example.com:3128. ",
+			)
+		}
+	}
+
+	for name := range customHeaders {
+		if !httpguts.ValidHeaderFieldName(name) || http.CanonicalHeaderKey(name) == "Authorization" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("custom_headers"),
+				"Invalid Configuration",
+				"The provider cannot create the client as "+name+" is not a valid custom header name. "+
+					"Please set the custom_headers attribute in the provider configuration to headers other than Authorization. ",
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(validateIngressAuth(ingressAuth)...)
+
 	if pageSize < 0 {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("page_size"),
@@ -122,14 +587,102 @@ func (p *SupersetProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	if pageConcurrency < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("page_concurrency"),
+			"Invalid Configuration",
+			"The provider cannot create the client as the page_concurrency must be at least 1. "+
+				"Please set the page_concurrency attribute in the provider configuration to a positive value. ",
+		)
+	}
+
+	if maxResponseSize < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_response_size"),
+			"Invalid Configuration",
+			"The provider cannot create the client as the max_response_size cannot be negative. "+
+				"Please set the max_response_size attribute in the provider configuration to a non-negative value. ",
+		)
+	}
+
+	if maxConcurrentRequests < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid Configuration",
+			"The provider cannot create the client as the max_concurrent_requests cannot be negative. "+
+				"Please set the max_concurrent_requests attribute in the provider configuration to a non-negative value. ",
+		)
+	}
+
+	if requestsPerSecond < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid Configuration",
+			"The provider cannot create the client as the requests_per_second cannot be negative. "+
+				"Please set the requests_per_second attribute in the provider configuration to a non-negative value. ",
+		)
+	}
+
+	if burst < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("burst"),
+			"Invalid Configuration",
+			"The provider cannot create the client as the burst must be at least 1. "+
+				"Please set the burst attribute in the provider configuration to a positive value. ",
+		)
+	}
+
+	if maxRetries < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid Configuration",
+			"The provider cannot create the client as the max_retries cannot be negative. "+
+				"Please set the max_retries attribute in the provider configuration to a non-negative value. ",
+		)
+	}
+
+	if retryMaxDelay < retryMinDelay && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_delay"),
+			"Invalid Configuration",
+			"The provider cannot create the client as the retry_max_delay is shorter than the retry_min_delay. "+
+				"Please set the retry_max_delay attribute in the provider configuration to a longer duration. ",
+		)
+	}
+
+	if tenantRouting == client.TenantRoutingPath && strings.Contains(tenant, "/") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tenant"),
+			"Invalid Configuration",
+			"The provider cannot create the client as the tenant must be a single path segment with path routing. "+
+				"Please set the tenant attribute in the provider configuration to a value without slashes. ",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	c, err := client.NewClientWrapper(ctx,
 		serverBaseUrl,
-		client.ClientCredentials{Username: username, Password: password},
+		client.ClientCredentials{Username: username, Password: password, AccessToken: accessToken},
+		client.WithBasePath(apiBasePath),
+		client.WithTLS(caCertPEM, insecureSkipVerify),
+		client.WithProxy(httpProxy, httpsProxy, noProxy),
+		client.WithCustomHeaders(customHeaders),
+		client.WithIngressAuth(ingressAuth),
+		client.WithAuthProvider(authProvider),
 		client.WithPageSize(pageSize),
+		client.WithPageConcurrency(pageConcurrency),
+		client.WithMaxResponseSize(maxResponseSize),
+		client.WithFailOnConflict(failOnConflict),
+		client.WithBulkMode(bulkMode),
+		client.WithLookupCacheTTL(lookupCacheTTL),
+		client.WithMaxConcurrentRequests(maxConcurrentRequests),
+		client.WithRateLimit(requestsPerSecond, burst),
+		client.WithRetryPolicy(maxRetries, retryMinDelay, retryMaxDelay),
+		client.WithTenant(tenant, tenantRouting, tenantHeader),
+		client.WithTimeoutDefaults(timeoutsDefaults),
 	)
 
 	if err != nil {
@@ -142,33 +695,182 @@ func (p *SupersetProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	if !data.PreflightCheck.IsNull() && len(data.PreflightCheck.Elements()) > 0 {
+		var resourceTypes []string
+		resp.Diagnostics.Append(data.PreflightCheck.ElementsAs(ctx, &resourceTypes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(checkPreflightPermissions(ctx, c, resourceTypes)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.DataSourceData = c
 	resp.ResourceData = c
 
 	tflog.Info(ctx, "Configured Superset client", map[string]interface{}{
-		"server_base_url": serverBaseUrl,
-		"username":        username,
-		"page_size":       pageSize,
+		"server_base_url":         serverBaseUrl,
+		"api_base_path":           apiBasePath,
+		"username":                username,
+		"auth_provider":           authProvider,
+		"access_token_set":        accessToken != "",
+		"ca_cert_file":            caCertFile,
+		"insecure_skip_verify":    insecureSkipVerify,
+		"http_proxy_set":          httpProxy != "",
+		"https_proxy_set":         httpsProxy != "",
+		"no_proxy":                noProxy,
+		"custom_headers":          slices.Sorted(maps.Keys(customHeaders)),
+		"ingress_auth_set":        ingressAuth.BearerToken != "" || ingressAuth.Username != "" || len(ingressAuth.Cookies) > 0,
+		"page_size":               pageSize,
+		"page_concurrency":        pageConcurrency,
+		"max_response_size":       maxResponseSize,
+		"fail_on_conflict":        failOnConflict,
+		"bulk_mode":               bulkMode,
+		"lookup_cache_ttl":        lookupCacheTTL.String(),
+		"max_concurrent_requests": maxConcurrentRequests,
+		"requests_per_second":     requestsPerSecond,
+		"burst":                   burst,
+		"max_retries":             maxRetries,
+		"retry_min_delay":         retryMinDelay.String(),
+		"retry_max_delay":         retryMaxDelay.String(),
+		"tenant":                  tenant,
+		"tenant_routing":          tenantRouting,
+		"timeouts_defaults":       timeoutsDefaults,
 	})
 }
 
+// resourceTypes returns the types of the resources of the provider, without the superset_ prefix.
+func (p *SupersetProvider) resourceTypes(ctx context.Context) []string {
+	var names []string
+	for _, newResource := range p.Resources(ctx) {
+		var resp resource.MetadataResponse
+		newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "superset"}, &resp)
+		names = append(names, strings.TrimPrefix(resp.TypeName, "superset_"))
+	}
+	return names
+}
+
+// validateIngressAuth checks that a single kind of ingress credentials is set and that they are not sent in
+// the Authorization header the provider authenticates to Superset with.
+func validateIngressAuth(auth client.IngressAuth) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	kinds := 0
+	for _, set := range []bool{auth.BearerToken != "", auth.Username != "", len(auth.Cookies) > 0} {
+		if set {
+			kinds++
+		}
+	}
+	if kinds > 1 {
+		diags.AddAttributeError(
+			path.Root("ingress_auth"),
+			"Invalid Configuration",
+			"The provider cannot create the client as more than one kind of ingress credentials is set. "+
+				"Please set only one of bearer_token, username and cookies in the ingress_auth attribute of the provider configuration. ",
+		)
+	}
+
+	if auth.Password != "" && auth.Username == "" {
+		diags.AddAttributeError(
+			path.Root("ingress_auth").AtName("username"),
+			"Invalid Configuration",
+			"The provider cannot create the client as the ingress password is set without a username. "+
+				"Please set the username in the ingress_auth attribute of the provider configuration. ",
+		)
+	}
+
+	if auth.Header != "" && (!httpguts.ValidHeaderFieldName(auth.Header) || http.CanonicalHeaderKey(auth.Header) == "Authorization") {
+		diags.AddAttributeError(
+			path.Root("ingress_auth").AtName("header"),
+			"Invalid Configuration",
+			"The provider cannot create the client as "+auth.Header+" is not a valid ingress credentials header. "+
+				"Please set the header in the ingress_auth attribute of the provider configuration to a header other than Authorization. ",
+		)
+	}
+
+	for name := range auth.Cookies {
+		if !httpguts.ValidHeaderFieldName(name) {
+			diags.AddAttributeError(
+				path.Root("ingress_auth").AtName("cookies"),
+				"Invalid Configuration",
+				"The provider cannot create the client as "+name+" is not a valid cookie name. "+
+					"Please set the cookies in the ingress_auth attribute of the provider configuration to valid cookie names. ",
+			)
+		}
+	}
+
+	return diags
+}
+
 func (p *SupersetProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewUserResource,
+		NewUserPasswordResource,
 		NewRoleResource,
 		NewRolePermissionsResource,
+		NewPublicRolePermissionsResource,
+		NewRoleHierarchyResource,
 		NewGroupResource,
 		NewGroupRoleBindingResource,
+		NewRoleUserBindingResource,
+		NewUserRoleBindingResource,
 		NewTagResource,
+		NewTagBulkAssignmentResource,
+		NewTaggedObjectResource,
 		NewDatasetColumnsResource,
 		NewDatasetResource,
 		NewDatasetFolderResource,
+		NewDatasetRefreshResource,
+		NewDatasetOwnersResource,
 		NewDatasetMetricsResource,
+		NewDashboardCertifiedResource,
+		NewDashboardRoleAccessResource,
+		NewFavoriteResource,
+		NewCssTemplateBindingResource,
+		NewSqlLabRoleGrantsResource,
+		NewDatabaseCatalogPermissionsResource,
+		NewDatabaseResource,
+		NewChartResource,
+		NewOwnerTransferResource,
+		NewCacheWarmupResource,
+		NewAssetPromotionResource,
+		NewImportBundleResource,
+		NewAlertResource,
+		NewReportResource,
+		NewReportRecipientResource,
+		NewAnnotationLayerResource,
+		NewAnnotationResource,
 	}
 }
 
 func (p *SupersetProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewMenuDataSource,
+		NewFeatureFlagsDataSource,
+		NewLoginProvidersDataSource,
+		NewChartVizTypesDataSource,
+		NewLogsDataSource,
+		NewDatasetHclDataSource,
+		NewAssetsExportDataSource,
+		NewDashboardExportDataSource,
+		NewDashboardFilterStateDataSource,
+		NewAlertStatesDataSource,
+		NewDatabaseDataSource,
+		NewRoleDataSource,
+		NewRolesDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
+		NewUserPermissionsDataSource,
+		NewPermissionsDataSource,
+		NewTagDataSource,
+		NewTagsDataSource,
+		NewSavedQueryDataSource,
+		NewDatabaseByEngineDataSource,
+		NewObjectsByOwnerDataSource,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -3,6 +3,18 @@
 
 package provider
 
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
 // TODO: Add acceptance tests for Superset provider
 
 //import (
@@ -37,3 +49,265 @@ package provider
 //	}
 //	return string(b)
 //}
+
+// testSupersetServer serves handler as a Superset server for unit tests, which answer the requests of
+// the provider without a real server.
+func testSupersetServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// writeTestJSON writes v as the JSON body of a successful response.
+func writeTestJSON(t *testing.T, w http.ResponseWriter, v interface{}) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		t.Errorf("failed to encode response: %v", err)
+	}
+}
+
+// testProviderServer returns the provider configured against the server at serverBaseUrl, with an
+// access token so that no login is needed.
+func testProviderServer(t *testing.T, serverBaseUrl string) (tfprotov6.ProviderServer, *tfprotov6.GetProviderSchemaResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("failed to create provider server: %v", err)
+	}
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("failed to get provider schema: %v", err)
+	}
+
+	config := testDynamicValue(t, schemas.Provider, map[string]tftypes.Value{
+		"server_base_url": tftypes.NewValue(tftypes.String, serverBaseUrl),
+		"access_token":    tftypes.NewValue(tftypes.String, "test"),
+	})
+	res, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: config})
+	if err != nil {
+		t.Fatalf("failed to configure provider: %v", err)
+	}
+	checkTestDiagnostics(t, res.Diagnostics)
+
+	return server, schemas
+}
+
+// testDynamicValue returns an object of the schema with the given attributes, and all others null.
+func testDynamicValue(t *testing.T, schema *tfprotov6.Schema, values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	typ, ok := schema.ValueType().(tftypes.Object)
+	if !ok {
+		t.Fatalf("unexpected schema type: %s", schema.ValueType())
+	}
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		}
+	}
+
+	dv, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, attrs))
+	if err != nil {
+		t.Fatalf("failed to create dynamic value: %v", err)
+	}
+	return &dv
+}
+
+// testPlanResource plans the creation of a resource of typeName with the configured attributes.
+func testPlanResource(t *testing.T, server tfprotov6.ProviderServer, schemas *tfprotov6.GetProviderSchemaResponse, typeName string, values map[string]tftypes.Value) *tfprotov6.PlanResourceChangeResponse {
+	t.Helper()
+
+	schema, ok := schemas.ResourceSchemas[typeName]
+	if !ok {
+		t.Fatalf("unknown resource type %s", typeName)
+	}
+	config := testDynamicValue(t, schema, values)
+	prior := testNullDynamicValue(t, schema)
+
+	plan, err := server.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       prior,
+		ProposedNewState: config,
+		Config:           config,
+	})
+	if err != nil {
+		t.Fatalf("failed to plan %s: %v", typeName, err)
+	}
+	return plan
+}
+
+// testCreateResource plans and applies the creation of a resource of typeName with the configured
+// attributes, and returns the response of the apply.
+func testCreateResource(t *testing.T, server tfprotov6.ProviderServer, schemas *tfprotov6.GetProviderSchemaResponse, typeName string, values map[string]tftypes.Value) *tfprotov6.ApplyResourceChangeResponse {
+	t.Helper()
+
+	plan := testPlanResource(t, server, schemas, typeName, values)
+	checkTestDiagnostics(t, plan.Diagnostics)
+
+	schema := schemas.ResourceSchemas[typeName]
+	res, err := server.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     typeName,
+		PriorState:   testNullDynamicValue(t, schema),
+		PlannedState: plan.PlannedState,
+		Config:       testDynamicValue(t, schema, values),
+	})
+	if err != nil {
+		t.Fatalf("failed to apply %s: %v", typeName, err)
+	}
+	return res
+}
+
+// testUpdateResource plans and applies the update of the resource of typeName with the state of the
+// apply response prior to the configured attributes, and returns the response of the apply. As with
+// Terraform, computed attributes that are not configured keep their prior value in the proposed state,
+// and write-only attributes are only passed in the configuration.
+func testUpdateResource(t *testing.T, server tfprotov6.ProviderServer, schemas *tfprotov6.GetProviderSchemaResponse, typeName string, prior *tfprotov6.ApplyResourceChangeResponse, values map[string]tftypes.Value) *tfprotov6.ApplyResourceChangeResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	schema := schemas.ResourceSchemas[typeName]
+	priorValue, err := prior.NewState.Unmarshal(schema.ValueType())
+	if err != nil {
+		t.Fatalf("failed to decode the prior state: %v", err)
+	}
+	var priorAttrs map[string]tftypes.Value
+	if err := priorValue.As(&priorAttrs); err != nil {
+		t.Fatalf("failed to decode the prior state: %v", err)
+	}
+	proposedValues := make(map[string]tftypes.Value, len(values))
+	for _, attr := range schema.Block.Attributes {
+		switch v, ok := values[attr.Name]; {
+		case attr.WriteOnly:
+		case ok:
+			proposedValues[attr.Name] = v
+		case attr.Computed:
+			proposedValues[attr.Name] = priorAttrs[attr.Name]
+		}
+	}
+	config := testDynamicValue(t, schema, values)
+
+	plan, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       prior.NewState,
+		ProposedNewState: testDynamicValue(t, schema, proposedValues),
+		Config:           config,
+		PriorPrivate:     prior.Private,
+	})
+	if err != nil {
+		t.Fatalf("failed to plan %s: %v", typeName, err)
+	}
+	checkTestDiagnostics(t, plan.Diagnostics)
+
+	res, err := server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:       typeName,
+		PriorState:     prior.NewState,
+		PlannedState:   plan.PlannedState,
+		Config:         config,
+		PlannedPrivate: plan.PlannedPrivate,
+	})
+	if err != nil {
+		t.Fatalf("failed to apply %s: %v", typeName, err)
+	}
+	return res
+}
+
+// testNullDynamicValue returns the null object of the schema, the state of a resource that does not exist.
+func testNullDynamicValue(t *testing.T, schema *tfprotov6.Schema) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	dv, err := tfprotov6.NewDynamicValue(schema.ValueType(), tftypes.NewValue(schema.ValueType(), nil))
+	if err != nil {
+		t.Fatalf("failed to create null value: %v", err)
+	}
+	return &dv
+}
+
+// checkTestDiagnostics fails the test with the errors of diagnostics.
+func checkTestDiagnostics(t *testing.T, diagnostics []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	for _, d := range diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+}
+
+func TestProviderIngressAuthEnvironment(t *testing.T) {
+	t.Setenv("SUPERSET_INGRESS_BEARER_TOKEN", "env-token")
+
+	var proxyAuthorization, cookie string
+	server := testSupersetServer(t, func(w http.ResponseWriter, r *http.Request) {
+		proxyAuthorization = r.Header.Get("Proxy-Authorization")
+		cookie = r.Header.Get("Cookie")
+		writeTestJSON(t, w, map[string]interface{}{"result": []interface{}{}})
+	})
+
+	ctx := context.Background()
+	ingressAuthType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"bearer_token": tftypes.String,
+		"username":     tftypes.String,
+		"password":     tftypes.String,
+		"cookies":      tftypes.Map{ElementType: tftypes.String},
+		"header":       tftypes.String,
+	}}
+	cookies := tftypes.NewValue(ingressAuthType, map[string]tftypes.Value{
+		"bearer_token": tftypes.NewValue(tftypes.String, nil),
+		"username":     tftypes.NewValue(tftypes.String, nil),
+		"password":     tftypes.NewValue(tftypes.String, nil),
+		"cookies": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"_oauth2_proxy": tftypes.NewValue(tftypes.String, "session"),
+		}),
+		"header": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	for _, c := range []struct {
+		name                   string
+		ingressAuth            tftypes.Value
+		wantProxyAuthorization string
+		wantCookie             string
+	}{
+		{"no ingress_auth", tftypes.NewValue(ingressAuthType, nil), "Bearer env-token", ""},
+		{"cookies", cookies, "", "_oauth2_proxy=session"},
+	} {
+		provider, err := providerserver.NewProtocol6WithError(New("test")())()
+		if err != nil {
+			t.Fatalf("failed to create provider server: %v", err)
+		}
+		schemas, err := provider.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+		if err != nil {
+			t.Fatalf("failed to get provider schema: %v", err)
+		}
+		res, err := provider.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: testDynamicValue(t, schemas.Provider, map[string]tftypes.Value{
+			"server_base_url": tftypes.NewValue(tftypes.String, server.URL),
+			"access_token":    tftypes.NewValue(tftypes.String, "test"),
+			"ingress_auth":    c.ingressAuth,
+		})})
+		if err != nil {
+			t.Fatalf("failed to configure provider: %v", err)
+		}
+		checkTestDiagnostics(t, res.Diagnostics)
+
+		read, err := provider.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{
+			TypeName: "superset_menu",
+			Config:   testDynamicValue(t, schemas.DataSourceSchemas["superset_menu"], nil),
+		})
+		if err != nil {
+			t.Fatalf("failed to read the menu: %v", err)
+		}
+		checkTestDiagnostics(t, read.Diagnostics)
+
+		if proxyAuthorization != c.wantProxyAuthorization || cookie != c.wantCookie {
+			t.Errorf("%s: sent Proxy-Authorization %q and Cookie %q, want %q and %q", c.name, proxyAuthorization, cookie, c.wantProxyAuthorization, c.wantCookie)
+		}
+	}
+}
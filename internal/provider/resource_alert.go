@@ -0,0 +1,289 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &AlertResource{}
+var _ resource.ResourceWithImportState = &AlertResource{}
+var _ resource.ResourceWithConfigValidators = &AlertResource{}
+var _ resource.ResourceWithModifyPlan = &AlertResource{}
+var _ resource.ResourceWithValidateConfig = &AlertResource{}
+
+func NewAlertResource() resource.Resource {
+	return &AlertResource{}
+}
+
+type AlertResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type alertResourceModel struct {
+	alertBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *AlertResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert"
+}
+
+func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := reportScheduleAttributes("alert")
+	attributes["database_id"] = schema.Int64Attribute{
+		Required:            true,
+		MarkdownDescription: "The ID of the database the `sql` of the alert is run on.",
+	}
+	attributes["sql"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The SQL statement evaluated on each run. It must return a single row with a single numeric column, or `NULL`.",
+	}
+	attributes["validator_type"] = schema.StringAttribute{
+		Required: true,
+		MarkdownDescription: "When the alert is triggered. `not null` triggers when the value is not `NULL`, empty or `0`, " +
+			"`operator` triggers when the value matches the `condition`.",
+		Validators: []validator.String{
+			stringvalidator.OneOf(alertValidatorNotNull, alertValidatorOperator),
+		},
+	}
+	attributes["condition"] = schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: "The condition the value is compared with. Required when `validator_type` is `operator`.",
+		Attributes: map[string]schema.Attribute{
+			"operator": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The comparison operator. One of `<`, `<=`, `>`, `>=`, `==` or `!=`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("<", "<=", ">", ">=", "==", "!="),
+				},
+			},
+			"threshold": schema.Float64Attribute{
+				Required:            true,
+				MarkdownDescription: "The threshold the value is compared with.",
+			},
+		},
+	}
+	attributes["grace_period"] = schema.Int64Attribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             int64default.StaticInt64(14400),
+		MarkdownDescription: "How long, in seconds, the alert is not sent again after it is triggered. Defaults to `14400`.",
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+	attributes["working_timeout"] = schema.Int64Attribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             int64default.StaticInt64(3600),
+		MarkdownDescription: "How long, in seconds, a run of the alert may take before it is marked as failed. Defaults to `3600`.",
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+	attributes["timeouts"] = timeouts.Attributes(ctx, timeouts.Opts{
+		Create: true, Update: true, Delete: true,
+	})
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a superset alert, which runs a SQL query on a schedule and sends a chart or dashboard to its recipients when the result meets a condition. " +
+			"The `ALERT_REPORTS` feature flag must be enabled.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *AlertResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("chart_id"), path.MatchRoot("dashboard_id")),
+	}
+}
+
+func (r *AlertResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var validatorType types.String
+	var condition types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("validator_type"), &validatorType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("condition"), &condition)...)
+	if resp.Diagnostics.HasError() || validatorType.IsUnknown() || condition.IsUnknown() {
+		return
+	}
+
+	switch validatorType.ValueString() {
+	case alertValidatorOperator:
+		if condition.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("condition"), "Missing Condition",
+				"condition must be set when validator_type is \"operator\".")
+		}
+	case alertValidatorNotNull:
+		if !condition.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("condition"), "Unexpected Condition",
+				"condition can only be set when validator_type is \"operator\".")
+		}
+	}
+}
+
+func (r *AlertResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyReportSchedulePlan(ctx, req, resp)
+}
+
+func (r *AlertResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+func (r *AlertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data alertResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "alert"))
+	defer cancel()
+
+	postData, diags := data.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	postData.CreationMethod = "alerts_reports"
+
+	alert, err := r.client.CreateReportSchedule(ctx, postData)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create alert, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.updateState(ctx, alert)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data alertResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "alert"))
+	defer cancel()
+
+	alert, err := r.client.GetReportSchedule(ctx, int(data.Id.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert with ID %d: %s", data.Id.ValueInt64(), err))
+		return
+	}
+	if alert.Type != client.ReportScheduleTypeAlert {
+		resp.Diagnostics.AddError("Invalid Alert", fmt.Sprintf("Report schedule with ID %d is a report, use the superset_report resource to manage it", alert.Id))
+		return
+	}
+
+	resp.Diagnostics.Append(data.updateState(ctx, alert)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state alertResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "alert"))
+	defer cancel()
+
+	putData, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alert, err := r.client.UpdateReportSchedule(ctx, int(state.Id.ValueInt64()), putData)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update alert with ID %d: %s", state.Id.ValueInt64(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.updateState(ctx, alert)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state alertResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "alert"))
+	defer cancel()
+
+	err := r.client.DeleteReportSchedule(ctx, int(state.Id.ValueInt64()))
+	if client.IsNotFound(err) {
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete alert with ID %d: %s", state.Id.ValueInt64(), err))
+		return
+	}
+}
+
+func (r *AlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected numeric ID, got %q: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.State.SetAttribute(ctx, path.Root("id"), id)
+}
@@ -0,0 +1,235 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &AnnotationResource{}
+var _ resource.ResourceWithImportState = &AnnotationResource{}
+
+// annotationTimeRegexp matches times in annotationTimeLayout.
+var annotationTimeRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}$`)
+
+func NewAnnotationResource() resource.Resource {
+	return &AnnotationResource{}
+}
+
+type AnnotationResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type annotationResourceModel struct {
+	annotationBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *AnnotationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_annotation"
+}
+
+func (r *AnnotationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	timeValidators := []validator.String{
+		stringvalidator.RegexMatches(annotationTimeRegexp, "must be a time in the format YYYY-MM-DDTHH:MM:SS"),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage an annotation of a superset annotation layer.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the annotation.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"layer_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the annotation layer of the annotation.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"short_descr": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The short description of the annotation, shown as its label.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 500),
+				},
+			},
+			"long_descr": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The long description of the annotation.",
+			},
+			"start_dttm": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The start time of the annotation in the format `YYYY-MM-DDTHH:MM:SS`, e.g. `2026-10-01T09:00:00`.",
+				Validators:          timeValidators,
+			},
+			"end_dttm": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The end time of the annotation in the format `YYYY-MM-DDTHH:MM:SS`. Use the `start_dttm` for an annotation marking a point in time.",
+				Validators:          timeValidators,
+			},
+			"json_metadata": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Additional metadata of the annotation as a JSON string.",
+				PlanModifiers: []planmodifier.String{
+					summarizeJSONChanges(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *AnnotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+func (r *AnnotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data annotationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "annotation"))
+	defer cancel()
+
+	a, err := r.client.CreateAnnotation(ctx, int(data.LayerId.ValueInt64()), data.postRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create annotation in annotation layer with ID %d, got error: %s", data.LayerId.ValueInt64(), err))
+		return
+	}
+
+	data.updateState(a)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnnotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data annotationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "annotation"))
+	defer cancel()
+
+	a, err := r.client.GetAnnotation(ctx, int(data.LayerId.ValueInt64()), int(data.Id.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read annotation with ID %d: %s", data.Id.ValueInt64(), err))
+		return
+	}
+
+	data.updateState(a)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnnotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state annotationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "annotation"))
+	defer cancel()
+
+	a, err := r.client.UpdateAnnotation(ctx, int(state.LayerId.ValueInt64()), int(state.Id.ValueInt64()), plan.putRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update annotation with ID %d: %s", state.Id.ValueInt64(), err))
+		return
+	}
+
+	plan.updateState(a)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AnnotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state annotationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "annotation"))
+	defer cancel()
+
+	err := r.client.DeleteAnnotation(ctx, int(state.LayerId.ValueInt64()), int(state.Id.ValueInt64()))
+	if client.IsNotFound(err) {
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete annotation with ID %d: %s", state.Id.ValueInt64(), err))
+		return
+	}
+}
+
+func (r *AnnotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	layer, annotation, ok := strings.Cut(req.ID, "/")
+	layerId, layerErr := strconv.ParseInt(layer, 10, 64)
+	id, idErr := strconv.ParseInt(annotation, 10, 64)
+	if !ok || layerErr != nil || idErr != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected import ID in the format <layer_id>/<annotation_id>, got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("layer_id"), layerId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
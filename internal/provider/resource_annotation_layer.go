@@ -0,0 +1,199 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &AnnotationLayerResource{}
+var _ resource.ResourceWithImportState = &AnnotationLayerResource{}
+
+func NewAnnotationLayerResource() resource.Resource {
+	return &AnnotationLayerResource{}
+}
+
+type AnnotationLayerResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type annotationLayerResourceModel struct {
+	annotationLayerBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *AnnotationLayerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_annotation_layer"
+}
+
+func (r *AnnotationLayerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a superset annotation layer. Charts display the annotations of a layer, e.g. release markers, on top of their data.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the annotation layer.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the annotation layer.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 250),
+				},
+			},
+			"descr": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The description of the annotation layer.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *AnnotationLayerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+func (r *AnnotationLayerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data annotationLayerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "annotation_layer"))
+	defer cancel()
+
+	l, err := r.client.CreateAnnotationLayer(ctx, data.request())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create annotation layer, got error: %s", err))
+		return
+	}
+
+	data.updateState(l)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnnotationLayerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data annotationLayerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "annotation_layer"))
+	defer cancel()
+
+	l, err := r.client.GetAnnotationLayer(ctx, int(data.Id.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read annotation layer with ID %d: %s", data.Id.ValueInt64(), err))
+		return
+	}
+
+	data.updateState(l)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnnotationLayerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state annotationLayerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "annotation_layer"))
+	defer cancel()
+
+	l, err := r.client.UpdateAnnotationLayer(ctx, int(state.Id.ValueInt64()), plan.request())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update annotation layer with ID %d: %s", state.Id.ValueInt64(), err))
+		return
+	}
+
+	plan.updateState(l)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AnnotationLayerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state annotationLayerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "annotation_layer"))
+	defer cancel()
+
+	err := r.client.DeleteAnnotationLayer(ctx, int(state.Id.ValueInt64()))
+	if client.IsNotFound(err) {
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete annotation layer with ID %d: %s", state.Id.ValueInt64(), err))
+		return
+	}
+}
+
+func (r *AnnotationLayerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected numeric ID, got %q: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.State.SetAttribute(ctx, path.Root("id"), id)
+}
@@ -0,0 +1,237 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &AssetPromotionResource{}
+var _ resource.ResourceWithConfigValidators = &AssetPromotionResource{}
+var _ resource.ResourceWithModifyPlan = &AssetPromotionResource{}
+
+func NewAssetPromotionResource() resource.Resource {
+	return &AssetPromotionResource{}
+}
+
+type AssetPromotionResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type assetPromotionResourceModel struct {
+	assetPromotionBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *AssetPromotionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_promotion"
+}
+
+func (r *AssetPromotionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Import an asset bundle exported from another Superset environment, e.g. with the `superset_assets_export` data source of a provider configured for the source environment. " +
+			"The UUID mapping and database overrides are applied to the bundle before it is imported, and the bundle is normalized " +
+			"so that exporting the same assets again does not trigger a new import. " +
+			"The bundle is imported again whenever its content or the overrides change. Assets with the same UUID in the target environment are overwritten. " +
+			"Destroying the resource does not delete the imported assets.",
+
+		Attributes: map[string]schema.Attribute{
+			"bundle_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The path of the bundle to import: a ZIP file as exported by Superset, or a directory containing the `metadata.yaml` of the extracted export. Conflicts with `bundle_base64`.",
+			},
+			"bundle_base64": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The ZIP bundle to import, base64 encoded, e.g. the `content_base64` of `superset_assets_export`. " +
+					"Unlike `bundle_path`, it does not require the bundle on the filesystem of the apply, e.g. on remote Terraform Cloud agents. Conflicts with `bundle_path`.",
+			},
+			"uuid_mapping": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "A map of source UUIDs to the UUIDs to use in the target environment. Every occurrence of a source UUID in the bundle is replaced, so references between assets follow the mapping.",
+			},
+			"database_overrides": schema.MapNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Overrides of the database connections of the bundle, keyed by the database name in the bundle. Every key must match a database of the bundle.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"database_name": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The name of the database in the target environment.",
+						},
+						"sqlalchemy_uri": schema.StringAttribute{
+							Optional:            true,
+							Sensitive:           true,
+							MarkdownDescription: "The SQLAlchemy URI of the database in the target environment.",
+						},
+						"password": schema.StringAttribute{
+							Optional:            true,
+							Sensitive:           true,
+							MarkdownDescription: "The password of the database. Exported bundles do not contain passwords, so it is required to import databases using password authentication.",
+						},
+					},
+				},
+			},
+			"bundle_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 checksum of the bundle imported, after the overrides are applied.",
+			},
+			"assets": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The UUIDs of the imported assets, keyed by their file in the bundle, e.g. `dashboards/Sales_1.yaml`.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *AssetPromotionResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("bundle_path"), path.MatchRoot("bundle_base64")),
+	}
+}
+
+func (r *AssetPromotionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan computes the checksum of the rewritten bundle, so that a change of the bundle content
+// is planned as an update.
+func (r *AssetPromotionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan assetPromotionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !plan.isKnown(ctx) {
+		return
+	}
+
+	if plan.BundleBase64.IsNull() && !bundleExists(plan.BundlePath.ValueString()) {
+		// The bundle may be created by another resource during the apply.
+		tflog.Debug(ctx, "Bundle does not exist yet", map[string]interface{}{
+			"bundle_path": plan.BundlePath.ValueString(),
+		})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("bundle_sha256"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("assets"), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	bundle, diags := plan.bundle(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.updateState(ctx, bundle)...)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *AssetPromotionResource) importBundle(ctx context.Context, data *assetPromotionResourceModel) diag.Diagnostics {
+	bundle, diags := data.bundle(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Debug(ctx, "Importing asset bundle", map[string]interface{}{
+		"source": data.source(),
+		"sha256": bundle.sha256(),
+		"assets": len(bundle.Assets),
+	})
+	if err := r.client.ImportAssets(ctx, bundle.Data, bundle.Passwords); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to import bundle '%s': %s", data.source(), err))
+		return diags
+	}
+
+	diags.Append(data.updateState(ctx, bundle)...)
+	return diags
+}
+
+func (r *AssetPromotionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data assetPromotionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "asset_promotion"))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.importBundle(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AssetPromotionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The import has no server side identity to refresh. Changes of the bundle are detected at plan time.
+	var data assetPromotionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AssetPromotionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan assetPromotionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "asset_promotion"))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.importBundle(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AssetPromotionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The imported assets are left in place, the resource is only removed from the state.
+}
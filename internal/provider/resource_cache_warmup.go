@@ -0,0 +1,270 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &CacheWarmupResource{}
+var _ resource.ResourceWithConfigValidators = &CacheWarmupResource{}
+
+func NewCacheWarmupResource() resource.Resource {
+	return &CacheWarmupResource{}
+}
+
+type CacheWarmupResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type cacheWarmupResourceModel struct {
+	cacheWarmupBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *CacheWarmupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cache_warmup"
+}
+
+func (r *CacheWarmupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Warm up the cache of dashboards, charts and datasets by running their queries, so that dashboards load fast after a data refresh. " +
+			"The warm-up runs once when the resource is created, and again whenever an argument or `triggers` changes, e.g. with the ID of the latest run of the refresh job. " +
+			"For warm-ups on a time schedule, configure the `CACHE_WARMUP` Celery beat task of the server instead. " +
+			"Destroying the resource does not clear the cache.",
+
+		Attributes: map[string]schema.Attribute{
+			"dashboard_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the dashboards whose charts are warmed up, with the default filters of the dashboard.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"chart_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the charts to warm up, without dashboard filters.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"datasets": schema.SetNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The datasets whose charts are all warmed up.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"database_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the database of the dataset.",
+						},
+						"table_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The table name of the dataset.",
+						},
+					},
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that run the warm-up again when they change, e.g. the time of the last data refresh.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether a chart whose query fails fails the apply. Otherwise the failure is reported as a warning and listed in `failed_chart_ids`. Defaults to `false`.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"warmed_chart_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the charts whose cache was warmed up.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"failed_chart_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the charts whose query failed.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *CacheWarmupResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(path.MatchRoot("dashboard_ids"), path.MatchRoot("chart_ids"), path.MatchRoot("datasets")),
+	}
+}
+
+func (r *CacheWarmupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+func (r *CacheWarmupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data cacheWarmupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var datasets []cacheWarmupDataset
+	if !data.Datasets.IsNull() {
+		resp.Diagnostics.Append(data.Datasets.ElementsAs(ctx, &datasets, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "cache_warmup"))
+	defer cancel()
+
+	results := newCacheWarmupResults()
+	for _, dashboardId := range int64SetToInts(data.DashboardIds) {
+		chartIds, err := r.client.ListDashboardChartIds(ctx, dashboardId)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list the charts of dashboard ID %d: %s", dashboardId, err))
+			return
+		}
+		for _, chartId := range chartIds {
+			warmed, err := r.client.WarmUpChartCache(ctx, chartId, dashboardId)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to warm up chart ID %d of dashboard ID %d: %s", chartId, dashboardId, err))
+				return
+			}
+			results.add(warmed)
+		}
+	}
+	for _, chartId := range int64SetToInts(data.ChartIds) {
+		warmed, err := r.client.WarmUpChartCache(ctx, chartId, 0)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to warm up chart ID %d: %s", chartId, err))
+			return
+		}
+		results.add(warmed)
+	}
+	for _, d := range datasets {
+		warmed, err := r.client.WarmUpDatasetCache(ctx, d.DatabaseName.ValueString(), d.TableName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to warm up dataset %s of database %s: %s", d.TableName.ValueString(), d.DatabaseName.ValueString(), err))
+			return
+		}
+		results.add(warmed)
+	}
+
+	tflog.Info(ctx, "Warmed up cache", map[string]interface{}{
+		"warmed": len(results.warmed),
+		"failed": len(results.failures),
+	})
+	if failed := results.failedChartIds(); len(failed) > 0 {
+		var details []string
+		for _, id := range failed {
+			details = append(details, fmt.Sprintf("chart ID %d: %s", id, results.failures[id]))
+		}
+		summary, detail := "Cache Warm-Up Failed", fmt.Sprintf("The queries of %d chart(s) failed:\n  - %s", len(failed), strings.Join(details, "\n  - "))
+		if data.FailOnError.ValueBool() {
+			resp.Diagnostics.AddError(summary, detail)
+			return
+		}
+		resp.Diagnostics.AddWarning(summary, detail)
+	}
+
+	data.setResults(ctx, results)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CacheWarmupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The warm-up is a one-off action, so there is nothing to refresh.
+	var data cacheWarmupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CacheWarmupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument requires replacement, so only the timeouts can change here.
+	var plan, state cacheWarmupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *CacheWarmupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The cache is not cleared, the resource is only removed from the state.
+}
@@ -0,0 +1,432 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+	"github.com/oapi-codegen/nullable"
+)
+
+var _ resource.Resource = &ChartResource{}
+var _ resource.ResourceWithImportState = &ChartResource{}
+var _ resource.ResourceWithConfigValidators = &ChartResource{}
+var _ resource.ResourceWithModifyPlan = &ChartResource{}
+
+func NewChartResource() resource.Resource {
+	return &ChartResource{}
+}
+
+type ChartResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type chartResourceModel struct {
+	chartBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ChartResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chart"
+}
+
+func (r *ChartResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a superset chart",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the chart.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"slice_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the chart.",
+			},
+			"viz_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The visualization type of the chart, e.g. `table`, `echarts_timeseries_line` or `big_number_total`.",
+			},
+			"dataset_id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the dataset the chart queries. Exactly one of `dataset_id` and `dataset_name` must be set.",
+				PlanModifiers: []planmodifier.Int64{
+					useStateForUnknownUnlessChanged(path.Root("dataset_name"), path.Root("dataset_schema"), path.Root("database_name")),
+				},
+			},
+			"dataset_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the dataset the chart queries. Use `dataset_schema` and `database_name` when the name is not unique. The name is resolved to `dataset_id` when planning, so the plan shows when it refers to another dataset.",
+			},
+			"dataset_schema": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The schema of the dataset named by `dataset_name`.",
+			},
+			"database_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The database name of the dataset named by `dataset_name`.",
+			},
+			"params": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The form data of the chart as a JSON string, as saved by the explore view. A change lists the changed keys of the form data as a warning.",
+				PlanModifiers: []planmodifier.String{
+					summarizeJSONChanges(),
+				},
+			},
+			"query_context": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The query context of the chart as a JSON string. It is used by the chart data API, e.g. for reports and alerts.",
+				PlanModifiers: []planmodifier.String{
+					summarizeJSONChanges(),
+				},
+			},
+			"owners": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The user IDs of the owners of the chart. Defaults to the provider account.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dashboards": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the dashboards the chart belongs to. When not set, dashboard membership is left as is.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cache_timeout": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The cache timeout of the chart in seconds. Defaults to the cache timeout of the dataset.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The description of the chart.",
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL of the chart in the explore view.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ChartResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("dataset_id"), path.MatchRoot("dataset_name")),
+		resourcevalidator.Conflicting(path.MatchRoot("dataset_id"), path.MatchRoot("dataset_schema")),
+		resourcevalidator.Conflicting(path.MatchRoot("dataset_id"), path.MatchRoot("database_name")),
+	}
+}
+
+func (r *ChartResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// resolveDatasetId returns the configured dataset ID, or looks up the dataset by its qualified name.
+func (r *ChartResource) resolveDatasetId(ctx context.Context, data *chartResourceModel) (int, error) {
+	if !data.DatasetId.IsNull() && !data.DatasetId.IsUnknown() {
+		return int(data.DatasetId.ValueInt64()), nil
+	}
+
+	dataset, err := r.client.FindQualifiedDataset(ctx, data.datasetQualifier())
+	if err != nil {
+		return 0, err
+	}
+	return dataset.Id, nil
+}
+
+// ModifyPlan resolves dataset_name to the dataset ID, so that the plan shows a change of dataset_id when
+// the name now refers to another dataset. The ID is unknown when the dataset does not exist yet, e.g.
+// when it is created in the same apply.
+func (r *ChartResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan chartResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DatasetName.IsNull() {
+		return
+	}
+	if plan.DatasetName.IsUnknown() || plan.DatasetSchema.IsUnknown() || plan.DatabaseName.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dataset_id"), types.Int64Unknown())...)
+		return
+	}
+
+	dataset, err := r.client.FindQualifiedDataset(ctx, plan.datasetQualifier())
+	if client.IsNotFound(err) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dataset_id"), types.Int64Unknown())...)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("dataset_name"), "Client Error", fmt.Sprintf("Unable to find dataset %s: %s", plan.datasetQualifier(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dataset_id"), int64(dataset.Id))...)
+}
+
+func (r *ChartResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data chartResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "chart"))
+	defer cancel()
+
+	datasetId, err := r.resolveDatasetId(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find dataset '%s': %s", data.datasetQualifier(), err))
+		return
+	}
+
+	postData := client.ChartRestApiPost{
+		SliceName:      data.SliceName.ValueString(),
+		VizType:        data.VizType.ValueString(),
+		DatasourceId:   datasetId,
+		DatasourceType: client.ChartRestApiPostDatasourceTypeTable,
+	}
+	if !data.Params.IsNull() {
+		postData.Params = nullable.NewNullableWithValue(data.Params.ValueString())
+	}
+	if !data.QueryContext.IsNull() {
+		postData.QueryContext = nullable.NewNullableWithValue(data.QueryContext.ValueString())
+	}
+	if !data.Description.IsNull() {
+		postData.Description = nullable.NewNullableWithValue(data.Description.ValueString())
+	}
+	if !data.CacheTimeout.IsNull() {
+		postData.CacheTimeout = nullable.NewNullableWithValue(int(data.CacheTimeout.ValueInt64()))
+	}
+	if !data.Owners.IsNull() && !data.Owners.IsUnknown() {
+		postData.Owners = int64SetToInts(data.Owners)
+	}
+	if !data.Dashboards.IsNull() && !data.Dashboards.IsUnknown() {
+		postData.Dashboards = int64SetToInts(data.Dashboards)
+	}
+
+	c, err := r.client.CreateChart(ctx, postData)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create chart, got error: %s", err))
+		return
+	}
+
+	data.updateState(c)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChartResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data chartResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "chart"))
+	defer cancel()
+
+	c, err := r.client.GetChart(ctx, int(data.Id.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read chart with ID %d: %s", data.Id.ValueInt64(), err))
+		return
+	}
+
+	data.updateState(c)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChartResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state chartResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "chart"))
+	defer cancel()
+
+	datasetId, err := r.resolveDatasetId(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find dataset '%s': %s", plan.datasetQualifier(), err))
+		return
+	}
+
+	putData := client.ChartRestApiPut{
+		SliceName:      nullable.NewNullableWithValue(plan.SliceName.ValueString()),
+		VizType:        nullable.NewNullableWithValue(plan.VizType.ValueString()),
+		DatasourceId:   nullable.NewNullableWithValue(datasetId),
+		DatasourceType: nullable.NewNullableWithValue(client.ChartRestApiPutDatasourceTypeTable),
+		Params:         nullable.NewNullNullable[string](),
+		QueryContext:   nullable.NewNullNullable[string](),
+		Description:    nullable.NewNullNullable[string](),
+		CacheTimeout:   nullable.NewNullNullable[int](),
+	}
+	if !plan.Params.IsNull() {
+		putData.Params = nullable.NewNullableWithValue(plan.Params.ValueString())
+	}
+	if !plan.QueryContext.IsNull() {
+		putData.QueryContext = nullable.NewNullableWithValue(plan.QueryContext.ValueString())
+	}
+	if !plan.Description.IsNull() {
+		putData.Description = nullable.NewNullableWithValue(plan.Description.ValueString())
+	}
+	if !plan.CacheTimeout.IsNull() {
+		putData.CacheTimeout = nullable.NewNullableWithValue(int(plan.CacheTimeout.ValueInt64()))
+	}
+	if !plan.Owners.IsNull() && !plan.Owners.IsUnknown() {
+		putData.Owners = int64SetToInts(plan.Owners)
+	}
+	if !plan.Dashboards.IsNull() && !plan.Dashboards.IsUnknown() {
+		putData.Dashboards = int64SetToInts(plan.Dashboards)
+	}
+
+	c, err := r.client.UpdateChart(ctx, int(state.Id.ValueInt64()), putData)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update chart with ID %d: %s", state.Id.ValueInt64(), err))
+		return
+	}
+
+	plan.updateState(c)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChartResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state chartResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "chart"))
+	defer cancel()
+
+	err := r.client.DeleteChart(ctx, int(state.Id.ValueInt64()))
+	if client.IsNotFound(err) {
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete chart with ID %d: %s", state.Id.ValueInt64(), err))
+		return
+	}
+}
+
+func (r *ChartResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected numeric ID, got %q: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.State.SetAttribute(ctx, path.Root("id"), id)
+}
+
+// useStateForUnknownUnlessChangedModifier keeps the prior state of a computed attribute that is derived
+// from other attributes, as long as none of them change.
+type useStateForUnknownUnlessChangedModifier struct {
+	paths []path.Path
+}
+
+func useStateForUnknownUnlessChanged(paths ...path.Path) planmodifier.Int64 {
+	return useStateForUnknownUnlessChangedModifier{paths: paths}
+}
+
+func (m useStateForUnknownUnlessChangedModifier) Description(ctx context.Context) string {
+	return "Keeps the prior state value unless the attributes it is derived from change."
+}
+
+func (m useStateForUnknownUnlessChangedModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateForUnknownUnlessChangedModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, p := range m.paths {
+		var planValue, stateValue types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, p, &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, p, &stateValue)...)
+		if resp.Diagnostics.HasError() || !planValue.Equal(stateValue) {
+			return
+		}
+	}
+
+	resp.PlanValue = req.StateValue
+}
@@ -0,0 +1,55 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestChartPlanResolvesDatasetName(t *testing.T) {
+	server := testSupersetServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method+" "+r.URL.Path != "GET /api/v1/dataset/" {
+			t.Logf("unexpected request %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+			return
+		}
+		// Only the dataset orders exists.
+		if !strings.Contains(r.URL.Query().Get("q"), "orders") {
+			writeTestJSON(t, w, map[string]interface{}{"count": 0, "result": []interface{}{}})
+			return
+		}
+		writeTestJSON(t, w, map[string]interface{}{"count": 1, "result": []map[string]interface{}{
+			{"id": 7, "table_name": "orders", "schema": "public", "database": map[string]interface{}{"id": 1, "database_name": "examples"}},
+		}})
+	})
+
+	provider, schemas := testProviderServer(t, server.URL)
+	for name, want := range map[string]tftypes.Value{
+		"orders":  tftypes.NewValue(tftypes.Number, 7),
+		"missing": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+	} {
+		plan := testPlanResource(t, provider, schemas, "superset_chart", map[string]tftypes.Value{
+			"slice_name":   tftypes.NewValue(tftypes.String, "Orders"),
+			"viz_type":     tftypes.NewValue(tftypes.String, "table"),
+			"dataset_name": tftypes.NewValue(tftypes.String, name),
+		})
+		checkTestDiagnostics(t, plan.Diagnostics)
+
+		planned, err := plan.PlannedState.Unmarshal(schemas.ResourceSchemas["superset_chart"].ValueType())
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, _, err := tftypes.WalkAttributePath(planned, tftypes.NewAttributePath().WithAttributeName("dataset_id"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gotValue, ok := got.(tftypes.Value); !ok || !gotValue.Equal(want) {
+			t.Errorf("planned dataset_id for %s = %v, want %v", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+	"github.com/oapi-codegen/nullable"
+)
+
+var _ resource.Resource = &CssTemplateBindingResource{}
+var _ resource.ResourceWithImportState = &CssTemplateBindingResource{}
+var _ resource.ResourceWithModifyPlan = &CssTemplateBindingResource{}
+
+func NewCssTemplateBindingResource() resource.Resource {
+	return &CssTemplateBindingResource{}
+}
+
+type CssTemplateBindingResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type cssTemplateBindingResourceModel struct {
+	cssTemplateBindingBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *CssTemplateBindingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_css_template_binding"
+}
+
+func (r *CssTemplateBindingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Set the CSS of an existing superset dashboard from a named CSS template. " +
+			"The `{{ name }}` placeholders of the template are replaced with the `variables`, so styling stays centralized in the template while the dashboard itself is managed elsewhere. " +
+			"Changes to the template are applied on the next apply. Destroying this resource clears the CSS of the dashboard.",
+
+		Attributes: map[string]schema.Attribute{
+			"dashboard_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the dashboard to style.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"template_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the CSS template.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"template_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the CSS template.",
+			},
+			"variables": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The values of the `{{ name }}` placeholders of the template, keyed by name. Every placeholder of the template must have a value.",
+			},
+			"css": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The rendered CSS of the dashboard.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *CssTemplateBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// render returns the template of the model and its CSS with the variables of the model.
+func (r *CssTemplateBindingResource) render(ctx context.Context, model *cssTemplateBindingBaseModel) (*client.CssTemplateRestApiGetList, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	template, err := r.client.FindCssTemplate(ctx, model.TemplateName.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("template_name"), "Client Error", fmt.Sprintf("Unable to find css template with name %s: %s", model.TemplateName.ValueString(), err))
+		return nil, "", diags
+	}
+
+	variables, d := model.variables(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, "", diags
+	}
+
+	css, missing := renderCssTemplate(nullableStringValue(template.Css).ValueString(), variables)
+	if len(missing) > 0 {
+		diags.AddAttributeError(
+			path.Root("variables"),
+			"Missing CSS Template Variables",
+			fmt.Sprintf("The css template %s has placeholders without a value in variables: %s", model.TemplateName.ValueString(), strings.Join(missing, ", ")),
+		)
+		return nil, "", diags
+	}
+
+	return template, css, diags
+}
+
+// ModifyPlan renders the template, so that changes of the template made outside Terraform and drift of
+// the dashboard CSS are shown in the plan.
+func (r *CssTemplateBindingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan cssTemplateBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.TemplateName.IsUnknown() || plan.Variables.IsUnknown() {
+		return
+	}
+
+	template, css, diags := r.render(ctx, &plan.cssTemplateBindingBaseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("template_id"), int64(template.Id))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("css"), css)...)
+}
+
+// apply sets the CSS of the dashboard of the model to its rendered template.
+func (r *CssTemplateBindingResource) apply(ctx context.Context, model *cssTemplateBindingBaseModel) diag.Diagnostics {
+	template, css, diags := r.render(ctx, model)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := r.client.UpdateDashboard(ctx, int(model.DashboardId.ValueInt64()), client.DashboardRestApiPut{
+		Css: nullable.NewNullableWithValue(css),
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set css of dashboard with ID %d: %s", model.DashboardId.ValueInt64(), err))
+		return diags
+	}
+
+	model.TemplateId = types.Int64Value(int64(template.Id))
+	model.Css = types.StringValue(css)
+	return diags
+}
+
+func (r *CssTemplateBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data cssTemplateBindingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "css_template_binding"))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &data.cssTemplateBindingBaseModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CssTemplateBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data cssTemplateBindingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "css_template_binding"))
+	defer cancel()
+
+	d, err := r.client.GetDashboard(ctx, int(data.DashboardId.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dashboard with ID %d: %s", data.DashboardId.ValueInt64(), err))
+		return
+	}
+
+	data.Css = types.StringValue(d.Css)
+	if data.TemplateId.IsNull() {
+		// Imported, so the template is only known from the import ID.
+		if template, err := r.client.FindCssTemplate(ctx, data.TemplateName.ValueString()); err == nil {
+			data.TemplateId = types.Int64Value(int64(template.Id))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CssTemplateBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan cssTemplateBindingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "css_template_binding"))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan.cssTemplateBindingBaseModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CssTemplateBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state cssTemplateBindingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "css_template_binding"))
+	defer cancel()
+
+	_, err := r.client.UpdateDashboard(ctx, int(state.DashboardId.ValueInt64()), client.DashboardRestApiPut{
+		Css: nullable.NewNullableWithValue(""),
+	})
+	if client.IsNotFound(err) {
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear css of dashboard with ID %d: %s", state.DashboardId.ValueInt64(), err))
+		return
+	}
+}
+
+func (r *CssTemplateBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	dashboardId, templateName, ok := strings.Cut(req.ID, "/")
+	if !ok || templateName == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected import ID in the format <dashboard_id>/<template_name>, got %q", req.ID),
+		)
+		return
+	}
+
+	id, err := strconv.ParseInt(dashboardId, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected numeric dashboard ID, got %q: %s", dashboardId, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dashboard_id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_name"), templateName)...)
+}
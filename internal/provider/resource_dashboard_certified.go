@@ -0,0 +1,229 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+	"github.com/oapi-codegen/nullable"
+)
+
+var _ resource.Resource = &DashboardCertifiedResource{}
+var _ resource.ResourceWithImportState = &DashboardCertifiedResource{}
+
+func NewDashboardCertifiedResource() resource.Resource {
+	return &DashboardCertifiedResource{}
+}
+
+type DashboardCertifiedResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type dashboardCertifiedResourceModel struct {
+	dashboardCertifiedBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DashboardCertifiedResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_certified"
+}
+
+func (r *DashboardCertifiedResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the certification of an existing superset dashboard. " +
+			"The dashboard itself is not managed by this resource, so certification can be managed independently of the dashboard authorship. " +
+			"Destroying this resource removes the certification from the dashboard.",
+
+		Attributes: map[string]schema.Attribute{
+			"dashboard_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the dashboard to certify.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"dashboard_title": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The title of the dashboard.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certified_by": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The person or group that has certified the dashboard.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"certification_details": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The details of the certification.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *DashboardCertifiedResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+func (r *DashboardCertifiedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data dashboardCertifiedResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dashboard_certified"))
+	defer cancel()
+
+	d, err := r.client.UpdateDashboard(ctx, int(data.DashboardId.ValueInt64()), client.DashboardRestApiPut{
+		CertifiedBy:          nullable.NewNullableWithValue(data.CertifiedBy.ValueString()),
+		CertificationDetails: nullable.NewNullableWithValue(data.CertificationDetails.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to certify dashboard with ID %d: %s", data.DashboardId.ValueInt64(), err))
+		return
+	}
+
+	data.updateState(d)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardCertifiedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dashboardCertifiedResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dashboard_certified"))
+	defer cancel()
+
+	d, err := r.client.GetDashboard(ctx, int(data.DashboardId.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dashboard with ID %d: %s", data.DashboardId.ValueInt64(), err))
+		return
+	}
+
+	if d.CertifiedBy == "" {
+		tflog.Debug(ctx, "Dashboard certification was removed outside Terraform", map[string]interface{}{
+			"dashboard_id": d.Id,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.updateState(d)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardCertifiedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dashboardCertifiedResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dashboard_certified"))
+	defer cancel()
+
+	d, err := r.client.UpdateDashboard(ctx, int(plan.DashboardId.ValueInt64()), client.DashboardRestApiPut{
+		CertifiedBy:          nullable.NewNullableWithValue(plan.CertifiedBy.ValueString()),
+		CertificationDetails: nullable.NewNullableWithValue(plan.CertificationDetails.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update certification of dashboard with ID %d: %s", plan.DashboardId.ValueInt64(), err))
+		return
+	}
+
+	plan.updateState(d)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DashboardCertifiedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dashboardCertifiedResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dashboard_certified"))
+	defer cancel()
+
+	_, err := r.client.UpdateDashboard(ctx, int(state.DashboardId.ValueInt64()), client.DashboardRestApiPut{
+		CertifiedBy:          nullable.NewNullNullable[string](),
+		CertificationDetails: nullable.NewNullNullable[string](),
+	})
+	if client.IsNotFound(err) {
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove certification of dashboard with ID %d: %s", state.DashboardId.ValueInt64(), err))
+		return
+	}
+}
+
+func (r *DashboardCertifiedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected numeric dashboard ID, got %q: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.State.SetAttribute(ctx, path.Root("dashboard_id"), id)
+}
@@ -0,0 +1,263 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &DashboardRoleAccessResource{}
+var _ resource.ResourceWithImportState = &DashboardRoleAccessResource{}
+var _ resource.ResourceWithModifyPlan = &DashboardRoleAccessResource{}
+
+// dashboardRbacFeatureFlag is the feature flag that restricts dashboards to their roles.
+const dashboardRbacFeatureFlag = "DASHBOARD_RBAC"
+
+func NewDashboardRoleAccessResource() resource.Resource {
+	return &DashboardRoleAccessResource{}
+}
+
+type DashboardRoleAccessResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type dashboardRoleAccessResourceModel struct {
+	dashboardRoleAccessBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DashboardRoleAccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_role_access"
+}
+
+func (r *DashboardRoleAccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the roles that can access an existing superset dashboard. " +
+			"The roles only restrict access when the `DASHBOARD_RBAC` feature flag of the server is enabled, so planning fails otherwise. " +
+			"The roles listed here replace the roles of the dashboard, and destroying this resource removes the restriction from the dashboard.",
+
+		Attributes: map[string]schema.Attribute{
+			"dashboard_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the dashboard.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"dashboard_title": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The title of the dashboard.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_names": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the roles that can access the dashboard.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"role_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the roles that can access the dashboard.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *DashboardRoleAccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan fails the plan when the roles have no effect because DASHBOARD_RBAC is disabled. The
+// check is skipped when the feature flags of the server cannot be read.
+func (r *DashboardRoleAccessResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	flags, err := r.client.GetFeatureFlags(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to check the feature flags of the server", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if !flags[dashboardRbacFeatureFlag] {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role_names"),
+			"Dashboard RBAC Disabled",
+			fmt.Sprintf("The %s feature flag of the server is disabled, so the roles of a dashboard do not restrict access to it. Enable the flag before managing the roles of dashboards.", dashboardRbacFeatureFlag),
+		)
+	}
+}
+
+// apply sets the roles of the dashboard of model to its role_names.
+func (r *DashboardRoleAccessResource) apply(ctx context.Context, model *dashboardRoleAccessBaseModel) (*client.DashboardGetResponseSchema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	roles, err := r.client.ListRoles(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list roles: %s", err))
+		return nil, diags
+	}
+	roleIds, notFoundRoles := resolveRoleNames(roles, model.roleNames())
+	if len(notFoundRoles) > 0 {
+		diags.AddError("Invalid Roles", fmt.Sprintf("The following roles were not found: %v", notFoundRoles))
+		return nil, diags
+	}
+
+	d, err := r.client.SetDashboardRoles(ctx, int(model.DashboardId.ValueInt64()), roleIds)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set roles of dashboard with ID %d: %s", model.DashboardId.ValueInt64(), err))
+		return nil, diags
+	}
+	return d, diags
+}
+
+func (r *DashboardRoleAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data dashboardRoleAccessResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dashboard_role_access"))
+	defer cancel()
+
+	d, diags := r.apply(ctx, &data.dashboardRoleAccessBaseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.updateState(ctx, d)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardRoleAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dashboardRoleAccessResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dashboard_role_access"))
+	defer cancel()
+
+	d, err := r.client.GetDashboard(ctx, int(data.DashboardId.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dashboard with ID %d: %s", data.DashboardId.ValueInt64(), err))
+		return
+	}
+
+	data.updateState(ctx, d)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardRoleAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dashboardRoleAccessResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dashboard_role_access"))
+	defer cancel()
+
+	d, diags := r.apply(ctx, &plan.dashboardRoleAccessBaseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.updateState(ctx, d)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DashboardRoleAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dashboardRoleAccessResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dashboard_role_access"))
+	defer cancel()
+
+	_, err := r.client.SetDashboardRoles(ctx, int(state.DashboardId.ValueInt64()), []int{})
+	if client.IsNotFound(err) {
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove roles of dashboard with ID %d: %s", state.DashboardId.ValueInt64(), err))
+		return
+	}
+}
+
+func (r *DashboardRoleAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected numeric dashboard ID, got %q: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.State.SetAttribute(ctx, path.Root("dashboard_id"), id)
+}
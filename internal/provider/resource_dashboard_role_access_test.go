@@ -0,0 +1,47 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDashboardRoleAccessPlanRbacDisabled(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		server := testSupersetServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/login/" {
+				t.Logf("unexpected request %s %s", r.Method, r.URL)
+				http.NotFound(w, r)
+				return
+			}
+			fmt.Fprintf(w, `<div data-bootstrap="{&#34;common&#34;: {&#34;feature_flags&#34;: {&#34;DASHBOARD_RBAC&#34;: %t}}}"></div>`, enabled)
+		})
+
+		provider, schemas := testProviderServer(t, server.URL)
+		plan := testPlanResource(t, provider, schemas, "superset_dashboard_role_access", map[string]tftypes.Value{
+			"dashboard_id": tftypes.NewValue(tftypes.Number, 1),
+			"role_names": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "Gamma"),
+			}),
+		})
+
+		var errors []string
+		for _, d := range plan.Diagnostics {
+			if d.Severity == tfprotov6.DiagnosticSeverityError {
+				errors = append(errors, d.Summary)
+			}
+		}
+		if enabled && len(errors) > 0 {
+			t.Errorf("unexpected errors with %s enabled: %v", dashboardRbacFeatureFlag, errors)
+		}
+		if !enabled && (len(errors) != 1 || errors[0] != "Dashboard RBAC Disabled") {
+			t.Errorf("expected a Dashboard RBAC Disabled error with %s disabled, got %v", dashboardRbacFeatureFlag, errors)
+		}
+	}
+}
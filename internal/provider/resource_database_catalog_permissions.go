@@ -0,0 +1,316 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &DatabaseCatalogPermissionsResource{}
+var _ resource.ResourceWithImportState = &DatabaseCatalogPermissionsResource{}
+
+func NewDatabaseCatalogPermissionsResource() resource.Resource {
+	return &DatabaseCatalogPermissionsResource{}
+}
+
+type DatabaseCatalogPermissionsResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type databaseCatalogPermissionsResourceModel struct {
+	databaseCatalogPermissionsBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DatabaseCatalogPermissionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_catalog_permissions"
+}
+
+func (r *DatabaseCatalogPermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grant a superset role access to catalogs of a multi-catalog database, e.g. BigQuery projects or Trino catalogs. " +
+			"Superset generates a `catalog_access` permission on `[<database_name>].[<catalog>]` for each catalog of a database with `allow_multi_catalog` enabled. " +
+			"The resource manages all catalog access of the role on the database; the other permissions of the role are kept.",
+
+		Attributes: map[string]schema.Attribute{
+			"role_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the role.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the database.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the database.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"catalogs": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The catalogs of the database the role can access.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"permissions": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The catalog access permissions granted to the role by this resource.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the permission.",
+						},
+						"view_menu_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the view menu.",
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *DatabaseCatalogPermissionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// grant replaces the catalog access of the role on the database with the catalogs of data.
+func (r *DatabaseCatalogPermissionsResource) grant(ctx context.Context, data *databaseCatalogPermissionsResourceModel) (*client.SupersetRoleApiGetList, *client.SupersetDatabaseApiGetList, []client.SupersetRolePermissionApiGetList, error) {
+	role, err := r.client.FindRole(ctx, data.RoleName.ValueString())
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to find role with name %s: %w", data.RoleName.ValueString(), err)
+	}
+	database, err := r.client.FindDatabase(ctx, data.DatabaseName.ValueString())
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to find database with name %s: %w", data.DatabaseName.ValueString(), err)
+	}
+
+	sourcePermissions, err := r.client.ListPermissions(ctx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to list permissions: %w", err)
+	}
+	grants, notFoundCatalogs := data.resolvePermissions(sourcePermissions, database)
+	if len(notFoundCatalogs) > 0 {
+		return nil, nil, nil, fmt.Errorf("no catalog access permissions were found for the catalogs %v of database %s, "+
+			"check that allow_multi_catalog is enabled on the database and its catalogs were synced", notFoundCatalogs, database.DatabaseName)
+	}
+
+	current, err := listRolePermissions(ctx, r.client, role.Id)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to list permissions for role ID %d: %w", role.Id, err)
+	}
+
+	err = r.client.AssignPermissionsToRole(ctx, role.Id, mergeRolePermissionIds(current, grants, revokedCatalogPermissions(database, current, grants)))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to grant catalog access to role ID %d: %w", role.Id, err)
+	}
+
+	return role, database, grants, nil
+}
+
+func (r *DatabaseCatalogPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data databaseCatalogPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "database_catalog_permissions"))
+	defer cancel()
+
+	role, database, grants, err := r.grant(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to grant catalog access: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.updateState(ctx, role, database, grants)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseCatalogPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data databaseCatalogPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "database_catalog_permissions"))
+	defer cancel()
+
+	role, err := r.client.FindRole(ctx, data.RoleName.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", data.RoleName.ValueString(), err))
+		return
+	}
+	database, err := r.client.FindDatabase(ctx, data.DatabaseName.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find database with name %s: %s", data.DatabaseName.ValueString(), err))
+		return
+	}
+
+	current, err := listRolePermissions(ctx, r.client, role.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+		return
+	}
+
+	grants := catalogPermissions(database, current)
+	if len(grants) == 0 {
+		tflog.Debug(ctx, "Catalog access was revoked outside Terraform", map[string]interface{}{
+			"role_id":     role.Id,
+			"database_id": database.Id,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.updateState(ctx, role, database, grants)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseCatalogPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan databaseCatalogPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "database_catalog_permissions"))
+	defer cancel()
+
+	role, database, grants, err := r.grant(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to grant catalog access: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.updateState(ctx, role, database, grants)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DatabaseCatalogPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state databaseCatalogPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "database_catalog_permissions"))
+	defer cancel()
+
+	role, err := r.client.FindRole(ctx, state.RoleName.ValueString())
+	if client.IsNotFound(err) {
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", state.RoleName.ValueString(), err))
+		return
+	}
+	database, err := r.client.FindDatabase(ctx, state.DatabaseName.ValueString())
+	if client.IsNotFound(err) {
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find database with name %s: %s", state.DatabaseName.ValueString(), err))
+		return
+	}
+
+	current, err := listRolePermissions(ctx, r.client, role.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+		return
+	}
+
+	err = r.client.AssignPermissionsToRole(ctx, role.Id, mergeRolePermissionIds(current, nil, revokedCatalogPermissions(database, current, nil)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke catalog access from role ID %d: %s", role.Id, err))
+		return
+	}
+}
+
+func (r *DatabaseCatalogPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	roleName, databaseName, ok := strings.Cut(req.ID, "/")
+	if !ok || roleName == "" || databaseName == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected import ID in the format <role_name>/<database_name>, got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_name"), roleName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database_name"), databaseName)...)
+}
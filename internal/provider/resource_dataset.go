@@ -7,17 +7,23 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/htamakos/terraform-provider-superset/internal/client"
@@ -26,6 +32,14 @@ import (
 
 var _ resource.Resource = &DatasetResource{}
 var _ resource.ResourceWithImportState = &DatasetResource{}
+var _ resource.ResourceWithModifyPlan = &DatasetResource{}
+var _ resource.ResourceWithUpgradeState = &DatasetResource{}
+
+// The attributes of the Dataset renamed in schema version 1.
+var (
+	datasetCreationDatabaseName = renamedAttribute{from: "bootstrap_database_name", to: "creation_database_name"}
+	datasetCreationDatabaseId   = renamedAttribute{from: "bootstrap_database_id", to: "creation_database_id"}
+)
 
 func NewDatasetResource() resource.Resource {
 	return &DatasetResource{}
@@ -48,6 +62,7 @@ func (r *DatasetResource) Metadata(ctx context.Context, req resource.MetadataReq
 func (r *DatasetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manage a superset Dataset",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
@@ -64,21 +79,36 @@ func (r *DatasetResource) Schema(ctx context.Context, req resource.SchemaRequest
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
-			"bootstrap_database_name": schema.StringAttribute{
+			"creation_database_name": schema.StringAttribute{
 				Optional: true,
-				MarkdownDescription: `The database name of the Dataset used for bootstrapping.
+				Computed: true,
+				MarkdownDescription: `The name of the database the Dataset is created with, when it differs from ` + "`database_name`" + `. Changing it forces the replacement of the Dataset.
 Some Superset databases configured with OAuth authentication cannot be directly referenced during dataset creation via the Terraform provider, resulting in creation failures.
 
 To mitigate this limitation, a temporary non-OAuth database is specified at creation time. Once the dataset resource is successfully created, it is immediately updated to reference the intended OAuth-authenticated database.
 
 This database is not intended for operational use and exists solely to satisfy creation-time constraints.`,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+			},
+			"creation_database_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the database the Dataset was created with.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"bootstrap_database_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the database the Dataset is created with.",
+				DeprecationMessage:  datasetCreationDatabaseName.deprecationMessage(),
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot(datasetCreationDatabaseName.to)),
 				},
 			},
 			"bootstrap_database_id": schema.Int64Attribute{
 				Computed:            true,
-				MarkdownDescription: "The database ID of the Dataset used for bootstrapping.",
+				MarkdownDescription: "The ID of the database the Dataset was created with.",
+				DeprecationMessage:  datasetCreationDatabaseId.deprecationMessage(),
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
@@ -113,11 +143,26 @@ This database is not intended for operational use and exists solely to satisfy c
 			},
 			"sql": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The SQL of the Dataset.",
+				Computed:            true,
+				MarkdownDescription: "The SQL of the Dataset. Conflicts with `saved_query_label`, which sets it from a saved query.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"saved_query_label": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The label of a saved query whose SQL is the SQL of the Dataset, so that shared SQL has a single source of truth. The saved query must be visible to the provider account.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("sql")),
+				},
+			},
+			"pin_revision": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether to keep the SQL resolved from `saved_query_label` when the Dataset was created or the label last changed, instead of tracking updates of the saved query. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
 			"description": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "The description of the Dataset.",
@@ -154,6 +199,31 @@ This database is not intended for operational use and exists solely to satisfy c
 				},
 				Default: booldefault.StaticBool(false),
 			},
+			"main_dttm_col": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the main temporal column of the Dataset, which time range filters apply to. Superset picks a temporal column of the Dataset when it is not set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_endpoint": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The URL users are redirected to when clicking the Dataset in the dataset list, instead of the explore view.",
+			},
+			"offset": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				MarkdownDescription: "The timezone offset of the temporal columns of the Dataset, in hours. Defaults to `0`.",
+			},
+			"template_params": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The Jinja template parameters of the `sql` of the Dataset as a JSON string, e.g. `jsonencode({ region = \"emea\" })`.",
+				PlanModifiers: []planmodifier.String{
+					summarizeJSONChanges(),
+				},
+			},
 			"normalize_columns": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -188,6 +258,44 @@ This database is not intended for operational use and exists solely to satisfy c
 				Optional:            true,
 				MarkdownDescription: "The details of the Dataset certification.",
 			},
+			"create_access_role": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether to create a companion role named `<access_role_prefix>_<table_name>` with datasource access to the Dataset. The role is deleted with the Dataset. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"access_role_prefix": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The prefix of the name of the companion role. Defaults to `dataset`.",
+				Default:             stringdefault.StaticString("dataset"),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"access_role_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the companion role, when `create_access_role` is enabled.",
+			},
+			"access_role_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the companion role, when `create_access_role` is enabled.",
+			},
+			"created_on": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the dataset was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"changed_on": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the dataset was last changed.",
+			},
+			"changed_by": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The first and last name of the user who last changed the dataset, as the server does not return their username.",
+			},
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true, Update: true, Delete: true,
 			}),
@@ -214,6 +322,165 @@ func (r *DatasetResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = c
 }
 
+func (r *DatasetResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: upgradeRenamedAttributes(datasetCreationDatabaseName, datasetCreationDatabaseId),
+	}
+}
+
+// ModifyPlan computes the companion role attributes, so that a change of the role name is shown in the plan,
+// and the SQL of the saved query, so that updates of the saved query are. It also plans the creation
+// database under its current and deprecated names.
+func (r *DatasetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DatasetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.planSql(ctx, req, resp, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planRenamedAttribute(ctx, req, resp, datasetCreationDatabaseName, true)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleId, roleName := types.Int64Null(), types.StringNull()
+	if plan.CreateAccessRole.IsUnknown() || plan.CreateAccessRole.ValueBool() {
+		roleId, roleName = types.Int64Unknown(), types.StringUnknown()
+		if plan.CreateAccessRole.ValueBool() && !plan.AccessRolePrefix.IsUnknown() && !plan.TableName.IsUnknown() {
+			roleName = types.StringValue(plan.accessRoleName())
+		}
+		if !req.State.Raw.IsNull() {
+			var stateRoleId types.Int64
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("access_role_id"), &stateRoleId)...)
+			if !stateRoleId.IsNull() {
+				roleId = stateRoleId
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("access_role_id"), roleId)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("access_role_name"), roleName)...)
+}
+
+// planSql plans the SQL of the Dataset: the SQL of the configuration, or the SQL of the saved query
+// referenced by saved_query_label.
+func (r *DatasetResource) planSql(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, plan *DatasetResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// sql is only computed for saved queries, so removing it from the configuration clears it.
+	if plan.SavedQueryLabel.IsNull() {
+		var configSql types.String
+		diags.Append(req.Config.GetAttribute(ctx, path.Root("sql"), &configSql)...)
+		diags.Append(resp.Plan.SetAttribute(ctx, path.Root("sql"), configSql)...)
+		return diags
+	}
+
+	if plan.SavedQueryLabel.IsUnknown() || plan.PinRevision.IsUnknown() || r.client == nil {
+		diags.Append(resp.Plan.SetAttribute(ctx, path.Root("sql"), types.StringUnknown())...)
+		return diags
+	}
+
+	if plan.PinRevision.ValueBool() && !req.State.Raw.IsNull() {
+		var stateLabel, stateSql types.String
+		diags.Append(req.State.GetAttribute(ctx, path.Root("saved_query_label"), &stateLabel)...)
+		diags.Append(req.State.GetAttribute(ctx, path.Root("sql"), &stateSql)...)
+		if stateLabel.Equal(plan.SavedQueryLabel) {
+			diags.Append(resp.Plan.SetAttribute(ctx, path.Root("sql"), stateSql)...)
+			return diags
+		}
+	}
+
+	sql, d := r.savedQuerySql(ctx, &plan.datasetBaseModel)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("sql"), sql)...)
+	return diags
+}
+
+// savedQuerySql returns the SQL of the saved query referenced by saved_query_label.
+func (r *DatasetResource) savedQuerySql(ctx context.Context, model *datasetBaseModel) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sq, err := r.client.FindSavedQuery(ctx, model.SavedQueryLabel.ValueString(), "")
+	if err != nil {
+		diags.AddAttributeError(path.Root("saved_query_label"), "Client Error", fmt.Sprintf("Unable to find saved query with label %s: %s", model.SavedQueryLabel.ValueString(), err))
+		return types.StringNull(), diags
+	}
+
+	sql := nullableStringValue(sq.Sql)
+	if sql.ValueString() == "" {
+		diags.AddAttributeError(path.Root("saved_query_label"), "Invalid Saved Query", fmt.Sprintf("The saved query with label %s has no SQL.", model.SavedQueryLabel.ValueString()))
+		return types.StringNull(), diags
+	}
+	return sql, diags
+}
+
+// syncAccessRole creates or renames the companion role of the dataset and grants it datasource access to
+// the dataset. roleId is the ID of the existing companion role, or 0 to create it.
+func (r *DatasetResource) syncAccessRole(ctx context.Context, model *datasetBaseModel, d *client.DatasetRestApiGet, roleId int) diag.Diagnostics {
+	var diags diag.Diagnostics
+	model.AccessRoleId = types.Int64Null()
+	model.AccessRoleName = types.StringNull()
+
+	name := model.accessRoleName()
+	var role *client.SupersetRoleApiGet
+	if roleId == 0 {
+		existingRole, err := r.client.FindRole(ctx, name)
+		if !client.IsNotFound(err) && err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to validate role name uniqueness: %s", err))
+			return diags
+		}
+		if existingRole != nil {
+			diags.AddError("Client Error", fmt.Sprintf("A role with name '%s' already exists with ID %d", name, existingRole.Id))
+			return diags
+		}
+
+		role, err = r.client.CreateRole(ctx, client.SupersetRoleApiPost{Name: name})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to create role '%s': %s", name, err))
+			return diags
+		}
+	} else {
+		var err error
+		role, err = r.client.UpdateRole(ctx, roleId, client.SupersetRoleApiPut{Name: name})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update role with ID %d: %s", roleId, err))
+			return diags
+		}
+	}
+	model.AccessRoleId = types.Int64Value(int64(role.Id))
+	model.AccessRoleName = types.StringValue(role.Name)
+
+	sourcePermissions, err := r.client.ListPermissions(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list permissions: %s", err))
+		return diags
+	}
+	viewMenuName := datasourceAccessViewMenuName(d)
+	for _, p := range sourcePermissions {
+		if p.Permission.Name == "datasource_access" && p.ViewMenu.Name == viewMenuName {
+			if err := r.client.AssignPermissionsToRole(ctx, role.Id, []int{p.Id}); err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to grant datasource access to role ID %d: %s", role.Id, err))
+			}
+			return diags
+		}
+	}
+
+	diags.AddError("Invalid Permissions", fmt.Sprintf("The permission datasource_access on %s was not found", viewMenuName))
+	return diags
+}
+
 func (r *DatasetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data DatasetResourceModel
 
@@ -223,22 +490,31 @@ func (r *DatasetResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset"))
 	defer cancel()
 
-	var bootstrapDatabaseName string
-	if !data.BootstrapDatabaseName.IsNull() && data.BootstrapDatabaseName.ValueString() != "" {
-		bootstrapDatabaseName = data.BootstrapDatabaseName.ValueString()
+	if data.Sql.IsUnknown() {
+		sql, diags := r.savedQuerySql(ctx, &data.datasetBaseModel)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Sql = sql
+	}
+
+	var creationDatabaseName string
+	if !data.CreationDatabaseName.IsNull() && data.CreationDatabaseName.ValueString() != "" {
+		creationDatabaseName = data.CreationDatabaseName.ValueString()
 	} else {
-		bootstrapDatabaseName = data.DatabaseName.ValueString()
+		creationDatabaseName = data.DatabaseName.ValueString()
 	}
 
-	database, err := r.client.FindDatabase(ctx, bootstrapDatabaseName)
+	database, err := r.client.FindDatabase(ctx, creationDatabaseName)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find Database with name '%s': %s", data.DatabaseName.ValueString(), err))
 		return
 	}
-	bootstrapDatabaseId := database.Id
+	creationDatabaseId := database.Id
 
 	postData := client.DatasetRestApiPost{
 		TableName:           data.TableName.ValueString(),
@@ -276,10 +552,19 @@ func (r *DatasetResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	isChangedBootstrapDatabase := data.DatabaseName.ValueString() != bootstrapDatabaseName
+	unlock, diags := lockDataset(ctx, r.client, d.Id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
+	isChangedCreationDatabase := data.DatabaseName.ValueString() != creationDatabaseName
 
-	if !data.Description.IsNull() || !data.CacheTimeout.IsNull() || !data.FilterSelectEnabled.IsNull() || isChangedBootstrapDatabase || !data.CertifiedBy.IsNull() || !data.FetchValuesPredicate.IsNull() || !data.AlwaysFilterMainDttm.IsNull() {
+	if !data.Description.IsNull() || !data.CacheTimeout.IsNull() || !data.FilterSelectEnabled.IsNull() || isChangedCreationDatabase || !data.CertifiedBy.IsNull() || !data.FetchValuesPredicate.IsNull() || !data.AlwaysFilterMainDttm.IsNull() ||
+		!data.MainDttmCol.IsUnknown() || !data.DefaultEndpoint.IsNull() || data.Offset.ValueInt64() != 0 || !data.TemplateParams.IsNull() {
 		putData := client.DatasetRestApiPut{}
+		data.setQueryFields(&putData, nil)
 		if !data.Description.IsNull() {
 			putData.Description = nullable.NewNullableWithValue(data.Description.ValueString())
 		}
@@ -298,7 +583,7 @@ func (r *DatasetResource) Create(ctx context.Context, req resource.CreateRequest
 			putData.AlwaysFilterMainDttm = data.AlwaysFilterMainDttm.ValueBool()
 		}
 
-		if isChangedBootstrapDatabase {
+		if isChangedCreationDatabase {
 			database, err = r.client.FindDatabase(ctx, data.DatabaseName.ValueString())
 			if err != nil {
 				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find Database with name '%s': %s", data.DatabaseName.ValueString(), err))
@@ -343,11 +628,13 @@ func (r *DatasetResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	data.BootstrapDatabaseId = types.Int64Value(int64(bootstrapDatabaseId))
-	if isChangedBootstrapDatabase {
-		data.BootstrapDatabaseName = types.StringValue(bootstrapDatabaseName)
+	data.setCreationDatabaseId(creationDatabaseId)
+
+	if data.CreateAccessRole.ValueBool() {
+		resp.Diagnostics.Append(r.syncAccessRole(ctx, &data.datasetBaseModel, d, 0)...)
 	}
 
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, datasetLastModified(d))...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -360,7 +647,7 @@ func (r *DatasetResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset"))
 	defer cancel()
 	t, err := r.client.GetDataset(ctx, int(data.Id.ValueInt64()))
 	if client.IsNotFound(err) {
@@ -376,6 +663,20 @@ func (r *DatasetResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	if !data.AccessRoleId.IsNull() {
+		role, err := r.client.GetRole(ctx, int(data.AccessRoleId.ValueInt64()))
+		if client.IsNotFound(err) {
+			data.AccessRoleId = types.Int64Null()
+			data.AccessRoleName = types.StringNull()
+		} else if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role with ID %d: %s", data.AccessRoleId.ValueInt64(), err))
+			return
+		} else {
+			data.AccessRoleName = types.StringValue(role.Name)
+		}
+	}
+
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, datasetLastModified(t))...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -389,9 +690,37 @@ func (r *DatasetResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset"))
 	defer cancel()
 
+	unlock, diags := lockDataset(ctx, r.client, int(state.Id.ValueInt64()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
+	current, err := r.client.GetDataset(ctx, int(state.Id.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Dataset with ID %d: %s", state.Id.ValueInt64(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(checkConcurrentModification(ctx, req.Private, r.client, client.ObjectKindDataset, current.Id,
+		fmt.Sprintf("Dataset '%s' (ID %d)", current.TableName, current.Id), datasetLastModified(current))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Sql.IsUnknown() {
+		sql, diags := r.savedQuerySql(ctx, &plan.datasetBaseModel)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.Sql = sql
+	}
+
 	putData := client.DatasetRestApiPut{}
 
 	if !plan.Description.IsNull() {
@@ -416,6 +745,7 @@ func (r *DatasetResource) Update(ctx context.Context, req resource.UpdateRequest
 	if !plan.AlwaysFilterMainDttm.IsNull() {
 		putData.AlwaysFilterMainDttm = plan.AlwaysFilterMainDttm.ValueBool()
 	}
+	plan.setQueryFields(&putData, &state.datasetBaseModel)
 	if !plan.Catalog.IsNull() && plan.Catalog.ValueString() != "" {
 		putData.Catalog = nullable.NewNullableWithValue(plan.Catalog.ValueString())
 	}
@@ -458,7 +788,21 @@ func (r *DatasetResource) Update(ctx context.Context, req resource.UpdateRequest
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update state for Dataset with ID %d: %s", state.Id.ValueInt64(), err))
 		return
 	}
+	if plan.MainDttmCol.IsUnknown() {
+		// Not configured, and picked by Superset.
+		plan.MainDttmCol = state.MainDttmCol
+	}
+
+	if plan.CreateAccessRole.ValueBool() {
+		resp.Diagnostics.Append(r.syncAccessRole(ctx, &plan.datasetBaseModel, g, int(state.AccessRoleId.ValueInt64()))...)
+	} else if !state.AccessRoleId.IsNull() {
+		if err := r.client.DeleteRole(ctx, int(state.AccessRoleId.ValueInt64())); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete role with ID %d: %s", state.AccessRoleId.ValueInt64(), err))
+			return
+		}
+	}
 
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, datasetLastModified(g))...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -467,9 +811,16 @@ func (r *DatasetResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset"))
 	defer cancel()
 
+	if !state.AccessRoleId.IsNull() {
+		if err := r.client.DeleteRole(ctx, int(state.AccessRoleId.ValueInt64())); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete role with ID %d: %s", state.AccessRoleId.ValueInt64(), err))
+			return
+		}
+	}
+
 	err := r.client.DeleteDataset(ctx, int(state.Id.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Dataset with ID %d: %s", state.Id.ValueInt64(), err))
@@ -489,11 +840,27 @@ func (r *DatasetResource) ImportState(ctx context.Context, req resource.ImportSt
 
 	id, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			fmt.Sprintf("Expected numeric ID, got %q: %s", req.ID, err),
-		)
-		return
+		// A dataset identified by name, <database_name>/<schema>/<table_name>.
+		parts := strings.SplitN(req.ID, "/", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				fmt.Sprintf("Expected a numeric ID or an import ID of the form <database_name>/<schema>/<table_name>, got %q", req.ID),
+			)
+			return
+		}
+
+		if _, err := r.client.FindDatabase(ctx, parts[0]); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find Database with name '%s': %s", parts[0], err))
+			return
+		}
+		qualifier := client.DatasetQualifier{DatabaseName: parts[0], Schema: parts[1], TableName: parts[2]}
+		dataset, err := r.client.FindQualifiedDataset(ctx, qualifier)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find dataset '%s': %s", qualifier, err))
+			return
+		}
+		id = int64(dataset.Id)
 	}
 
 	resp.State.SetAttribute(ctx, path.Root("id"), id)
@@ -6,17 +6,22 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/htamakos/terraform-provider-superset/internal/client"
 	"github.com/oapi-codegen/nullable"
@@ -45,7 +50,10 @@ func (r *datasetColumnsResource) Metadata(ctx context.Context, req resource.Meta
 
 func (r *datasetColumnsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manage a superset Dataset Columns",
+		MarkdownDescription: "Manage a superset Dataset Columns. The listed columns replace the columns of the dataset, unless `authoritative` is `false`. " +
+			"On destroy, only the columns created by this resource are removed. " +
+			"Deleted columns are also removed from the folders of the dataset, so that `superset_dataset_folder` and `superset_dataset_metrics` resources " +
+			"of the same dataset can be applied with this resource without `depends_on`.",
 
 		Attributes: map[string]schema.Attribute{
 			"dataset_id": schema.Int64Attribute{
@@ -64,7 +72,7 @@ func (r *datasetColumnsResource) Schema(ctx context.Context, req resource.Schema
 			},
 			"columns": schema.MapNestedAttribute{
 				Required:            true,
-				MarkdownDescription: "The columns of the dataset.",
+				MarkdownDescription: "The columns of the dataset, keyed by the column name. Columns of the table are matched by name, and new columns with an `expression` are created as calculated columns.",
 				Validators: []validator.Map{
 					mapvalidator.SizeAtLeast(1),
 				},
@@ -100,7 +108,7 @@ func (r *datasetColumnsResource) Schema(ctx context.Context, req resource.Schema
 						"expression": schema.StringAttribute{
 							Optional:            true,
 							Computed:            true,
-							MarkdownDescription: "The expression of the column.",
+							MarkdownDescription: "The SQL expression of the column. A column that does not exist in the dataset is created as a calculated column, so it requires an expression.",
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
 							},
@@ -141,6 +149,20 @@ func (r *datasetColumnsResource) Schema(ctx context.Context, req resource.Schema
 							Optional:            true,
 							Computed:            true,
 							MarkdownDescription: "The verbose name of the column.",
+							PlanModifiers: []planmodifier.String{
+								useServerDefault("column_name", isDefaultVerboseName, "Keeps the verbose name derived by the server from column_name when verbose_name is not configured."),
+							},
+						},
+						"warning_markdown": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The warning shown next to the column in the explore view, in markdown.",
+						},
+						"extra": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							MarkdownDescription: "The other keys of the column extra field as a JSON object, such as column grouping metadata. " +
+								"Use `jsonencode` to set it. When not set, the keys already stored in Superset are preserved. " +
+								"Keys exposed as dedicated attributes (`certification` and `warning_markdown`) must not be set here.",
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
 							},
@@ -148,6 +170,15 @@ func (r *datasetColumnsResource) Schema(ctx context.Context, req resource.Schema
 					},
 				},
 			},
+			"authoritative": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				MarkdownDescription: "Whether `columns` lists all columns of the dataset, so that the columns not listed are deleted. " +
+					"When `false`, only the listed columns are managed, e.g. to curate the certification and verbose names of some columns, " +
+					"and the other columns are kept as they are and left out of the state. Defaults to `true`.",
+			},
+			"pending_removals": pendingRemovalsAttribute("columns", r.pendingRemovals),
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true, Update: true, Delete: true,
 			}),
@@ -174,6 +205,37 @@ func (r *datasetColumnsResource) Configure(ctx context.Context, req resource.Con
 	r.client = c
 }
 
+// pendingRemovals returns the columns of the dataset that are not configured, which the update of the
+// dataset deletes. There are none when the resource is not authoritative.
+func (r *datasetColumnsResource) pendingRemovals(ctx context.Context, req planmodifier.SetRequest) ([]string, bool, diag.Diagnostics) {
+	var columns types.Map
+	var authoritative types.Bool
+	diags := req.Plan.GetAttribute(ctx, path.Root("columns"), &columns)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("authoritative"), &authoritative)...)
+	if diags.HasError() || columns.IsUnknown() || authoritative.IsUnknown() {
+		return nil, false, diags
+	}
+	if !authoritative.IsNull() && !authoritative.ValueBool() {
+		return nil, true, diags
+	}
+	planned, ok := plannedNames(slices.Collect(maps.Values(columns.Elements())), "column_name")
+	if !ok {
+		return nil, false, diags
+	}
+
+	dataset, ok, d := currentDataset(ctx, r.client, req)
+	diags.Append(d...)
+	if !ok {
+		return nil, false, diags
+	}
+
+	current := make([]string, 0, len(dataset.Columns))
+	for _, c := range dataset.Columns {
+		current = append(current, c.ColumnName)
+	}
+	return unplannedNames(current, planned), true, diags
+}
+
 func (r *datasetColumnsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data datasetColumnsResourceModel
 
@@ -183,7 +245,7 @@ func (r *datasetColumnsResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset_columns"))
 	defer cancel()
 
 	_dataset, err := r.client.FindDataset(ctx, data.DatasetName.ValueString())
@@ -191,13 +253,24 @@ func (r *datasetColumnsResource) Create(ctx context.Context, req resource.Create
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find dataset with name '%s': %s", data.DatasetName.ValueString(), err))
 		return
 	}
+	unlock, diags := lockDataset(ctx, r.client, _dataset.Id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	dataset, err := r.client.GetDataset(ctx, _dataset.Id)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get dataset with ID %d: %s", dataset.Id, err))
 		return
 	}
 
-	columns := data.resovleColumns(dataset.Columns)
+	columns, err := data.resovleColumns(dataset.Columns)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("columns"), "Invalid Columns", fmt.Sprintf("Unable to resolve the columns of dataset '%s': %s", dataset.TableName, err))
+		return
+	}
 
 	putData := client.DatasetRestApiPut{}
 
@@ -210,7 +283,9 @@ func (r *datasetColumnsResource) Create(ctx context.Context, req resource.Create
 			Groupby:    column.Groupby.ValueBool(),
 			IsActive:   nullable.NewNullableWithValue(column.IsActive.ValueBool()),
 			IsDttm:     nullable.NewNullableWithValue(column.IsDttm.ValueBool()),
-			Type:       nullable.NewNullableWithValue(column.Type.ValueString()),
+		}
+		if !column.Type.IsUnknown() {
+			datasetColumn.Type = nullable.NewNullableWithValue(column.Type.ValueString())
 		}
 		if !column.AdvancedDataType.IsNull() && column.AdvancedDataType.ValueString() != "" {
 			datasetColumn.AdvancedDataType = nullable.NewNullableWithValue(column.AdvancedDataType.ValueString())
@@ -221,32 +296,48 @@ func (r *datasetColumnsResource) Create(ctx context.Context, req resource.Create
 		if !column.Expression.IsNull() && column.Expression.ValueString() != "" {
 			datasetColumn.Expression = nullable.NewNullableWithValue(column.Expression.ValueString())
 		}
-		if !column.VerboseName.IsNull() && column.VerboseName.ValueString() != "" {
+		if column.VerboseName.IsUnknown() {
+			datasetColumn.VerboseName = nullable.NewNullNullable[string]()
+		} else if !column.VerboseName.IsNull() && column.VerboseName.ValueString() != "" {
 			datasetColumn.VerboseName = nullable.NewNullableWithValue(column.VerboseName.ValueString())
 		}
-		if !column.CertifiedBy.IsNull() || column.CertifiedBy.ValueString() != "" {
-			extra, err := column.toExtra()
-			if err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to convert column certification info to extra field for column '%s': %s", column.ColumnName.ValueString(), err))
-				return
-			}
+		extra, err := column.toExtra()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to convert column attributes to extra field for column '%s': %s", column.ColumnName.ValueString(), err))
+			return
+		}
+		if extra == "" {
+			datasetColumn.Extra = nullable.NewNullNullable[string]()
+		} else {
 			datasetColumn.Extra = nullable.NewNullableWithValue(extra)
 		}
 
 		datasetColumns = append(datasetColumns, datasetColumn)
 	}
-	putData.Columns = datasetColumns
+	putData.Columns = append(datasetColumns, data.unmanagedColumns(dataset.Columns)...)
 
 	d, err := r.client.UpdateDataset(ctx, dataset.Id, putData)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dataset with ID %d: %s", dataset.Id, err))
 		return
 	}
+	d, diags = pruneDatasetFolders(ctx, r.client, d)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := data.checkApplied(d); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create the columns of dataset with ID %d: %s", dataset.Id, err))
+		return
+	}
 	if err := data.updateState(d); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update state from dataset with ID %d: %s", dataset.Id, err))
 		return
 	}
 
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, datasetLastModified(d))...)
+	resp.Diagnostics.Append(setCreatedIds(ctx, resp.Private, trackCreatedIds(nil, datasetColumnIds(dataset.Columns), datasetColumnIds(d.Columns)))...)
+	data.PendingRemovals = appliedPendingRemovals(data.PendingRemovals)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -259,7 +350,7 @@ func (r *datasetColumnsResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset_columns"))
 	defer cancel()
 
 	t, err := r.client.GetDataset(ctx, int(data.DatasetId.ValueInt64()))
@@ -276,6 +367,8 @@ func (r *datasetColumnsResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, datasetLastModified(t))...)
+	data.PendingRemovals = pendingRemovalsValue(nil)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -298,17 +391,34 @@ func (r *datasetColumnsResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset_columns"))
 	defer cancel()
+	unlock, diags := lockDataset(ctx, r.client, _dataset.Id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	dataset, err := r.client.GetDataset(ctx, _dataset.Id)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get dataset with ID %d: %s", dataset.Id, err))
 		return
 	}
 
+	resp.Diagnostics.Append(checkConcurrentModification(ctx, req.Private, r.client, client.ObjectKindDataset, dataset.Id,
+		fmt.Sprintf("Dataset '%s' (ID %d)", dataset.TableName, dataset.Id), datasetLastModified(dataset))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	putData := client.DatasetRestApiPut{}
 
-	resolvedColumns := plan.resovleColumns(dataset.Columns)
+	resolvedColumns, err := plan.resovleColumns(dataset.Columns)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("columns"), "Invalid Columns", fmt.Sprintf("Unable to resolve the columns of dataset '%s': %s", dataset.TableName, err))
+		return
+	}
 	var columns []client.DatasetColumnsPut
 	stateColumnsMap := make(map[int64]datasetColumn)
 	for _, column := range state.Columns {
@@ -323,7 +433,9 @@ func (r *datasetColumnsResource) Update(ctx context.Context, req resource.Update
 			Groupby:    column.Groupby.ValueBool(),
 			IsActive:   nullable.NewNullableWithValue(column.IsActive.ValueBool()),
 			IsDttm:     nullable.NewNullableWithValue(column.IsDttm.ValueBool()),
-			Type:       nullable.NewNullableWithValue(column.Type.ValueString()),
+		}
+		if !column.Type.IsUnknown() {
+			_column.Type = nullable.NewNullableWithValue(column.Type.ValueString())
 		}
 		if !column.AdvancedDataType.IsNull() {
 			_column.AdvancedDataType = nullable.NewNullableWithValue(column.AdvancedDataType.ValueString())
@@ -334,21 +446,25 @@ func (r *datasetColumnsResource) Update(ctx context.Context, req resource.Update
 		if !column.Expression.IsNull() {
 			_column.Expression = nullable.NewNullableWithValue(column.Expression.ValueString())
 		}
-		if !column.VerboseName.IsNull() {
+		if column.VerboseName.IsUnknown() {
+			_column.VerboseName = nullable.NewNullNullable[string]()
+		} else if !column.VerboseName.IsNull() {
 			_column.VerboseName = nullable.NewNullableWithValue(column.VerboseName.ValueString())
 		}
-		if !column.CertifiedBy.IsNull() || column.CertifiedBy.ValueString() != "" {
-			extra, err := column.toExtra()
-			if err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to convert column certification info to extra field for column '%s': %s", column.ColumnName.ValueString(), err))
-				return
-			}
+		extra, err := column.toExtra()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to convert column attributes to extra field for column '%s': %s", column.ColumnName.ValueString(), err))
+			return
+		}
+		if extra == "" {
+			_column.Extra = nullable.NewNullNullable[string]()
+		} else {
 			_column.Extra = nullable.NewNullableWithValue(extra)
 		}
 
 		columns = append(columns, _column)
 	}
-	putData.Columns = columns
+	putData.Columns = append(columns, plan.unmanagedColumns(dataset.Columns)...)
 
 	d, err := r.client.UpdateDataset(ctx, dataset.Id, putData)
 
@@ -356,11 +472,31 @@ func (r *datasetColumnsResource) Update(ctx context.Context, req resource.Update
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dataset with ID %d: %s", dataset.Id, err))
 		return
 	}
+	d, diags = pruneDatasetFolders(ctx, r.client, d)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	if err := plan.checkApplied(d); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update the columns of dataset with ID %d: %s", dataset.Id, err))
+		return
+	}
+
+	// The state keeps the columns the plan manages.
+	state.Columns = plan.Columns
+	state.Authoritative = plan.Authoritative
 	if err := state.updateState(d); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update state from dataset with ID %d: %s", dataset.Id, err))
 		return
 	}
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, datasetLastModified(d))...)
+	created, diags := getCreatedIds(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if created != nil {
+		resp.Diagnostics.Append(setCreatedIds(ctx, resp.Private, trackCreatedIds(created, datasetColumnIds(dataset.Columns), datasetColumnIds(d.Columns)))...)
+	}
+	state.PendingRemovals = appliedPendingRemovals(plan.PendingRemovals)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -369,7 +505,7 @@ func (r *datasetColumnsResource) Delete(ctx context.Context, req resource.Delete
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset_columns"))
 	defer cancel()
 
 	// Delete is not supported for dataset columns, so we just update the dataset to remove the columns
@@ -382,18 +518,50 @@ func (r *datasetColumnsResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	unlock, diags := lockDataset(ctx, r.client, dataset.Id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	putData := client.DatasetRestApiPut{
 		Columns: []client.DatasetColumnsPut{},
 	}
-	_, err = r.client.UpdateDataset(ctx, dataset.Id, putData)
+
+	// Only remove the columns this resource created, when they were recorded, and keep
+	// the ones that existed before, e.g. the physical columns of the table.
+	// Without a record, a resource that is not authoritative keeps the columns it does not manage.
+	created, diags := getCreatedIds(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if created != nil || !state.isAuthoritative() {
+		d, err := r.client.GetDataset(ctx, dataset.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get dataset with ID %d: %s", dataset.Id, err))
+			return
+		}
+		if created == nil {
+			putData.Columns = append(putData.Columns, state.unmanagedColumns(d.Columns)...)
+		} else {
+			for _, column := range d.Columns {
+				if !created[strconv.Itoa(column.Id)] {
+					putData.Columns = append(putData.Columns, client.DatasetColumnsPut{
+						Id:         column.Id,
+						ColumnName: column.ColumnName,
+					})
+				}
+			}
+		}
+	}
+
+	d, err := r.client.UpdateDataset(ctx, dataset.Id, putData)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dataset with ID %d: %s", dataset.Id, err))
 		return
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	_, diags = pruneDatasetFolders(ctx, r.client, d)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *datasetColumnsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
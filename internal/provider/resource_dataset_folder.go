@@ -7,10 +7,13 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/htamakos/terraform-provider-superset/internal/client"
 )
@@ -25,6 +29,9 @@ import (
 var _ resource.Resource = &datasetFolderResource{}
 var _ resource.ResourceWithImportState = &datasetFolderResource{}
 
+// folderChildrenWaitTimeout is how long the folders wait for the columns and metrics they list to be created.
+const folderChildrenWaitTimeout = 2 * time.Minute
+
 func NewDatasetFolderResource() resource.Resource {
 	return &datasetFolderResource{}
 }
@@ -45,7 +52,10 @@ func (r *datasetFolderResource) Metadata(ctx context.Context, req resource.Metad
 
 func (r *datasetFolderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manage a superset Dataset folder",
+		MarkdownDescription: "Manage a superset Dataset folder. On destroy, only the root level folders created by this resource are removed. " +
+			"The columns and metrics of the folders are found by name when the folders are written. " +
+			"Terraform cannot tell that they are created by `superset_dataset_columns` or `superset_dataset_metrics` resources of the same configuration, " +
+			"so the folders wait up to two minutes for the missing ones to be created instead of requiring `depends_on`.",
 
 		Attributes: map[string]schema.Attribute{
 			"dataset_id": schema.Int64Attribute{
@@ -64,7 +74,7 @@ func (r *datasetFolderResource) Schema(ctx context.Context, req resource.SchemaR
 			},
 			"folders": schema.ListNestedAttribute{
 				Required:            true,
-				MarkdownDescription: "The folder of the dataset.",
+				MarkdownDescription: "The root level folders of the dataset, in the order Superset lists them. The order is kept: reordering the folders in the configuration reorders them in Superset, and reordering them in Superset shows as a change on the next plan.",
 				Validators: []validator.List{
 					listvalidator.SizeAtLeast(1),
 				},
@@ -96,11 +106,11 @@ func (r *datasetFolderResource) Schema(ctx context.Context, req resource.SchemaR
 						},
 						"uuid": schema.StringAttribute{
 							Computed:            true,
-							MarkdownDescription: "The UUID of the folder.",
+							MarkdownDescription: "The UUID of the folder. It is kept when the folders are reordered, as the folders are matched by name.",
 						},
 						"children": schema.ListNestedAttribute{
 							Required:            true,
-							MarkdownDescription: "The children of the folder.",
+							MarkdownDescription: "The columns and metrics of the folder, in the order Superset lists them. The order is kept like the order of the folders.",
 							Validators: []validator.List{
 								listvalidator.SizeAtLeast(1),
 							},
@@ -140,6 +150,7 @@ func (r *datasetFolderResource) Schema(ctx context.Context, req resource.SchemaR
 					},
 				},
 			},
+			"pending_removals": pendingRemovalsAttribute("root level folders", r.pendingRemovals),
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true, Update: true, Delete: true,
 			}),
@@ -166,6 +177,90 @@ func (r *datasetFolderResource) Configure(ctx context.Context, req resource.Conf
 	r.client = c
 }
 
+// pendingRemovals returns the root level folders of the dataset that are not configured, which the update
+// of the dataset deletes. The columns and metrics of a deleted folder are not deleted.
+func (r *datasetFolderResource) pendingRemovals(ctx context.Context, req planmodifier.SetRequest) ([]string, bool, diag.Diagnostics) {
+	var folders types.List
+	diags := req.Plan.GetAttribute(ctx, path.Root("folders"), &folders)
+	if diags.HasError() || folders.IsUnknown() {
+		return nil, false, diags
+	}
+	planned, ok := plannedNames(folders.Elements(), "name")
+	if !ok {
+		return nil, false, diags
+	}
+
+	dataset, ok, d := currentDataset(ctx, r.client, req)
+	diags.Append(d...)
+	if !ok {
+		return nil, false, diags
+	}
+
+	currentFolders, err := datasetFolders(dataset)
+	if err != nil {
+		diags.AddError("Folder Conversion Error", fmt.Sprintf("Unable to read folders of dataset with ID %d: %s", dataset.Id, err))
+		return nil, false, diags
+	}
+	current := make([]string, 0, len(currentFolders))
+	for _, f := range currentFolders {
+		current = append(current, f.Name)
+	}
+	return unplannedNames(current, planned), true, diags
+}
+
+// lockDatasetWithChildren takes the lock of the dataset with the given ID and returns the dataset, once it has
+// the columns and metrics listed by the folders of model. Those may be created by a superset_dataset_columns
+// or superset_dataset_metrics resource applied at the same time without depends_on, so the lock is released
+// while waiting for them, up to folderChildrenWaitTimeout.
+func (r *datasetFolderResource) lockDatasetWithChildren(ctx context.Context, model *datasetFolderBaseModel, datasetID int) (*client.DatasetRestApiGet, func(), diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	deadline := time.Now().Add(folderChildrenWaitTimeout)
+	delay := time.Second
+	for {
+		unlock, d := lockDataset(ctx, r.client, datasetID)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, unlock, diags
+		}
+
+		dataset, err := r.client.GetDataset(ctx, datasetID)
+		if err != nil {
+			unlock()
+			diags.AddError("Client Error", fmt.Sprintf("Unable to get dataset with ID %d: %s", datasetID, err))
+			return nil, func() {}, diags
+		}
+
+		missing := model.missingChildren(dataset)
+		if len(missing) == 0 {
+			return dataset, unlock, diags
+		}
+		unlock()
+
+		if time.Now().After(deadline) {
+			diags.AddError(
+				"Missing Folder Children",
+				fmt.Sprintf("The folders of dataset with ID %d list %s, which the dataset does not have after waiting %s. "+
+					"Create them with superset_dataset_columns or superset_dataset_metrics, or fix the names of the children.",
+					datasetID, strings.Join(missing, ", "), folderChildrenWaitTimeout),
+			)
+			return nil, func() {}, diags
+		}
+
+		tflog.Debug(ctx, "Waiting for the columns and metrics of the dataset folders", map[string]interface{}{
+			"dataset_id": datasetID,
+			"missing":    missing,
+		})
+		select {
+		case <-ctx.Done():
+			diags.AddError("Client Error", fmt.Sprintf("Unable to wait for %s of dataset with ID %d: %s", strings.Join(missing, ", "), datasetID, ctx.Err()))
+			return nil, func() {}, diags
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, 10*time.Second)
+	}
+}
+
 func (r *datasetFolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data datasetFolderResourceModel
 
@@ -175,7 +270,7 @@ func (r *datasetFolderResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset_folder"))
 	defer cancel()
 
 	_dataset, err := r.client.FindDataset(ctx, data.DatasetName.ValueString())
@@ -183,11 +278,12 @@ func (r *datasetFolderResource) Create(ctx context.Context, req resource.CreateR
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find dataset with name '%s': %s", data.DatasetName.ValueString(), err))
 		return
 	}
-	dataset, err := r.client.GetDataset(ctx, _dataset.Id)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get dataset with ID %d: %s", dataset.Id, err))
+	dataset, unlock, diags := r.lockDatasetWithChildren(ctx, &data.datasetFolderBaseModel, _dataset.Id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	defer unlock()
 
 	data.resolveColumns(dataset)
 	folders, err := data.toFolders()
@@ -210,6 +306,9 @@ func (r *datasetFolderResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, datasetLastModified(d))...)
+	resp.Diagnostics.Append(trackCreatedFolders(ctx, nil, resp.Private, dataset, d)...)
+	data.PendingRemovals = appliedPendingRemovals(data.PendingRemovals)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -222,7 +321,7 @@ func (r *datasetFolderResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset_folder"))
 	defer cancel()
 
 	t, err := r.client.GetDataset(ctx, int(data.DatasetId.ValueInt64()))
@@ -239,6 +338,8 @@ func (r *datasetFolderResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, datasetLastModified(t))...)
+	data.PendingRemovals = pendingRemovalsValue(nil)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -261,14 +362,27 @@ func (r *datasetFolderResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset_folder"))
 	defer cancel()
-	dataset, err := r.client.GetDataset(ctx, _dataset.Id)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get dataset with ID %d: %s", dataset.Id, err))
+	dataset, unlock, diags := r.lockDatasetWithChildren(ctx, &plan.datasetFolderBaseModel, _dataset.Id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
+	resp.Diagnostics.Append(checkConcurrentModification(ctx, req.Private, r.client, client.ObjectKindDataset, dataset.Id,
+		fmt.Sprintf("Dataset '%s' (ID %d)", dataset.TableName, dataset.Id), datasetLastModified(dataset))...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	currentFolders, err := datasetFolders(dataset)
+	if err != nil {
+		resp.Diagnostics.AddError("Folder Conversion Error", fmt.Sprintf("Unable to convert folders for dataset with ID %d: %s", dataset.Id, err))
+		return
+	}
+	plan.resolveFolderUuids(currentFolders)
 	plan.resolveColumns(dataset)
 	folders, err := plan.toFolders()
 	if err != nil {
@@ -291,6 +405,13 @@ func (r *datasetFolderResource) Update(ctx context.Context, req resource.UpdateR
 		resp.Diagnostics.AddError("State Update Error", fmt.Sprintf("Unable to update state for datasetfolder with ID %d: %s", dataset.Id, err))
 		return
 	}
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, datasetLastModified(d))...)
+	created, diags := getCreatedIds(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if created != nil {
+		resp.Diagnostics.Append(trackCreatedFolders(ctx, created, resp.Private, dataset, d)...)
+	}
+	state.PendingRemovals = appliedPendingRemovals(plan.PendingRemovals)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -299,7 +420,7 @@ func (r *datasetFolderResource) Delete(ctx context.Context, req resource.DeleteR
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset_folder"))
 	defer cancel()
 
 	// Delete is not supported for dataset folder, so we just update the dataset to remove the folder
@@ -312,9 +433,38 @@ func (r *datasetFolderResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	unlock, diags := lockDataset(ctx, r.client, dataset.Id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	putData := client.DatasetRestApiPut{
 		Folders: []client.Folder{},
 	}
+
+	// Only remove the root level folders this resource created, when they were recorded.
+	created, diags := getCreatedIds(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if created != nil {
+		d, err := r.client.GetDataset(ctx, dataset.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get dataset with ID %d: %s", dataset.Id, err))
+			return
+		}
+		folders, err := datasetFolders(d)
+		if err != nil {
+			resp.Diagnostics.AddError("Folder Conversion Error", fmt.Sprintf("Unable to convert folders for dataset with ID %d: %s", dataset.Id, err))
+			return
+		}
+		for _, folder := range folders {
+			if !created[folder.Uuid.String()] {
+				putData.Folders = append(putData.Folders, folder)
+			}
+		}
+	}
+
 	_, err = r.client.UpdateDataset(ctx, dataset.Id, putData)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dataset with ID %d: %s", dataset.Id, err))
@@ -326,6 +476,25 @@ func (r *datasetFolderResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 }
 
+// trackCreatedFolders records the root level folders of after, the dataset after a write, the resource
+// created in the private state.
+func trackCreatedFolders(ctx context.Context, created map[string]bool, p privateStateSetter, before, after *client.DatasetRestApiGet) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	beforeFolders, err := datasetFolders(before)
+	if err != nil {
+		diags.AddError("Folder Conversion Error", fmt.Sprintf("Unable to convert folders for dataset with ID %d: %s", before.Id, err))
+		return diags
+	}
+	afterFolders, err := datasetFolders(after)
+	if err != nil {
+		diags.AddError("Folder Conversion Error", fmt.Sprintf("Unable to convert folders for dataset with ID %d: %s", after.Id, err))
+		return diags
+	}
+
+	return setCreatedIds(ctx, p, trackCreatedIds(created, datasetFolderIds(beforeFolders), datasetFolderIds(afterFolders)))
+}
+
 func (r *datasetFolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
 		"import_id": req.ID,
@@ -6,18 +6,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/htamakos/terraform-provider-superset/internal/client"
 	"github.com/oapi-codegen/nullable"
@@ -46,7 +51,10 @@ func (r *datasetMetricsResource) Metadata(ctx context.Context, req resource.Meta
 
 func (r *datasetMetricsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manage a superset Dataset metrics",
+		MarkdownDescription: "Manage a superset Dataset metrics. The listed metrics replace the metrics of the dataset, unless `authoritative` is `false`. " +
+			"On destroy, only the metrics created by this resource are removed. " +
+			"Deleted metrics are also removed from the folders of the dataset, so that `superset_dataset_folder` and `superset_dataset_columns` resources " +
+			"of the same dataset can be applied with this resource without `depends_on`.",
 
 		Attributes: map[string]schema.Attribute{
 			"dataset_id": schema.Int64Attribute{
@@ -82,13 +90,15 @@ func (r *datasetMetricsResource) Schema(ctx context.Context, req resource.Schema
 							Optional: true,
 							Attributes: map[string]schema.Attribute{
 								"symbol": schema.StringAttribute{
-									Required: true,
+									Required:            true,
+									MarkdownDescription: "The ISO 4217 code of the currency, e.g. `USD` or `EUR`.",
 									Validators: []validator.String{
-										stringvalidator.OneOf("GBP", "USD", "JPY", "INR", "CNY", "MXN"),
+										stringvalidator.OneOf(currencyCodes...),
 									},
 								},
 								"symbol_position": schema.StringAttribute{
-									Required: true,
+									Required:            true,
+									MarkdownDescription: "Whether the symbol is placed before (`prefix`) or after (`suffix`) the value.",
 									Validators: []validator.String{
 										stringvalidator.OneOf("prefix", "suffix"),
 									},
@@ -98,9 +108,12 @@ func (r *datasetMetricsResource) Schema(ctx context.Context, req resource.Schema
 						"d3format": schema.StringAttribute{
 							Optional:            true,
 							Computed:            true,
-							MarkdownDescription: "The D3 format of the metric.",
+							MarkdownDescription: "The D3 format of the metric, e.g. `,.2f`, or a named format of Superset, e.g. `SMART_NUMBER`.",
+							Validators: []validator.String{
+								d3FormatValidator{},
+							},
 							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
+								useServerDefault("metric_name", isDefaultD3format, "Keeps the number format derived by the server when d3format is not configured."),
 							},
 						},
 						"expression": schema.StringAttribute{
@@ -132,7 +145,7 @@ func (r *datasetMetricsResource) Schema(ctx context.Context, req resource.Schema
 							Computed:            true,
 							MarkdownDescription: "The verbose name of the metric.",
 							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
+								useServerDefault("metric_name", isDefaultVerboseName, "Keeps the verbose name derived by the server from metric_name when verbose_name is not configured."),
 							},
 						},
 						"warning_text": schema.StringAttribute{
@@ -142,6 +155,14 @@ func (r *datasetMetricsResource) Schema(ctx context.Context, req resource.Schema
 					},
 				},
 			},
+			"authoritative": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				MarkdownDescription: "Whether `metrics` lists all metrics of the dataset, so that the metrics not listed are deleted. " +
+					"When `false`, only the listed metrics are managed, so that metrics created in the UI are kept as they are and left out of the state. Defaults to `true`.",
+			},
+			"pending_removals": pendingRemovalsAttribute("metrics", r.pendingRemovals),
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true, Update: true, Delete: true,
 			}),
@@ -168,6 +189,37 @@ func (r *datasetMetricsResource) Configure(ctx context.Context, req resource.Con
 	r.client = c
 }
 
+// pendingRemovals returns the metrics of the dataset that are not configured, which the update of the
+// dataset deletes. There are none when the resource is not authoritative.
+func (r *datasetMetricsResource) pendingRemovals(ctx context.Context, req planmodifier.SetRequest) ([]string, bool, diag.Diagnostics) {
+	var metrics types.Map
+	var authoritative types.Bool
+	diags := req.Plan.GetAttribute(ctx, path.Root("metrics"), &metrics)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("authoritative"), &authoritative)...)
+	if diags.HasError() || metrics.IsUnknown() || authoritative.IsUnknown() {
+		return nil, false, diags
+	}
+	if !authoritative.IsNull() && !authoritative.ValueBool() {
+		return nil, true, diags
+	}
+	planned, ok := plannedNames(slices.Collect(maps.Values(metrics.Elements())), "metric_name")
+	if !ok {
+		return nil, false, diags
+	}
+
+	dataset, ok, d := currentDataset(ctx, r.client, req)
+	diags.Append(d...)
+	if !ok {
+		return nil, false, diags
+	}
+
+	current := make([]string, 0, len(dataset.Metrics))
+	for _, m := range dataset.Metrics {
+		current = append(current, m.MetricName)
+	}
+	return unplannedNames(current, planned), true, diags
+}
+
 func (r *datasetMetricsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data datasetMetricsResourceModel
 
@@ -177,7 +229,7 @@ func (r *datasetMetricsResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset_metrics"))
 	defer cancel()
 
 	_dataset, err := r.client.FindDataset(ctx, data.DatasetName.ValueString())
@@ -185,6 +237,13 @@ func (r *datasetMetricsResource) Create(ctx context.Context, req resource.Create
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find dataset with name '%s': %s", data.DatasetName.ValueString(), err))
 		return
 	}
+	unlock, diags := lockDataset(ctx, r.client, _dataset.Id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	dataset, err := r.client.GetDataset(ctx, _dataset.Id)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get dataset with ID %d: %s", dataset.Id, err))
@@ -203,10 +262,14 @@ func (r *datasetMetricsResource) Create(ctx context.Context, req resource.Create
 		if !metric.Description.IsNull() && metric.Description.ValueString() != "" {
 			datasetMetric.Description = nullable.NewNullableWithValue(metric.Description.ValueString())
 		}
-		if !metric.VerboseName.IsNull() && metric.VerboseName.ValueString() != "" {
+		if metric.VerboseName.IsUnknown() {
+			datasetMetric.VerboseName = nullable.NewNullNullable[string]()
+		} else if !metric.VerboseName.IsNull() && metric.VerboseName.ValueString() != "" {
 			datasetMetric.VerboseName = nullable.NewNullableWithValue(metric.VerboseName.ValueString())
 		}
-		if !metric.D3format.IsNull() && metric.D3format.ValueString() != "" {
+		if metric.D3format.IsUnknown() {
+			datasetMetric.D3format = nullable.NewNullNullable[string]()
+		} else if !metric.D3format.IsNull() && metric.D3format.ValueString() != "" {
 			datasetMetric.D3format = nullable.NewNullableWithValue(metric.D3format.ValueString())
 		}
 		if !metric.WarningText.IsNull() && metric.WarningText.ValueString() != "" {
@@ -232,18 +295,26 @@ func (r *datasetMetricsResource) Create(ctx context.Context, req resource.Create
 
 		datasetMetrics = append(datasetMetrics, datasetMetric)
 	}
-	putData.Metrics = datasetMetrics
+	putData.Metrics = append(datasetMetrics, data.unmanagedMetrics(dataset.Metrics)...)
 
 	d, err := r.client.UpdateDataset(ctx, dataset.Id, putData)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dataset with ID %d: %s", dataset.Id, err))
 		return
 	}
+	d, diags = pruneDatasetFolders(ctx, r.client, d)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if err := data.updateState(d); err != nil {
 		resp.Diagnostics.AddError("State Update Error", fmt.Sprintf("Unable to update state from API response for dataset with ID %d: %s", dataset.Id, err))
 		return
 	}
 
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, datasetLastModified(d))...)
+	resp.Diagnostics.Append(setCreatedIds(ctx, resp.Private, trackCreatedIds(nil, datasetMetricIds(dataset.Metrics), datasetMetricIds(d.Metrics)))...)
+	data.PendingRemovals = appliedPendingRemovals(data.PendingRemovals)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -256,7 +327,7 @@ func (r *datasetMetricsResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset_metrics"))
 	defer cancel()
 
 	t, err := r.client.GetDataset(ctx, int(data.DatasetId.ValueInt64()))
@@ -273,6 +344,8 @@ func (r *datasetMetricsResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, datasetLastModified(t))...)
+	data.PendingRemovals = pendingRemovalsValue(nil)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -295,14 +368,27 @@ func (r *datasetMetricsResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset_metrics"))
 	defer cancel()
+	unlock, diags := lockDataset(ctx, r.client, _dataset.Id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	dataset, err := r.client.GetDataset(ctx, _dataset.Id)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get dataset with ID %d: %s", dataset.Id, err))
 		return
 	}
 
+	resp.Diagnostics.Append(checkConcurrentModification(ctx, req.Private, r.client, client.ObjectKindDataset, dataset.Id,
+		fmt.Sprintf("Dataset '%s' (ID %d)", dataset.TableName, dataset.Id), datasetLastModified(dataset))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	putData := client.DatasetRestApiPut{}
 
 	var datasetMetrics []client.DatasetMetricsPut
@@ -316,10 +402,14 @@ func (r *datasetMetricsResource) Update(ctx context.Context, req resource.Update
 		if !metric.Description.IsNull() && metric.Description.ValueString() != "" {
 			datasetMetric.Description = nullable.NewNullableWithValue(metric.Description.ValueString())
 		}
-		if !metric.VerboseName.IsNull() && metric.VerboseName.ValueString() != "" {
+		if metric.VerboseName.IsUnknown() {
+			datasetMetric.VerboseName = nullable.NewNullNullable[string]()
+		} else if !metric.VerboseName.IsNull() && metric.VerboseName.ValueString() != "" {
 			datasetMetric.VerboseName = nullable.NewNullableWithValue(metric.VerboseName.ValueString())
 		}
-		if !metric.D3format.IsNull() && metric.D3format.ValueString() != "" {
+		if metric.D3format.IsUnknown() {
+			datasetMetric.D3format = nullable.NewNullNullable[string]()
+		} else if !metric.D3format.IsNull() && metric.D3format.ValueString() != "" {
 			datasetMetric.D3format = nullable.NewNullableWithValue(metric.D3format.ValueString())
 		}
 		if !metric.WarningText.IsNull() && metric.WarningText.ValueString() != "" {
@@ -344,18 +434,33 @@ func (r *datasetMetricsResource) Update(ctx context.Context, req resource.Update
 
 		datasetMetrics = append(datasetMetrics, datasetMetric)
 	}
-	putData.Metrics = datasetMetrics
+	putData.Metrics = append(datasetMetrics, plan.unmanagedMetrics(dataset.Metrics)...)
 	d, err := r.client.UpdateDataset(ctx, dataset.Id, putData)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dataset with ID %d: %s", dataset.Id, err))
 		return
 	}
+	d, diags = pruneDatasetFolders(ctx, r.client, d)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	// The state keeps the metrics the plan manages.
+	state.Metrics = plan.Metrics
+	state.Authoritative = plan.Authoritative
 	if err := state.updateState(d); err != nil {
 		resp.Diagnostics.AddError("State Update Error", fmt.Sprintf("Unable to update state from API response for dataset with ID %d: %s", dataset.Id, err))
 		return
 	}
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, datasetLastModified(d))...)
+	created, diags := getCreatedIds(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if created != nil {
+		resp.Diagnostics.Append(setCreatedIds(ctx, resp.Private, trackCreatedIds(created, datasetMetricIds(dataset.Metrics), datasetMetricIds(d.Metrics)))...)
+	}
+	state.PendingRemovals = appliedPendingRemovals(plan.PendingRemovals)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -364,7 +469,7 @@ func (r *datasetMetricsResource) Delete(ctx context.Context, req resource.Delete
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset_metrics"))
 	defer cancel()
 
 	// Delete is not supported for dataset metrics, so we just update the dataset to remove the metrics
@@ -377,18 +482,50 @@ func (r *datasetMetricsResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	unlock, diags := lockDataset(ctx, r.client, dataset.Id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
 	putData := client.DatasetRestApiPut{
 		Metrics: []client.DatasetMetricsPut{},
 	}
-	_, err = r.client.UpdateDataset(ctx, dataset.Id, putData)
+
+	// Only remove the metrics this resource created, when they were recorded. Without a record, a
+	// resource that is not authoritative keeps the metrics it does not manage.
+	created, diags := getCreatedIds(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if created != nil || !state.isAuthoritative() {
+		d, err := r.client.GetDataset(ctx, dataset.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get dataset with ID %d: %s", dataset.Id, err))
+			return
+		}
+		if created == nil {
+			putData.Metrics = append(putData.Metrics, state.unmanagedMetrics(d.Metrics)...)
+		} else {
+			for _, metric := range d.Metrics {
+				if !created[strconv.Itoa(metric.Id)] {
+					putData.Metrics = append(putData.Metrics, client.DatasetMetricsPut{
+						Id:         metric.Id,
+						MetricName: metric.MetricName,
+						Expression: metric.Expression,
+					})
+				}
+			}
+		}
+	}
+
+	d, err := r.client.UpdateDataset(ctx, dataset.Id, putData)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dataset with ID %d: %s", dataset.Id, err))
 		return
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	_, diags = pruneDatasetFolders(ctx, r.client, d)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *datasetMetricsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,252 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &DatasetOwnersResource{}
+var _ resource.ResourceWithImportState = &DatasetOwnersResource{}
+
+func NewDatasetOwnersResource() resource.Resource {
+	return &DatasetOwnersResource{}
+}
+
+type DatasetOwnersResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type datasetOwnersResourceModel struct {
+	datasetOwnersBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DatasetOwnersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_owners"
+}
+
+func (r *DatasetOwnersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the owners of an existing superset dataset, so that owners changed in the UI are reverted on the next apply. " +
+			"The listed users replace the owners of the dataset. " +
+			"Leave `owner_ids` of the `superset_dataset` resource unset when using this resource. " +
+			"Destroying this resource does not change the owners of the dataset.",
+
+		Attributes: map[string]schema.Attribute{
+			"dataset_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the dataset.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The table name of the dataset.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner_usernames": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The usernames of the owners of the dataset.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"owner_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the owners of the dataset.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *DatasetOwnersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// apply sets the owners of the dataset of model to its owner_usernames.
+func (r *DatasetOwnersResource) apply(ctx context.Context, model *datasetOwnersBaseModel) (*client.DatasetRestApiGet, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	dataset, err := r.client.FindDataset(ctx, model.DatasetName.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to find Dataset with name '%s': %s", model.DatasetName.ValueString(), err))
+		return nil, diags
+	}
+
+	unlock, lockDiags := lockDataset(ctx, r.client, dataset.Id)
+	diags.Append(lockDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	defer unlock()
+
+	var ownerIds []int
+	var notFoundUsers []string
+	for _, username := range model.usernames() {
+		user, err := r.client.FindUser(ctx, username)
+		if client.IsNotFound(err) {
+			notFoundUsers = append(notFoundUsers, username)
+			continue
+		} else if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to find user with username %s: %s", username, err))
+			return nil, diags
+		}
+		ownerIds = append(ownerIds, user.Id)
+	}
+	if len(notFoundUsers) > 0 {
+		diags.AddError("Invalid Users", fmt.Sprintf("The following users were not found: %v", notFoundUsers))
+		return nil, diags
+	}
+
+	d, err := r.client.UpdateDataset(ctx, dataset.Id, client.DatasetRestApiPut{Owners: ownerIds})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update owners of Dataset with ID %d: %s", dataset.Id, err))
+		return nil, diags
+	}
+	return d, diags
+}
+
+func (r *DatasetOwnersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data datasetOwnersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset_owners"))
+	defer cancel()
+
+	d, diags := r.apply(ctx, &data.datasetOwnersBaseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.updateState(ctx, d, data.usernames())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatasetOwnersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data datasetOwnersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset_owners"))
+	defer cancel()
+
+	if data.DatasetId.IsNull() {
+		// An imported resource, identified by the dataset name.
+		dataset, err := r.client.FindDataset(ctx, data.DatasetName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find Dataset with name '%s': %s", data.DatasetName.ValueString(), err))
+			return
+		}
+		data.DatasetId = types.Int64Value(int64(dataset.Id))
+	}
+
+	d, err := r.client.GetDataset(ctx, int(data.DatasetId.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Dataset with ID %d: %s", data.DatasetId.ValueInt64(), err))
+		return
+	}
+
+	usernames := data.usernames()
+	if data.ownersChanged(d) {
+		users, err := r.client.ListUsers(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users: %s", err))
+			return
+		}
+		tflog.Debug(ctx, "Dataset owners changed outside Terraform", map[string]interface{}{
+			"dataset_id": d.Id,
+		})
+		usernames = ownerUsernames(users, d)
+	}
+
+	data.updateState(ctx, d, usernames)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatasetOwnersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan datasetOwnersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "dataset_owners"))
+	defer cancel()
+
+	d, diags := r.apply(ctx, &plan.datasetOwnersBaseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.updateState(ctx, d, plan.usernames())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DatasetOwnersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The owners are left as they are, the resource is only removed from the state.
+}
+
+func (r *DatasetOwnersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	resp.State.SetAttribute(ctx, path.Root("dataset_name"), req.ID)
+}
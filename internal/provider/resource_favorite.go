@@ -0,0 +1,293 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &FavoriteResource{}
+var _ resource.ResourceWithConfigValidators = &FavoriteResource{}
+
+func NewFavoriteResource() resource.Resource {
+	return &FavoriteResource{}
+}
+
+type FavoriteResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type favoriteResourceModel struct {
+	favoriteBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *FavoriteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_favorite"
+}
+
+func (r *FavoriteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Mark dashboards and charts as favorites of a user, e.g. to curate the landing page of new team members. " +
+			"Superset only changes the favorites of the authenticated user, so the provider must authenticate as `username`, e.g. with a provider alias per user. " +
+			"Only the listed objects are managed, and the other favorites of the user are kept. " +
+			"Destroying the resource unmarks the listed objects.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the favorites, the username.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The username of the user, which must be the user the provider authenticates as.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dashboard_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the dashboards to mark as favorites.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"chart_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the charts to mark as favorites.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *FavoriteResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(path.MatchRoot("dashboard_ids"), path.MatchRoot("chart_ids")),
+	}
+}
+
+func (r *FavoriteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// authenticatedAs reports whether the provider authenticates as the user with the given username, which
+// returns the name of the authenticated user otherwise.
+func (r *FavoriteResource) authenticatedAs(ctx context.Context, username string) (string, bool, error) {
+	me, err := r.client.GetCurrentUser(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	return me.Username, me.Username == username, nil
+}
+
+// checkUser reports an error unless the provider authenticates as the user of model.
+func (r *FavoriteResource) checkUser(ctx context.Context, model *favoriteBaseModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, ok, err := r.authenticatedAs(ctx, model.Username.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get the authenticated user: %s", err))
+	} else if !ok {
+		diags.AddAttributeError(
+			path.Root("username"),
+			"Unexpected Authenticated User",
+			fmt.Sprintf("The provider authenticates as %q, but Superset only changes the favorites of the authenticated user. "+
+				"Use a provider configuration authenticated as %q for this resource.", current, model.Username.ValueString()),
+		)
+	}
+	return diags
+}
+
+// setFavorites marks the objects of favorite as favorites, or unmarks the objects of unfavorite, by type.
+func (r *FavoriteResource) setFavorites(ctx context.Context, favorite map[string][]int, unfavorite map[string][]int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, objectType := range favoriteObjectTypes {
+		for _, id := range favorite[objectType] {
+			if err := r.client.SetFavorite(ctx, objectType, id, true); err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to mark %s with ID %d as favorite: %s", objectType, id, err))
+				return diags
+			}
+		}
+		for _, id := range unfavorite[objectType] {
+			if err := r.client.SetFavorite(ctx, objectType, id, false); err != nil && !client.IsNotFound(err) {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to unmark %s with ID %d as favorite: %s", objectType, id, err))
+				return diags
+			}
+		}
+	}
+	return diags
+}
+
+// favoriteIds returns the IDs of the objects of model by type.
+func favoriteIds(model *favoriteBaseModel) map[string][]int {
+	ids := make(map[string][]int, len(favoriteObjectTypes))
+	for _, objectType := range favoriteObjectTypes {
+		ids[objectType] = int64SetToInts(*model.ids(objectType))
+	}
+	return ids
+}
+
+func (r *FavoriteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data favoriteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "favorite"))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.checkUser(ctx, &data.favoriteBaseModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setFavorites(ctx, favoriteIds(&data.favoriteBaseModel), nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.Username
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FavoriteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data favoriteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "favorite"))
+	defer cancel()
+
+	current, ok, err := r.authenticatedAs(ctx, data.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get the authenticated user: %s", err))
+		return
+	}
+	if !ok {
+		// The favorites of another user cannot be read, so the state is kept as is.
+		tflog.Warn(ctx, "Skipping the refresh of favorites of another user", map[string]interface{}{
+			"username":           data.Username.ValueString(),
+			"authenticated_user": current,
+		})
+		return
+	}
+
+	favorites := make(map[string]map[int]bool, len(favoriteObjectTypes))
+	for objectType, ids := range favoriteIds(&data.favoriteBaseModel) {
+		if len(ids) == 0 {
+			continue
+		}
+		favorites[objectType], err = r.client.FavoriteStatus(ctx, objectType, ids)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the favorite status of %s IDs %v: %s", objectType, ids, err))
+			return
+		}
+	}
+
+	data.updateState(ctx, favorites)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FavoriteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state favoriteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "favorite"))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.checkUser(ctx, &plan.favoriteBaseModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, prior := favoriteIds(&plan.favoriteBaseModel), favoriteIds(&state.favoriteBaseModel)
+	added, removed := make(map[string][]int), make(map[string][]int)
+	for _, objectType := range favoriteObjectTypes {
+		added[objectType] = idDifference(planned[objectType], prior[objectType])
+		removed[objectType] = idDifference(prior[objectType], planned[objectType])
+	}
+	resp.Diagnostics.Append(r.setFavorites(ctx, added, removed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = plan.Username
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FavoriteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state favoriteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "favorite"))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.checkUser(ctx, &state.favoriteBaseModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setFavorites(ctx, nil, favoriteIds(&state.favoriteBaseModel))...)
+}
@@ -102,7 +102,7 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "group"))
 	defer cancel()
 
 	postData := client.SupersetGroupApiPost{
@@ -142,7 +142,7 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "group"))
 	defer cancel()
 	g, err := r.client.GetGroup(ctx, int(data.Id.ValueInt64()))
 	if client.IsNotFound(err) {
@@ -168,7 +168,7 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "group"))
 	defer cancel()
 
 	putData := client.SupersetGroupApiPut{
@@ -195,7 +195,7 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "group"))
 	defer cancel()
 
 	err := r.client.DeleteGroup(ctx, int(state.Id.ValueInt64()))
@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -108,7 +109,7 @@ func (r *GroupRoleBindingResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "group_role_binding"))
 	defer cancel()
 
 	sourceRoles, err := r.client.ListRoles(ctx)
@@ -154,18 +155,29 @@ func (r *GroupRoleBindingResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "group_role_binding"))
 	defer cancel()
 
+	if data.GroupName.IsNull() {
+		// A resource imported by group ID.
+		g, err := r.client.GetGroup(ctx, int(data.GroupId.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get group with ID %d: %s", data.GroupId.ValueInt64(), err))
+			return
+		}
+		data.GroupName = types.StringValue(g.Name)
+	}
+
 	group, err := r.client.FindGroup(ctx, data.GroupName.ValueString())
 	if client.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	} else if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get group with ID %d Name: %s: %s,", data.GroupId.ValueInt64(), data.GroupName.ValueString(), err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find group with name %s: %s", data.GroupName.ValueString(), err))
 		return
 	}
 
+	// The roles are read from the group, so that importing an existing binding plans no changes.
 	data.updateState(group)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -180,7 +192,7 @@ func (r *GroupRoleBindingResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "group_role_binding"))
 	defer cancel()
 
 	sourceRoles, err := r.client.ListRoles(ctx)
@@ -214,7 +226,7 @@ func (r *GroupRoleBindingResource) Delete(ctx context.Context, req resource.Dele
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "group_role_binding"))
 	defer cancel()
 
 	groupId := int(state.GroupId.ValueInt64())
@@ -234,5 +246,10 @@ func (r *GroupRoleBindingResource) ImportState(ctx context.Context, req resource
 		"import_id": req.ID,
 	})
 
+	// The binding is imported by group ID or group name.
+	if id, err := strconv.ParseInt(req.ID, 10, 64); err == nil {
+		resp.State.SetAttribute(ctx, path.Root("group_id"), id)
+		return
+	}
 	resp.State.SetAttribute(ctx, path.Root("group_name"), req.ID)
 }
@@ -0,0 +1,257 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &ImportBundleResource{}
+var _ resource.ResourceWithConfigValidators = &ImportBundleResource{}
+var _ resource.ResourceWithModifyPlan = &ImportBundleResource{}
+var _ resource.ResourceWithValidateConfig = &ImportBundleResource{}
+
+func NewImportBundleResource() resource.Resource {
+	return &ImportBundleResource{}
+}
+
+type ImportBundleResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type importBundleResourceModel struct {
+	importBundleBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ImportBundleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_import_bundle"
+}
+
+func (r *ImportBundleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Import a Superset export bundle kept in source control, e.g. dashboards with their charts, datasets and databases. " +
+			"The bundle is a ZIP file as exported by Superset, or a directory holding the extracted export. " +
+			"The bundle is imported again whenever its content, the passwords or the options change. " +
+			"Use `superset_asset_promotion` instead to rewrite UUIDs or database connections for the target environment. " +
+			"Destroying the resource does not delete the imported objects.",
+
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The path of the bundle: a ZIP file, or a directory containing the `metadata.yaml` of the export. Hidden files of the directory, e.g. `.git`, are ignored. Conflicts with `content_base64`.",
+			},
+			"content_base64": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The ZIP bundle, base64 encoded, e.g. with `filebase64` or from the `content_base64` of `superset_dashboard_export`. " +
+					"Unlike `path`, it does not require the bundle on the filesystem of the apply, e.g. on remote Terraform Cloud agents. Conflicts with `path`.",
+			},
+			"object_type": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The type of the export, which selects the import endpoint of the type: `chart`, `dashboard`, `database`, `dataset` or `saved_query`. " +
+					"By default the bundle is imported as an export of all assets, with `/api/v1/assets/import/`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(importBundleObjectTypes...),
+				},
+			},
+			"overwrite": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				MarkdownDescription: "Whether to overwrite the existing objects with the same UUIDs. Importing an existing object fails otherwise. " +
+					"The import of all assets always overwrites, so it can only be unset with `object_type`. Defaults to `true`.",
+			},
+			"passwords": schema.MapAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The passwords of the databases of the bundle, keyed by their file in the bundle, e.g. `databases/examples.yaml`. Exported bundles do not contain passwords, so they are required to import databases using password authentication.",
+			},
+			"bundle_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 checksum of the imported bundle, after the export timestamp is removed.",
+			},
+			"assets": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The UUIDs of the imported objects, keyed by their file in the bundle, e.g. `dashboards/Sales_1.yaml`.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ImportBundleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("path"), path.MatchRoot("content_base64")),
+	}
+}
+
+func (r *ImportBundleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var objectType types.String
+	var overwrite types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("object_type"), &objectType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("overwrite"), &overwrite)...)
+	if resp.Diagnostics.HasError() || objectType.IsUnknown() || overwrite.IsUnknown() {
+		return
+	}
+
+	if objectType.IsNull() && !overwrite.IsNull() && !overwrite.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("overwrite"), "Unsupported Overwrite",
+			"The import of all assets always overwrites the existing objects. Set object_type to import the bundle without overwriting.")
+	}
+}
+
+func (r *ImportBundleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan computes the checksum of the bundle, so that a change of the bundle content is planned as
+// an update.
+func (r *ImportBundleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan importBundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Path.IsUnknown() || plan.ContentBase64.IsUnknown() {
+		return
+	}
+
+	if plan.ContentBase64.IsNull() && !bundleExists(plan.Path.ValueString()) {
+		// The bundle may be created by another resource during the apply.
+		tflog.Debug(ctx, "Bundle does not exist yet", map[string]interface{}{
+			"path": plan.Path.ValueString(),
+		})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("bundle_sha256"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("assets"), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	bundle, diags := plan.bundle(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.updateState(ctx, bundle)...)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *ImportBundleResource) importBundle(ctx context.Context, data *importBundleResourceModel) diag.Diagnostics {
+	bundle, diags := data.bundle(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Debug(ctx, "Importing bundle", map[string]interface{}{
+		"source":      data.source(),
+		"object_type": data.ObjectType.ValueString(),
+		"sha256":      bundle.sha256(),
+		"assets":      len(bundle.Assets),
+	})
+	var err error
+	if data.ObjectType.IsNull() {
+		err = r.client.ImportAssets(ctx, bundle.Data, bundle.Passwords)
+	} else {
+		err = r.client.ImportObjects(ctx, data.ObjectType.ValueString(), bundle.Data, bundle.Passwords, data.Overwrite.ValueBool())
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to import bundle '%s': %s", data.source(), err))
+		return diags
+	}
+
+	diags.Append(data.updateState(ctx, bundle)...)
+	return diags
+}
+
+func (r *ImportBundleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data importBundleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "import_bundle"))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.importBundle(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImportBundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The import has no server side identity to refresh. Changes of the bundle are detected at plan time.
+	var data importBundleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImportBundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan importBundleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "import_bundle"))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.importBundle(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ImportBundleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The imported objects are left in place, the resource is only removed from the state.
+}
@@ -0,0 +1,264 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &OwnerTransferResource{}
+
+func NewOwnerTransferResource() resource.Resource {
+	return &OwnerTransferResource{}
+}
+
+type OwnerTransferResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type ownerTransferResourceModel struct {
+	ownerTransferBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *OwnerTransferResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_owner_transfer"
+}
+
+func (r *OwnerTransferResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	assetTypes := make([]attr.Value, 0, len(ownerTransferAssetTypes))
+	for _, t := range ownerTransferAssetTypes {
+		assetTypes = append(assetTypes, types.StringValue(t))
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reassign the ownership of all datasets, charts and dashboards owned by a user to another user, e.g. when offboarding the user. " +
+			"The transfer runs once when the resource is created, and again whenever an argument or `triggers` changes. " +
+			"Destroying the resource does not give the assets back to the previous owner. " +
+			"Saved queries are not transferred, as the Superset API does not allow changing their owner.",
+
+		Attributes: map[string]schema.Attribute{
+			"from_username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The username of the current owner.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"to_username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The username of the new owner.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"from_user_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the current owner.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"to_user_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the new owner.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"asset_types": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, assetTypes)),
+				MarkdownDescription: "The types of assets to transfer. Valid values are `dataset`, `chart` and `dashboard`. Defaults to all of them.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(ownerTransferAssetTypes...)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"keep_previous_owner": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to add the new owner without removing the current one. Defaults to `false`.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that run the transfer again when they change, e.g. to pick up assets the user created since the last transfer.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"dataset_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the datasets transferred to the new owner.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"chart_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the charts transferred to the new owner.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dashboard_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The IDs of the dashboards transferred to the new owner.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *OwnerTransferResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+func (r *OwnerTransferResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ownerTransferResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "owner_transfer"))
+	defer cancel()
+
+	fromUser, err := r.client.FindUser(ctx, data.FromUsername.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user '%s': %s", data.FromUsername.ValueString(), err))
+		return
+	}
+	toUser, err := r.client.FindUser(ctx, data.ToUsername.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user '%s': %s", data.ToUsername.ValueString(), err))
+		return
+	}
+	if fromUser.Id == toUser.Id {
+		resp.Diagnostics.AddError("Invalid Owner Transfer", "from_username and to_username must be different users.")
+		return
+	}
+
+	transferred := make(map[string][]int)
+	for _, kind := range ownerTransferAssetTypes {
+		if !data.includesAssetType(kind) {
+			continue
+		}
+
+		assets, err := r.client.ListOwnedAssets(ctx, kind, fromUser.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list the %ss owned by '%s': %s", kind, data.FromUsername.ValueString(), err))
+			return
+		}
+
+		for _, asset := range assets {
+			owners := transferredOwners(asset.Owners, fromUser.Id, toUser.Id, data.KeepPreviousOwner.ValueBool())
+			tflog.Debug(ctx, "Transferring asset ownership", map[string]interface{}{
+				"kind":   kind,
+				"id":     asset.Id,
+				"name":   asset.Name,
+				"owners": owners,
+			})
+			if err := r.client.SetAssetOwners(ctx, kind, asset.Id, owners); err != nil {
+				// The assets transferred so far no longer belong to the current owner, so applying again resumes the transfer.
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to transfer %s '%s' (ID %d) to '%s': %s", kind, asset.Name, asset.Id, data.ToUsername.ValueString(), err))
+				return
+			}
+			transferred[kind] = append(transferred[kind], asset.Id)
+		}
+	}
+
+	data.FromUserId = types.Int64Value(int64(fromUser.Id))
+	data.ToUserId = types.Int64Value(int64(toUser.Id))
+	data.setTransferred(ctx, transferred)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OwnerTransferResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The transfer is a one-off action, so there is nothing to refresh.
+	var data ownerTransferResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OwnerTransferResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument requires replacement, so only the timeouts can change here.
+	var plan, state ownerTransferResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OwnerTransferResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The ownership is not transferred back, the resource is only removed from the state.
+}
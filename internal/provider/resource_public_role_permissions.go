@@ -0,0 +1,342 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+// defaultPublicRoleName is the default value of AUTH_ROLE_PUBLIC in Superset.
+const defaultPublicRoleName = "Public"
+
+var _ resource.Resource = &PublicRolePermissionsResource{}
+var _ resource.ResourceWithImportState = &PublicRolePermissionsResource{}
+var _ resource.ResourceWithValidateConfig = &PublicRolePermissionsResource{}
+
+func NewPublicRolePermissionsResource() resource.Resource {
+	return &PublicRolePermissionsResource{}
+}
+
+type PublicRolePermissionsResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type publicRolePermissionsResourceModel struct {
+	rolePermissionBaseModel
+	ConfirmPublicAccess types.Bool     `tfsdk:"confirm_public_access"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *PublicRolePermissionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_public_role_permissions"
+}
+
+func (r *PublicRolePermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the exact permission set of the role granted to anonymous users (`AUTH_ROLE_PUBLIC`). " +
+			"Any permission not listed is revoked, and destroying the resource revokes all permissions. " +
+			"Granting permissions requires `confirm_public_access = true`, since they become available to everyone who can reach the server.",
+
+		Attributes: map[string]schema.Attribute{
+			"role_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the public role.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultPublicRoleName),
+				MarkdownDescription: "The name of the public role. Must match `AUTH_ROLE_PUBLIC` in the Superset configuration. Defaults to `Public`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.SetNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the permission.",
+						},
+						"view_menu_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the view menu.",
+						},
+					},
+				},
+				MarkdownDescription: "The complete list of permissions granted to the public role. Set to an empty list to revoke all anonymous access.",
+			},
+			"except_permissions": schema.SetNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the permission, or a pattern of names.",
+						},
+						"view_menu_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the view menu, or a pattern of names.",
+						},
+					},
+				},
+				MarkdownDescription: "Permissions of `permissions` that are never granted to anonymous users, e.g. `{ permission_name = \"can_write\", view_menu_name = \"*\" }`. `*` matches any sequence of characters and `?` any single character. The patterns are matched against the permissions of the server at apply time.",
+			},
+			"confirm_public_access": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Must be set to `true` when `permissions` is not empty, to confirm that the permissions are meant to be granted to anonymous users.",
+			},
+			"resolve_dataset_ids": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the view menu names of dataset permissions in `permissions` may omit the `(id:N)` suffix, which differs between servers, e.g. `[examples].[public].[orders]` for `[examples].[public].[orders](id:3)`. The suffix is looked up on the server at apply time. Defaults to `false`.",
+			},
+			"pending_removals": pendingRemovalsAttribute("permissions of the public role", r.pendingRemovals),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *PublicRolePermissionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data publicRolePermissionsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validateDatasetIds()...)
+
+	if data.Permissions.IsNull() || data.Permissions.IsUnknown() || len(data.Permissions.Elements()) == 0 {
+		return
+	}
+
+	if data.ConfirmPublicAccess.IsUnknown() || data.ConfirmPublicAccess.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("confirm_public_access"),
+		"Public Access Not Confirmed",
+		fmt.Sprintf("The configuration grants %d permission(s) to the public role, which makes them available to anonymous users. "+
+			"Set confirm_public_access = true to confirm this is intended.", len(data.Permissions.Elements())),
+	)
+}
+
+func (r *PublicRolePermissionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// pendingRemovals returns the permissions of the public role that are not configured, which the update
+// revokes from anonymous users.
+func (r *PublicRolePermissionsResource) pendingRemovals(ctx context.Context, req planmodifier.SetRequest) ([]string, bool, diag.Diagnostics) {
+	return rolePermissionsPendingRemovals(ctx, r.client, req)
+}
+
+func (r *PublicRolePermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data publicRolePermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "public_role_permissions"))
+	defer cancel()
+
+	role, err := r.client.FindRole(ctx, data.RoleName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find public role with name %s: %s", data.RoleName.ValueString(), err))
+		return
+	}
+
+	sourcePermissions, err := r.client.ListPermissions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions: %s", err))
+		return
+	}
+
+	permissions, notFoundPermissions := data.resolvePermissions(sourcePermissions)
+	if len(notFoundPermissions) > 0 {
+		resp.Diagnostics.AddError("Invalid Permissions", fmt.Sprintf("The following permissions were not found: %v", notFoundPermissions))
+		return
+	}
+	permissionIds := make([]int, 0, len(permissions))
+	for _, permission := range permissions {
+		permissionIds = append(permissionIds, permission.Id)
+	}
+
+	err = r.client.AssignPermissionsToRole(ctx, role.Id, permissionIds)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set permissions of public role, got error: %s", err))
+		return
+	}
+
+	data.updateState(int64(role.Id), role.Name, permissions)
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, rolePermissionsLastModified(permissions))...)
+	data.PendingRemovals = appliedPendingRemovals(data.PendingRemovals)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PublicRolePermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data publicRolePermissionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "public_role_permissions"))
+	defer cancel()
+
+	role, err := r.client.FindRole(ctx, data.RoleName.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find public role with name %s: %s", data.RoleName.ValueString(), err))
+		return
+	}
+
+	permissions, err := listRolePermissions(ctx, r.client, role.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+		return
+	}
+
+	data.updateState(int64(role.Id), role.Name, permissions)
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, rolePermissionsLastModified(permissions))...)
+	data.PendingRemovals = pendingRemovalsValue(nil)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PublicRolePermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state publicRolePermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "public_role_permissions"))
+	defer cancel()
+
+	role, err := r.client.FindRole(ctx, plan.RoleName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find public role with name %s: %s", plan.RoleName.ValueString(), err))
+		return
+	}
+
+	currentPermissions, err := listRolePermissions(ctx, r.client, role.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(checkConcurrentModification(ctx, req.Private, r.client, client.ObjectKindRolePermissions, role.Id,
+		fmt.Sprintf("The permissions of public role '%s' (ID %d)", role.Name, role.Id), rolePermissionsLastModified(currentPermissions))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourcePermissions, err := r.client.ListPermissions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions: %s", err))
+		return
+	}
+
+	permissions, notFoundPermissions := plan.resolvePermissions(sourcePermissions)
+	if len(notFoundPermissions) > 0 {
+		resp.Diagnostics.AddError("Invalid Permissions", fmt.Sprintf("The following permissions were not found: %v", notFoundPermissions))
+		return
+	}
+	permissionIds := make([]int, 0, len(permissions))
+	for _, permission := range permissions {
+		permissionIds = append(permissionIds, permission.Id)
+	}
+
+	err = r.client.AssignPermissionsToRole(ctx, role.Id, permissionIds)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set permissions of public role, got error: %s", err))
+		return
+	}
+
+	plan.updateState(int64(role.Id), role.Name, permissions)
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, rolePermissionsLastModified(permissions))...)
+	plan.PendingRemovals = appliedPendingRemovals(plan.PendingRemovals)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PublicRolePermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state publicRolePermissionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "public_role_permissions"))
+	defer cancel()
+
+	role, err := r.client.FindRole(ctx, state.RoleName.ValueString())
+	if client.IsNotFound(err) {
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find public role with name %s: %s", state.RoleName.ValueString(), err))
+		return
+	}
+
+	// The public role itself is part of the server configuration, so only its permissions are revoked.
+	err = r.client.AssignPermissionsToRole(ctx, role.Id, []int{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke permissions of public role with ID %d: %s", role.Id, err))
+		return
+	}
+}
+
+func (r *PublicRolePermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	resp.State.SetAttribute(ctx, path.Root("role_name"), req.ID)
+}
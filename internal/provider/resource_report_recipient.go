@@ -0,0 +1,193 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ReportRecipientResource{}
+var _ resource.ResourceWithConfigValidators = &ReportRecipientResource{}
+var _ resource.ResourceWithModifyPlan = &ReportRecipientResource{}
+
+func NewReportRecipientResource() resource.Resource {
+	return &ReportRecipientResource{}
+}
+
+type ReportRecipientResource struct{}
+
+type reportRecipientResourceModel struct {
+	reportRecipientBaseModel
+}
+
+func (r *ReportRecipientResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_report_recipient"
+}
+
+func (r *ReportRecipientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	targetValidators := []validator.Set{
+		setvalidator.SizeAtLeast(1),
+		setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+	}
+	copyValidators := append(targetValidators, setvalidator.AlsoRequires(path.MatchRoot("emails")))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Define a named set of recipients that can be shared by several `superset_alert` and `superset_report` resources, " +
+			"by assigning its `recipients` to their `recipients`, e.g. `recipients = superset_report_recipient.sales.recipients`. " +
+			"Sets can be combined with `setunion`. The set only exists in the Terraform state: " +
+			"changing it updates every schedule that references it, and destroying it does not change Superset.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the recipient set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the recipient set, e.g. `sales-team`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"emails": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The email addresses the schedules are sent to.",
+				Validators:          targetValidators,
+			},
+			"cc_emails": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The email addresses a copy is sent to. Requires `emails`.",
+				Validators:          copyValidators,
+			},
+			"bcc_emails": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The email addresses a blind copy is sent to. Requires `emails`.",
+				Validators:          copyValidators,
+			},
+			"slack_channels": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The Slack channels the schedules are sent to. Use channel IDs with `SlackV2` and channel names with `Slack`.",
+				Validators:          targetValidators,
+			},
+			"slack_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("SlackV2"),
+				MarkdownDescription: "The recipient type used for `slack_channels`. `SlackV2` uses the Slack API v2 and is the default, `Slack` is the deprecated legacy integration.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("Slack", "SlackV2"),
+				},
+			},
+			"recipients": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The recipients, in the form of the `recipients` attribute of alerts and reports.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the recipient.",
+						},
+						"target": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The comma separated email addresses or Slack channels.",
+						},
+						"cc_target": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The comma separated email addresses a copy is sent to.",
+						},
+						"bcc_target": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The comma separated email addresses a blind copy is sent to.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ReportRecipientResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(path.MatchRoot("emails"), path.MatchRoot("slack_channels")),
+	}
+}
+
+// ModifyPlan computes the recipients at plan time, so the schedules referencing them show the
+// actual change in the plan.
+func (r *ReportRecipientResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan reportRecipientResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !plan.isKnown() || plan.Name.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.updateRecipients(ctx)...)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *ReportRecipientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data reportRecipientResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.updateRecipients(ctx)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReportRecipientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The recipient set only exists in the state, so there is nothing to refresh.
+	var data reportRecipientResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReportRecipientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan reportRecipientResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.updateRecipients(ctx)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ReportRecipientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Schedules keep the recipients they were last updated with, the set is only removed from the state.
+}
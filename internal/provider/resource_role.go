@@ -94,7 +94,7 @@ func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role"))
 	defer cancel()
 
 	postData := client.SupersetRoleApiPost{
@@ -131,7 +131,7 @@ func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role"))
 	defer cancel()
 	g, err := r.client.GetRole(ctx, int(data.Id.ValueInt64()))
 	if client.IsNotFound(err) {
@@ -157,7 +157,7 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role"))
 	defer cancel()
 
 	putData := client.SupersetRoleApiPut{
@@ -180,7 +180,7 @@ func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role"))
 	defer cancel()
 
 	err := r.client.DeleteRole(ctx, int(state.Id.ValueInt64()))
@@ -202,11 +202,13 @@ func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportState
 
 	id, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			fmt.Sprintf("Expected numeric ID, got %q: %s", req.ID, err),
-		)
-		return
+		// A role identified by name.
+		role, err := r.client.FindRole(ctx, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", req.ID, err))
+			return
+		}
+		id = int64(role.Id)
 	}
 
 	resp.State.SetAttribute(ctx, path.Root("id"), id)
@@ -0,0 +1,382 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &RoleHierarchyResource{}
+var _ resource.ResourceWithImportState = &RoleHierarchyResource{}
+var _ resource.ResourceWithModifyPlan = &RoleHierarchyResource{}
+var _ resource.ResourceWithValidateConfig = &RoleHierarchyResource{}
+
+func NewRoleHierarchyResource() resource.Resource {
+	return &RoleHierarchyResource{}
+}
+
+type RoleHierarchyResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type roleHierarchyResourceModel struct {
+	roleHierarchyBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *RoleHierarchyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_hierarchy"
+}
+
+func (r *RoleHierarchyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Compose an existing superset role from other roles, emulating the role inheritance Superset lacks. " +
+			"The role is granted the union of the permissions of the included roles, and the permissions none of them has are revoked. " +
+			"The permissions of the included roles are read when planning, so a change of an included role, e.g. of a built-in role after an upgrade, is planned as an update of the composed role. " +
+			"Do not manage the permissions of the role with `superset_role_permissions` as well. On destroy, only the permissions granted by this resource are revoked.",
+
+		Attributes: map[string]schema.Attribute{
+			"role_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the composed role.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the composed role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_roles": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the roles whose permissions the composed role is granted, e.g. `[\"Gamma\", \"sql_lab\"]`.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"permissions": schema.SetNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the permission.",
+						},
+						"view_menu_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the view menu.",
+						},
+					},
+				},
+				MarkdownDescription: "The permissions of the composed role: the union of the permissions of the included roles.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *RoleHierarchyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data roleHierarchyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.RoleName.IsUnknown() || data.IncludeRoles.IsUnknown() {
+		return
+	}
+
+	for _, name := range data.includeRoles() {
+		if name == data.RoleName.ValueString() {
+			resp.Diagnostics.AddAttributeError(path.Root("include_roles"), "Invalid Included Role",
+				fmt.Sprintf("The role %s cannot include itself.", name))
+		}
+	}
+}
+
+func (r *RoleHierarchyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// includedPermissions returns the union of the permissions of the included roles of model.
+func (r *RoleHierarchyResource) includedPermissions(ctx context.Context, model *roleHierarchyBaseModel) ([]client.SupersetRolePermissionApiGetList, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var roles [][]client.SupersetRolePermissionApiGetList
+	var notFoundRoles []string
+	for _, name := range model.includeRoles() {
+		role, err := r.client.FindRole(ctx, name)
+		if client.IsNotFound(err) {
+			notFoundRoles = append(notFoundRoles, name)
+			continue
+		} else if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", name, err))
+			return nil, diags
+		}
+		permissions, err := listRolePermissions(ctx, r.client, role.Id)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+			return nil, diags
+		}
+		roles = append(roles, permissions)
+	}
+	if len(notFoundRoles) > 0 {
+		diags.AddAttributeError(path.Root("include_roles"), "Invalid Roles", fmt.Sprintf("The following roles were not found: %v", notFoundRoles))
+		return nil, diags
+	}
+	return unionRolePermissions(roles...), diags
+}
+
+// ModifyPlan plans the permissions of the included roles, so that a change of an included role is
+// planned as an update of the composed role.
+func (r *RoleHierarchyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan roleHierarchyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.IncludeRoles.IsUnknown() {
+		return
+	}
+
+	permissions, diags := r.includedPermissions(ctx, &plan.roleHierarchyBaseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("permissions"), rolePermissionSetValue(permissions))...)
+}
+
+// plannedPermissions returns the union of the permissions of the included roles, and an error when it
+// differs from the permissions planned, as an included role changed in the meantime.
+func (r *RoleHierarchyResource) plannedPermissions(ctx context.Context, plan *roleHierarchyBaseModel) ([]client.SupersetRolePermissionApiGetList, diag.Diagnostics) {
+	permissions, diags := r.includedPermissions(ctx, plan)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if !plan.Permissions.IsUnknown() && !plan.Permissions.Equal(rolePermissionSetValue(permissions)) {
+		diags.AddError("Included Roles Changed",
+			fmt.Sprintf("The permissions of the roles included by %s changed after the plan. Run terraform plan again to review the new permissions.", plan.RoleName.ValueString()))
+		return nil, diags
+	}
+	return permissions, diags
+}
+
+func (r *RoleHierarchyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data roleHierarchyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role_hierarchy"))
+	defer cancel()
+
+	role, err := r.client.FindRole(ctx, data.RoleName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", data.RoleName.ValueString(), err))
+		return
+	}
+
+	permissions, diags := r.plannedPermissions(ctx, &data.roleHierarchyBaseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentPermissions, err := listRolePermissions(ctx, r.client, role.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+		return
+	}
+
+	tflog.Info(ctx, "Composing role permissions", map[string]interface{}{
+		"role_id":       role.Id,
+		"include_roles": data.includeRoles(),
+		"permissions":   len(permissions),
+	})
+	if err := r.client.AssignPermissionsToRole(ctx, role.Id, rolePermissionIdValues(permissions)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update role permissions, got error: %s", err))
+		return
+	}
+
+	data.updateState(role.Id, role.Name, permissions)
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, rolePermissionsLastModified(permissions))...)
+	resp.Diagnostics.Append(setCreatedIds(ctx, resp.Private, trackCreatedIds(nil, rolePermissionIds(currentPermissions), rolePermissionIds(permissions)))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleHierarchyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data roleHierarchyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role_hierarchy"))
+	defer cancel()
+
+	role, err := r.client.FindRole(ctx, data.RoleName.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", data.RoleName.ValueString(), err))
+		return
+	}
+
+	permissions, err := listRolePermissions(ctx, r.client, role.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+		return
+	}
+
+	data.updateState(role.Id, role.Name, permissions)
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, rolePermissionsLastModified(permissions))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleHierarchyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan roleHierarchyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role_hierarchy"))
+	defer cancel()
+
+	role, err := r.client.FindRole(ctx, plan.RoleName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", plan.RoleName.ValueString(), err))
+		return
+	}
+
+	currentPermissions, err := listRolePermissions(ctx, r.client, role.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(checkConcurrentModification(ctx, req.Private, r.client, client.ObjectKindRolePermissions, role.Id,
+		fmt.Sprintf("The permissions of role '%s' (ID %d)", role.Name, role.Id), rolePermissionsLastModified(currentPermissions))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, diags := r.plannedPermissions(ctx, &plan.roleHierarchyBaseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grant, revoke := rolePermissionChanges(currentPermissions, permissions)
+	tflog.Info(ctx, "Updating composed role permissions", map[string]interface{}{
+		"role_id": role.Id,
+		"granted": rolePermissionNames(grant),
+		"revoked": rolePermissionNames(revoke),
+	})
+	err = r.client.ChangeRolePermissions(ctx, role.Id, rolePermissionIdValues(currentPermissions), rolePermissionIdValues(grant), rolePermissionIdValues(revoke))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update role permissions, got error: %s", err))
+		return
+	}
+
+	plan.updateState(role.Id, role.Name, permissions)
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, rolePermissionsLastModified(permissions))...)
+	created, diags := getCreatedIds(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if created != nil {
+		resp.Diagnostics.Append(setCreatedIds(ctx, resp.Private, trackCreatedIds(created, rolePermissionIds(currentPermissions), rolePermissionIds(permissions)))...)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RoleHierarchyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state roleHierarchyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role_hierarchy"))
+	defer cancel()
+
+	role, err := r.client.FindRole(ctx, state.RoleName.ValueString())
+	if client.IsNotFound(err) {
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", state.RoleName.ValueString(), err))
+		return
+	}
+
+	// Only revoke the permissions this resource granted, when they were recorded, and keep
+	// the ones the role had before.
+	permissionIds := []int{}
+	created, diags := getCreatedIds(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if created != nil {
+		permissions, err := listRolePermissions(ctx, r.client, role.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+			return
+		}
+		for _, permission := range permissions {
+			if !created[strconv.Itoa(permission.Id)] {
+				permissionIds = append(permissionIds, permission.Id)
+			}
+		}
+	}
+
+	if err := r.client.AssignPermissionsToRole(ctx, role.Id, permissionIds); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke the permissions of role with ID %d: %s", role.Id, err))
+	}
+}
+
+func (r *RoleHierarchyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	resp.State.SetAttribute(ctx, path.Root("role_name"), req.ID)
+}
@@ -6,12 +6,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -44,7 +47,7 @@ func (r *RolePermissionsResource) Metadata(ctx context.Context, req resource.Met
 
 func (r *RolePermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manage a superset role with permissions",
+		MarkdownDescription: "Manage a superset role with permissions. On destroy, only the permissions granted by this resource are revoked.",
 
 		Attributes: map[string]schema.Attribute{
 			"role_id": schema.Int64Attribute{
@@ -80,6 +83,29 @@ func (r *RolePermissionsResource) Schema(ctx context.Context, req resource.Schem
 				},
 				MarkdownDescription: "The list of permissions assigned to the role.",
 			},
+			"except_permissions": schema.SetNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the permission, or a pattern of names.",
+						},
+						"view_menu_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the view menu, or a pattern of names.",
+						},
+					},
+				},
+				MarkdownDescription: "Permissions of `permissions` that are never granted, e.g. `{ permission_name = \"can_sql_json\", view_menu_name = \"*\" }`, so a large list such as the permissions of a built-in role can be reused without editing it. `*` matches any sequence of characters and `?` any single character. The patterns are matched against the permissions of the server at apply time.",
+			},
+			"resolve_dataset_ids": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the view menu names of dataset permissions in `permissions` may omit the `(id:N)` suffix, which differs between servers, e.g. `[examples].[public].[orders]` for `[examples].[public].[orders](id:3)`. The suffix is looked up on the server at apply time. Defaults to `false`.",
+			},
+			"pending_removals": pendingRemovalsAttribute("permissions of the role", r.pendingRemovals),
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true, Update: true, Delete: true,
 			}),
@@ -98,6 +124,8 @@ func (r *RolePermissionsResource) ValidateConfig(
 		return
 	}
 
+	resp.Diagnostics.Append(data.validateDatasetIds()...)
+
 	if data.Permissions.IsNull() || data.Permissions.IsUnknown() {
 		return
 	}
@@ -178,6 +206,21 @@ func (r *RolePermissionsResource) Configure(ctx context.Context, req resource.Co
 	r.client = c
 }
 
+// pendingRemovals returns the permissions of the role that are not configured, which the update revokes.
+func (r *RolePermissionsResource) pendingRemovals(ctx context.Context, req planmodifier.SetRequest) ([]string, bool, diag.Diagnostics) {
+	return rolePermissionsPendingRemovals(ctx, r.client, req)
+}
+
+// listRolePermissions returns the current permissions of a role that is known to exist. The client
+// reports a role without permissions as not found, which is an empty list here.
+func listRolePermissions(ctx context.Context, c *client.ClientWrapper, roleId int) ([]client.SupersetRolePermissionApiGetList, error) {
+	permissions, err := c.ListRolePermissions(ctx, roleId)
+	if client.IsNotFound(err) {
+		return nil, nil
+	}
+	return permissions, err
+}
+
 func (r *RolePermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data rolePermissionsResourceModel
 
@@ -187,7 +230,7 @@ func (r *RolePermissionsResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role_permissions"))
 	defer cancel()
 
 	role, err := r.client.FindRole(ctx, data.RoleName.ValueString())
@@ -211,6 +254,12 @@ func (r *RolePermissionsResource) Create(ctx context.Context, req resource.Creat
 		permissionIds = append(permissionIds, permission.Id)
 	}
 
+	currentPermissions, err := listRolePermissions(ctx, r.client, role.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+		return
+	}
+
 	err = r.client.AssignPermissionsToRole(ctx, role.Id, permissionIds)
 
 	if err != nil {
@@ -219,6 +268,9 @@ func (r *RolePermissionsResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	data.updateState(int64(role.Id), role.Name, permissions)
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, rolePermissionsLastModified(permissions))...)
+	resp.Diagnostics.Append(setCreatedIds(ctx, resp.Private, trackCreatedIds(nil, rolePermissionIds(currentPermissions), rolePermissionIds(permissions)))...)
+	data.PendingRemovals = appliedPendingRemovals(data.PendingRemovals)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -231,7 +283,7 @@ func (r *RolePermissionsResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role_permissions"))
 	defer cancel()
 
 	role, err := r.client.FindRole(ctx, data.RoleName.ValueString())
@@ -243,13 +295,15 @@ func (r *RolePermissionsResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	permissions, err := r.client.ListRolePermissions(ctx, role.Id)
+	permissions, err := listRolePermissions(ctx, r.client, role.Id)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
 		return
 	}
 
 	data.updateState(int64(role.Id), role.Name, permissions)
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, rolePermissionsLastModified(permissions))...)
+	data.PendingRemovals = pendingRemovalsValue(nil)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -263,7 +317,7 @@ func (r *RolePermissionsResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role_permissions"))
 	defer cancel()
 
 	role, err := r.client.FindRole(ctx, plan.RoleName.ValueString())
@@ -271,7 +325,19 @@ func (r *RolePermissionsResource) Update(ctx context.Context, req resource.Updat
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", plan.RoleName.ValueString(), err))
 		return
 	}
-	permissionIds := make([]int, 0, len(plan.Permissions.Elements()))
+
+	currentPermissions, err := listRolePermissions(ctx, r.client, role.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(checkConcurrentModification(ctx, req.Private, r.client, client.ObjectKindRolePermissions, role.Id,
+		fmt.Sprintf("The permissions of role '%s' (ID %d)", role.Name, role.Id), rolePermissionsLastModified(currentPermissions))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sourcePermissions, err := r.client.ListPermissions(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions: %s", err))
@@ -282,18 +348,30 @@ func (r *RolePermissionsResource) Update(ctx context.Context, req resource.Updat
 		resp.Diagnostics.AddError("Invalid Permissions", fmt.Sprintf("The following permissions were not found: %v", notFoundPermissions))
 		return
 	}
-	for _, permission := range permissions {
-		permissionIds = append(permissionIds, permission.Id)
-	}
 
-	err = r.client.AssignPermissionsToRole(ctx, role.Id, permissionIds)
+	// Only the changes are logged, so that small changes of large roles are reviewable. They are applied
+	// to the permissions of the role read again just before the whole list is written.
+	grant, revoke := rolePermissionChanges(currentPermissions, permissions)
+	tflog.Info(ctx, "Updating role permissions", map[string]interface{}{
+		"role_id": role.Id,
+		"granted": rolePermissionNames(grant),
+		"revoked": rolePermissionNames(revoke),
+	})
+	err = r.client.ChangeRolePermissions(ctx, role.Id, rolePermissionIdValues(currentPermissions), rolePermissionIdValues(grant), rolePermissionIdValues(revoke))
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create role, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update role permissions, got error: %s", err))
 		return
 	}
 
 	state.updateState(int64(role.Id), role.Name, permissions)
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, rolePermissionsLastModified(permissions))...)
+	created, diags := getCreatedIds(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if created != nil {
+		resp.Diagnostics.Append(setCreatedIds(ctx, resp.Private, trackCreatedIds(created, rolePermissionIds(currentPermissions), rolePermissionIds(permissions)))...)
+	}
+	state.PendingRemovals = appliedPendingRemovals(plan.PendingRemovals)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -302,7 +380,7 @@ func (r *RolePermissionsResource) Delete(ctx context.Context, req resource.Delet
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role_permissions"))
 	defer cancel()
 
 	role, err := r.client.FindRole(ctx, state.RoleName.ValueString())
@@ -311,7 +389,25 @@ func (r *RolePermissionsResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	err = r.client.AssignPermissionsToRole(ctx, role.Id, []int{})
+	// Only revoke the permissions this resource granted, when they were recorded, and keep
+	// the ones the role had before.
+	permissionIds := []int{}
+	created, diags := getCreatedIds(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if created != nil {
+		permissions, err := listRolePermissions(ctx, r.client, role.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+			return
+		}
+		for _, permission := range permissions {
+			if !created[strconv.Itoa(permission.Id)] {
+				permissionIds = append(permissionIds, permission.Id)
+			}
+		}
+	}
+
+	err = r.client.AssignPermissionsToRole(ctx, role.Id, permissionIds)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete role with ID %d: %s", role.Id, err))
 		return
@@ -3,4 +3,60 @@
 
 package provider
 
-// Add tests for role permissions resource here in the future.
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRolePermissionsCreateEmptyRole(t *testing.T) {
+	var assigned []int
+	server := testSupersetServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v1/security/roles/":
+			writeTestJSON(t, w, map[string]interface{}{"count": 1, "result": []map[string]interface{}{{"id": 5, "name": "Empty"}}})
+		case "GET /api/v1/security/permissions-resources/":
+			writeTestJSON(t, w, map[string]interface{}{"count": 1, "result": []map[string]interface{}{
+				{"id": 1, "permission": map[string]string{"name": "can_read"}, "view_menu": map[string]string{"name": "Chart"}},
+			}})
+		case "GET /api/v1/security/roles/5/permissions/":
+			// A role without permissions.
+			writeTestJSON(t, w, map[string]interface{}{"result": []interface{}{}})
+		case "POST /api/v1/security/roles/5/permissions":
+			var body struct {
+				PermissionViewMenuIds []int `json:"permission_view_menu_ids"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+			assigned = body.PermissionViewMenuIds
+			writeTestJSON(t, w, map[string]interface{}{})
+		default:
+			t.Logf("unexpected request %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	})
+
+	provider, schemas := testProviderServer(t, server.URL)
+	permissionType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"permission_name": tftypes.String,
+		"view_menu_name":  tftypes.String,
+	}}
+	res := testCreateResource(t, provider, schemas, "superset_role_permissions", map[string]tftypes.Value{
+		"role_name": tftypes.NewValue(tftypes.String, "Empty"),
+		"permissions": tftypes.NewValue(tftypes.Set{ElementType: permissionType}, []tftypes.Value{
+			tftypes.NewValue(permissionType, map[string]tftypes.Value{
+				"permission_name": tftypes.NewValue(tftypes.String, "can_read"),
+				"view_menu_name":  tftypes.NewValue(tftypes.String, "Chart"),
+			}),
+		}),
+	})
+	checkTestDiagnostics(t, res.Diagnostics)
+
+	if !reflect.DeepEqual(assigned, []int{1}) {
+		t.Errorf("unexpected assigned permissions: %v", assigned)
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &RoleUserBindingResource{}
+var _ resource.ResourceWithImportState = &RoleUserBindingResource{}
+
+func NewRoleUserBindingResource() resource.Resource {
+	return &RoleUserBindingResource{}
+}
+
+type RoleUserBindingResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type roleUserBindingResourceModel struct {
+	roleUserBindingBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *RoleUserBindingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_user_binding"
+}
+
+func (r *RoleUserBindingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource for managing the users of a role in Superset. " +
+			"By default the binding is authoritative, and users of the role that are not listed are removed from it. " +
+			"With `authoritative = false`, only the listed users are managed, so several bindings, or users assigned outside Terraform, can share a role.",
+
+		Attributes: map[string]schema.Attribute{
+			"role_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the role.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"usernames": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Usernames of the users to assign to the role.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"authoritative": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether `usernames` are the only users of the role. When `false`, other users of the role are kept, and only the users of `usernames` are removed from the role on destroy. Defaults to `true`.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *RoleUserBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// apply assigns the users of plan to its role, and removes the users of prior, the state before the
+// change or nil on create, that plan no longer lists. It returns the role and its users afterwards.
+func (r *RoleUserBindingResource) apply(ctx context.Context, prior *roleUserBindingBaseModel, plan *roleUserBindingBaseModel) (*client.SupersetRoleApiGetList, []client.SupersetUserApiGetList, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	role, err := r.client.FindRole(ctx, plan.RoleName.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", plan.RoleName.ValueString(), err))
+		return nil, nil, diags
+	}
+
+	users, err := r.client.ListUsers(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list users: %s", err))
+		return nil, nil, diags
+	}
+	userIds, notFoundUsers := resolveUserIds(users, plan.usernames())
+	if len(notFoundUsers) > 0 {
+		diags.AddError("Invalid Users", fmt.Sprintf("The following users were not found: %v", notFoundUsers))
+		return nil, nil, diags
+	}
+
+	if !plan.Authoritative.ValueBool() {
+		var removed []int
+		if prior != nil {
+			removed, _ = resolveUserIds(users, nameDifference(prior.usernames(), plan.usernames()))
+		}
+		userIds = roleUserIds(users, role.Id, userIds, removed)
+	}
+
+	err = r.client.AssignUsersToRole(ctx, role.Id, userIds)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to assign users to role ID %d: %s", role.Id, err))
+		return nil, nil, diags
+	}
+
+	users, err = r.client.ListUsers(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list users: %s", err))
+		return nil, nil, diags
+	}
+	return role, users, diags
+}
+
+func (r *RoleUserBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data roleUserBindingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role_user_binding"))
+	defer cancel()
+
+	role, users, diags := r.apply(ctx, nil, &data.roleUserBindingBaseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.updateState(role, users)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleUserBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data roleUserBindingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role_user_binding"))
+	defer cancel()
+
+	role, err := r.client.FindRole(ctx, data.RoleName.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", data.RoleName.ValueString(), err))
+		return
+	}
+
+	users, err := r.client.ListUsers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users: %s", err))
+		return
+	}
+
+	if data.Authoritative.IsNull() {
+		// An imported binding.
+		data.Authoritative = types.BoolValue(true)
+	}
+	data.updateState(role, users)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleUserBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state roleUserBindingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role_user_binding"))
+	defer cancel()
+
+	role, users, diags := r.apply(ctx, &state.roleUserBindingBaseModel, &plan.roleUserBindingBaseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.updateState(role, users)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RoleUserBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state roleUserBindingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "role_user_binding"))
+	defer cancel()
+
+	roleId := int(state.RoleId.ValueInt64())
+	userIds := []int{}
+	if !state.Authoritative.ValueBool() {
+		users, err := r.client.ListUsers(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users: %s", err))
+			return
+		}
+		removed, _ := resolveUserIds(users, state.usernames())
+		userIds = roleUserIds(users, roleId, nil, removed)
+	}
+
+	err := r.client.AssignUsersToRole(ctx, roleId, userIds)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove users from role ID %d: %s", roleId, err))
+		return
+	}
+}
+
+func (r *RoleUserBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	resp.State.SetAttribute(ctx, path.Root("role_name"), req.ID)
+}
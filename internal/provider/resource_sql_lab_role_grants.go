@@ -0,0 +1,389 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &SqlLabRoleGrantsResource{}
+var _ resource.ResourceWithImportState = &SqlLabRoleGrantsResource{}
+
+func NewSqlLabRoleGrantsResource() resource.Resource {
+	return &SqlLabRoleGrantsResource{}
+}
+
+type SqlLabRoleGrantsResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type sqlLabRoleGrantsResourceModel struct {
+	sqlLabRoleGrantsBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *SqlLabRoleGrantsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_lab_role_grants"
+}
+
+func (r *SqlLabRoleGrantsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grant a superset role everything it needs to use SQL Lab against a database: " +
+			"the SQL Lab menu, query execution and access to the database. " +
+			"The permissions are added to the permissions the role already has, so do not use this resource together with `superset_role_permissions` for the same role. " +
+			"The SQL Lab permissions shared by all databases are only revoked when the role has no database access left.",
+
+		Attributes: map[string]schema.Attribute{
+			"role_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the role.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the database.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the database the role can query in SQL Lab.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow_csv_export": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the role can export query results to CSV. Defaults to `false`.",
+			},
+			"permissions": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The permissions granted to the role by this resource.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the permission.",
+						},
+						"view_menu_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the view menu.",
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *SqlLabRoleGrantsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+func (r *SqlLabRoleGrantsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data sqlLabRoleGrantsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "sql_lab_role_grants"))
+	defer cancel()
+
+	role, err := r.client.FindRole(ctx, data.RoleName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", data.RoleName.ValueString(), err))
+		return
+	}
+	database, err := r.client.FindDatabase(ctx, data.DatabaseName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find database with name %s: %s", data.DatabaseName.ValueString(), err))
+		return
+	}
+
+	sourcePermissions, err := r.client.ListPermissions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions: %s", err))
+		return
+	}
+	grants, notFoundPermissions := data.resolvePermissions(sourcePermissions, database)
+	if len(notFoundPermissions) > 0 {
+		resp.Diagnostics.AddError("Invalid Permissions", fmt.Sprintf("The following permissions required for SQL Lab were not found: %v", notFoundPermissions))
+		return
+	}
+
+	current, err := listRolePermissions(ctx, r.client, role.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+		return
+	}
+
+	err = r.client.AssignPermissionsToRole(ctx, role.Id, mergeRolePermissionIds(current, grants, nil))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to grant SQL Lab permissions to role ID %d: %s", role.Id, err))
+		return
+	}
+
+	data.updateState(role, database, grants)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SqlLabRoleGrantsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data sqlLabRoleGrantsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "sql_lab_role_grants"))
+	defer cancel()
+
+	role, err := r.client.FindRole(ctx, data.RoleName.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", data.RoleName.ValueString(), err))
+		return
+	}
+	database, err := r.client.FindDatabase(ctx, data.DatabaseName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find database with name %s: %s", data.DatabaseName.ValueString(), err))
+		return
+	}
+
+	current, err := listRolePermissions(ctx, r.client, role.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+		return
+	}
+
+	currentSet := make(map[requiredPermission]client.SupersetRolePermissionApiGetList, len(current))
+	for _, p := range current {
+		currentSet[requiredPermission{p.PermissionName, p.ViewMenuName}] = p
+	}
+
+	granted := data.grantedPermissions()
+	if len(granted) == 0 {
+		// Imported, so resolve the permissions this resource would grant.
+		_, csvExport := currentSet[sqlLabCsvExportPermissions[0]]
+		data.AllowCsvExport = types.BoolValue(csvExport)
+		sourcePermissions, err := r.client.ListPermissions(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions: %s", err))
+			return
+		}
+		grants, _ := data.resolvePermissions(sourcePermissions, database)
+		for _, p := range grants {
+			granted = append(granted, requiredPermission{p.PermissionName, p.ViewMenuName})
+		}
+	}
+
+	var grants []client.SupersetRolePermissionApiGetList
+	for _, p := range granted {
+		c, ok := currentSet[p]
+		if !ok {
+			tflog.Debug(ctx, "SQL Lab permission was revoked outside Terraform", map[string]interface{}{
+				"role_id":    role.Id,
+				"permission": p.String(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		grants = append(grants, c)
+	}
+
+	data.updateState(role, database, grants)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SqlLabRoleGrantsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state sqlLabRoleGrantsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "sql_lab_role_grants"))
+	defer cancel()
+
+	role, err := r.client.FindRole(ctx, plan.RoleName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", plan.RoleName.ValueString(), err))
+		return
+	}
+	database, err := r.client.FindDatabase(ctx, plan.DatabaseName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find database with name %s: %s", plan.DatabaseName.ValueString(), err))
+		return
+	}
+
+	sourcePermissions, err := r.client.ListPermissions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions: %s", err))
+		return
+	}
+	grants, notFoundPermissions := plan.resolvePermissions(sourcePermissions, database)
+	if len(notFoundPermissions) > 0 {
+		resp.Diagnostics.AddError("Invalid Permissions", fmt.Sprintf("The following permissions required for SQL Lab were not found: %v", notFoundPermissions))
+		return
+	}
+
+	current, err := listRolePermissions(ctx, r.client, role.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+		return
+	}
+
+	err = r.client.AssignPermissionsToRole(ctx, role.Id, mergeRolePermissionIds(current, grants, state.grantedPermissions()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to grant SQL Lab permissions to role ID %d: %s", role.Id, err))
+		return
+	}
+
+	plan.updateState(role, database, grants)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SqlLabRoleGrantsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sqlLabRoleGrantsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "sql_lab_role_grants"))
+	defer cancel()
+
+	role, err := r.client.FindRole(ctx, state.RoleName.ValueString())
+	if client.IsNotFound(err) {
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find role with name %s: %s", state.RoleName.ValueString(), err))
+		return
+	}
+
+	current, err := listRolePermissions(ctx, r.client, role.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions for role ID %d: %s", role.Id, err))
+		return
+	}
+
+	// The SQL Lab permissions are shared with the grants of other databases, so they are only
+	// revoked together with the last database access of the role.
+	granted := state.grantedPermissions()
+	var revoke []requiredPermission
+	otherDatabaseAccess := false
+	for _, p := range current {
+		if p.PermissionName == "database_access" && !slices.Contains(granted, requiredPermission{p.PermissionName, p.ViewMenuName}) {
+			otherDatabaseAccess = true
+		}
+	}
+	for _, p := range granted {
+		if !otherDatabaseAccess || p.PermissionName == "database_access" {
+			revoke = append(revoke, p)
+		}
+	}
+
+	err = r.client.AssignPermissionsToRole(ctx, role.Id, mergeRolePermissionIds(current, nil, revoke))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke SQL Lab permissions from role ID %d: %s", role.Id, err))
+		return
+	}
+}
+
+func (r *SqlLabRoleGrantsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	roleName, databaseName, ok := strings.Cut(req.ID, "/")
+	if !ok || roleName == "" || databaseName == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected import ID in the format <role_name>/<database_name>, got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_name"), roleName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database_name"), databaseName)...)
+}
+
+// mergeRolePermissionIds returns the permission IDs of current without revoke, plus the IDs of grant.
+func mergeRolePermissionIds(current []client.SupersetRolePermissionApiGetList, grant []client.SupersetRolePermissionApiGetList, revoke []requiredPermission) []int {
+	seen := make(map[int]bool, len(current)+len(grant))
+	ids := make([]int, 0, len(current)+len(grant))
+	for _, p := range current {
+		if slices.Contains(revoke, requiredPermission{p.PermissionName, p.ViewMenuName}) || seen[p.Id] {
+			continue
+		}
+		seen[p.Id] = true
+		ids = append(ids, p.Id)
+	}
+	for _, p := range grant {
+		if seen[p.Id] {
+			continue
+		}
+		seen[p.Id] = true
+		ids = append(ids, p.Id)
+	}
+
+	return ids
+}
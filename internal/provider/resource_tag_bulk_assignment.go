@@ -0,0 +1,349 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &TagBulkAssignmentResource{}
+var _ resource.ResourceWithImportState = &TagBulkAssignmentResource{}
+
+func NewTagBulkAssignmentResource() resource.Resource {
+	return &TagBulkAssignmentResource{}
+}
+
+type TagBulkAssignmentResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type tagBulkAssignmentResourceModel struct {
+	tagBulkAssignmentBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *TagBulkAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_bulk_assignment"
+}
+
+func (r *TagBulkAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	objectsValidators := []validator.Set{
+		setvalidator.SizeAtLeast(1),
+		setvalidator.AtLeastOneOf(path.MatchRoot("dashboards"), path.MatchRoot("charts"), path.MatchRoot("datasets")),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assign a tag to many dashboards, charts and datasets by name, e.g. to label all assets of a domain with `domain:finance`. " +
+			"Objects are tagged in chunks of `chunk_size` per request, and the tag is created when it does not exist. " +
+			"Only the listed objects are managed: objects tagged outside Terraform keep the tag, and the tag itself is not deleted.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the tag.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the tag, e.g. `domain:finance`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dashboards": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The titles of the dashboards to tag.",
+				Validators:          objectsValidators,
+			},
+			"charts": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the charts to tag.",
+				Validators:          objectsValidators,
+			},
+			"datasets": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The table names of the datasets to tag.",
+				Validators:          objectsValidators,
+			},
+			"chunk_size": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultTagChunkSize),
+				MarkdownDescription: fmt.Sprintf("The number of objects tagged per request. Defaults to %d.", defaultTagChunkSize),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *TagBulkAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+func (r *TagBulkAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data tagBulkAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "tag_bulk_assignment"))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &tagBulkAssignmentBaseModel{}, &data.tagBulkAssignmentBaseModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = data.Tag
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TagBulkAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data tagBulkAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "tag_bulk_assignment"))
+	defer cancel()
+
+	tagged, err := r.taggedObjects(ctx, data.Tag.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read objects tagged with %s: %s", data.Tag.ValueString(), err))
+		return
+	}
+
+	data.updateState(tagged)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TagBulkAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state tagBulkAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "tag_bulk_assignment"))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &state.tagBulkAssignmentBaseModel, &plan.tagBulkAssignmentBaseModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Id = plan.Tag
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TagBulkAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tagBulkAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "tag_bulk_assignment"))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &state.tagBulkAssignmentBaseModel, &tagBulkAssignmentBaseModel{Tag: state.Tag})...)
+}
+
+func (r *TagBulkAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("chunk_size"), int64(defaultTagChunkSize))...)
+}
+
+// taggedObjects returns the objects the tag named tagName is assigned to.
+func (r *TagBulkAssignmentResource) taggedObjects(ctx context.Context, tagName string) ([]client.TaggedObject, error) {
+	tag, err := r.client.FindTag(ctx, tagName)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.ListTaggedObjects(ctx, tag.Id)
+}
+
+// apply removes the tag from the objects of prior that are not in planned, and assigns it to the objects
+// of planned that are not in prior.
+func (r *TagBulkAssignmentResource) apply(ctx context.Context, prior *tagBulkAssignmentBaseModel, planned *tagBulkAssignmentBaseModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	tagName := planned.Tag.ValueString()
+
+	var removed []string
+	for _, objectType := range taggedObjectTypes {
+		for _, name := range nameDifference(prior.objectNames(objectType), planned.objectNames(objectType)) {
+			removed = append(removed, objectType+"/"+name)
+		}
+	}
+	if len(removed) > 0 {
+		tagged, err := r.taggedObjects(ctx, tagName)
+		if client.IsNotFound(err) {
+			tagged = nil
+		} else if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read objects tagged with %s: %s", tagName, err))
+			return diags
+		}
+
+		for _, o := range tagged {
+			if !slices.Contains(removed, o.Type+"/"+o.Name) {
+				continue
+			}
+			if err := r.client.UntagObject(ctx, tagName, o); err != nil && !client.IsNotFound(err) {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to remove tag %s from %s %s: %s", tagName, o.Type, o.Name, err))
+				return diags
+			}
+		}
+	}
+
+	var added []client.TaggedObject
+	for _, objectType := range taggedObjectTypes {
+		names := nameDifference(planned.objectNames(objectType), prior.objectNames(objectType))
+		if len(names) == 0 {
+			continue
+		}
+		objects, err := r.findObjects(ctx, objectType, names)
+		if err != nil {
+			diags.AddAttributeError(path.Root(objectType+"s"), "Client Error", fmt.Sprintf("Unable to find the %ss to tag: %s", objectType, err))
+			return diags
+		}
+		added = append(added, objects...)
+	}
+
+	chunkSize := int(planned.ChunkSize.ValueInt64())
+	if chunkSize <= 0 {
+		chunkSize = defaultTagChunkSize
+	}
+	for chunk := range slices.Chunk(added, chunkSize) {
+		if err := r.client.TagObjects(ctx, tagName, chunk); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to tag %d objects with %s: %s", len(chunk), tagName, err))
+			return diags
+		}
+	}
+	return diags
+}
+
+// findObjects returns the objects of objectType named names, listing all objects of the type once
+// instead of looking up every name.
+func (r *TagBulkAssignmentResource) findObjects(ctx context.Context, objectType string, names []string) ([]client.TaggedObject, error) {
+	var all []client.TaggedObject
+	switch objectType {
+	case client.TaggedObjectTypeDashboard:
+		dashboards, err := r.client.ListDashboards(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range dashboards {
+			title, _ := d.DashboardTitle.Get()
+			all = append(all, client.TaggedObject{Type: objectType, Id: d.Id, Name: title})
+		}
+	case client.TaggedObjectTypeChart:
+		charts, err := r.client.ListCharts(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range charts {
+			name, _ := c.SliceName.Get()
+			all = append(all, client.TaggedObject{Type: objectType, Id: c.Id, Name: name})
+		}
+	default:
+		datasets, err := r.client.ListDatasets(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range datasets {
+			all = append(all, client.TaggedObject{Type: objectType, Id: d.Id, Name: d.TableName})
+		}
+	}
+
+	byName := make(map[string][]client.TaggedObject)
+	for _, o := range all {
+		byName[o.Name] = append(byName[o.Name], o)
+	}
+
+	var objects []client.TaggedObject
+	var problems []string
+	for _, name := range names {
+		switch matches := byName[name]; len(matches) {
+		case 0:
+			problems = append(problems, fmt.Sprintf("no %s named %q", objectType, name))
+		case 1:
+			objects = append(objects, matches[0])
+		default:
+			ids := make([]string, 0, len(matches))
+			for _, o := range matches {
+				ids = append(ids, fmt.Sprintf("id=%d", o.Id))
+			}
+			problems = append(problems, fmt.Sprintf("%d %ss named %q (%s)", len(matches), objectType, name, strings.Join(ids, ", ")))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, errors.New(strings.Join(problems, "; "))
+	}
+	return objects, nil
+}
@@ -0,0 +1,258 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &TaggedObjectResource{}
+var _ resource.ResourceWithImportState = &TaggedObjectResource{}
+
+func NewTaggedObjectResource() resource.Resource {
+	return &TaggedObjectResource{}
+}
+
+type TaggedObjectResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type taggedObjectResourceModel struct {
+	taggedObjectBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *TaggedObjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tagged_object"
+}
+
+func (r *TaggedObjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assign tags to a dashboard, chart or dataset, creating the tags that do not exist. " +
+			"Only the listed tags are managed, so tags assigned to the object outside Terraform, or by `superset_tag_bulk_assignment`, are kept. " +
+			"The tags Superset assigns itself, to owners, types and favorites, are ignored.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the tagged object, `<object_type>/<object_id>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The type of the object: `dashboard`, `chart` or `dataset`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(taggedObjectTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the object.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the tags to assign to the object.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *TaggedObjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// getObject checks that object exists, returning a NotFoundError otherwise.
+func (r *TaggedObjectResource) getObject(ctx context.Context, object client.TaggedObject) error {
+	var err error
+	switch object.Type {
+	case client.TaggedObjectTypeDashboard:
+		_, err = r.client.GetDashboard(ctx, object.Id)
+	case client.TaggedObjectTypeChart:
+		_, err = r.client.GetChart(ctx, object.Id)
+	default:
+		_, err = r.client.GetDataset(ctx, object.Id)
+	}
+	return err
+}
+
+// untag removes the tags from object. Tags that are no longer assigned are skipped.
+func (r *TaggedObjectResource) untag(ctx context.Context, object client.TaggedObject, tags []string) error {
+	for _, tag := range tags {
+		if err := r.client.UntagObject(ctx, tag, object); err != nil && !client.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *TaggedObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data taggedObjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "tagged_object"))
+	defer cancel()
+
+	object := data.object()
+	if err := r.client.TagObject(ctx, object, data.tags()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to tag %s with ID %d: %s", object.Type, object.Id, err))
+		return
+	}
+
+	data.updateState(data.tags())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TaggedObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data taggedObjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "tagged_object"))
+	defer cancel()
+
+	object := data.object()
+	err := r.getObject(ctx, object)
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s with ID %d: %s", object.Type, object.Id, err))
+		return
+	}
+
+	assigned, err := r.client.ListObjectTags(ctx, object)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list tags of %s with ID %d: %s", object.Type, object.Id, err))
+		return
+	}
+
+	data.updateState(assigned)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TaggedObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state taggedObjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "tagged_object"))
+	defer cancel()
+
+	object := plan.object()
+	if added := nameDifference(plan.tags(), state.tags()); len(added) > 0 {
+		if err := r.client.TagObject(ctx, object, added); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to tag %s with ID %d: %s", object.Type, object.Id, err))
+			return
+		}
+	}
+	if err := r.untag(ctx, object, nameDifference(state.tags(), plan.tags())); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to untag %s with ID %d: %s", object.Type, object.Id, err))
+		return
+	}
+
+	plan.updateState(plan.tags())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TaggedObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state taggedObjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "tagged_object"))
+	defer cancel()
+
+	object := state.object()
+	if err := r.untag(ctx, object, state.tags()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to untag %s with ID %d: %s", object.Type, object.Id, err))
+		return
+	}
+}
+
+func (r *TaggedObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	objectType, rawId, ok := strings.Cut(req.ID, "/")
+	id, err := strconv.ParseInt(rawId, 10, 64)
+	if !ok || err != nil || !slices.Contains(taggedObjectTypes, objectType) {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form <object_type>/<object_id>, with an object type of %v, got %q", taggedObjectTypes, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_type"), objectType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_id"), id)...)
+}
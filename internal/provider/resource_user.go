@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -82,7 +83,7 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			"password": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
-				MarkdownDescription: "The password of the user.",
+				MarkdownDescription: "The password of the user. A user created without a password gets a random one, so that nobody can log in as the user until the `superset_user_password` resource sets its password.",
 			},
 			"role_names": schema.SetAttribute{
 				Required:    true,
@@ -110,6 +111,21 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Default:             booldefault.StaticBool(true),
 				MarkdownDescription: "Whether the user is active.",
 			},
+			"created_on": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the user was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"changed_on": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the user was last changed.",
+			},
+			"changed_by": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The username of the user who last changed the user. Null when the server does not record it or the user no longer exists.",
+			},
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true, Update: true, Delete: true,
 			}),
@@ -145,14 +161,23 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "user"))
 	defer cancel()
+	initialPassword := data.Password.ValueString()
+	if data.Password.IsNull() {
+		generated, err := randomUserPassword()
+		if err != nil {
+			resp.Diagnostics.AddError("Password Generation Error", fmt.Sprintf("Unable to generate a password for the user: %s", err))
+			return
+		}
+		initialPassword = generated
+	}
 	postData := client.SupersetUserApiPost{
 		Username:  data.Username.ValueString(),
 		Email:     data.Email.ValueString(),
 		FirstName: data.FirstName.ValueString(),
 		LastName:  data.LastName.ValueString(),
-		Password:  data.Password.ValueString(),
+		Password:  initialPassword,
 		Active:    data.Active.ValueBool(),
 	}
 
@@ -185,6 +210,20 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		postData.Groups = groupIds
 	}
 
+	if r.client.BulkMode() {
+		// Fast path: the server rejects duplicate usernames itself, and the state is taken
+		// from the plan instead of reading the user back.
+		id, err := r.client.CreateUserWithoutRead(ctx, postData)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user, got error: %s", err))
+			return
+		}
+
+		data.Id = types.Int64Value(int64(id))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
 	existingUser, err := r.client.FindUser(ctx, postData.Username)
 	if !client.IsNotFound(err) && err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to validate user name uniqueness: %s", err))
@@ -208,6 +247,10 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	data.updateState(u, password)
+	changedBy, diags := r.changedBy(ctx, u)
+	resp.Diagnostics.Append(diags...)
+	data.ChangedBy = changedBy
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, userLastModified(u))...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -220,7 +263,7 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "user"))
 	defer cancel()
 
 	u, err := r.client.GetUser(ctx, int(state.Id.ValueInt64()))
@@ -239,6 +282,10 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	state.updateState(u, password)
+	changedBy, diags := r.changedBy(ctx, u)
+	resp.Diagnostics.Append(diags...)
+	state.ChangedBy = changedBy
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, userLastModified(u))...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -252,9 +299,21 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "user"))
 	defer cancel()
 
+	current, err := r.client.GetUser(ctx, int(state.Id.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user with ID %d: %s", state.Id.ValueInt64(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(checkConcurrentModification(ctx, req.Private, r.client, client.ObjectKindUser, current.Id,
+		fmt.Sprintf("User '%s' (ID %d)", current.Username, current.Id), userLastModified(current))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	putData := client.SupersetUserApiPut{
 		Email:     plan.Email.ValueString(),
 		FirstName: plan.FirstName.ValueString(),
@@ -309,15 +368,39 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	state.updateState(u, password)
+	changedBy, diags := r.changedBy(ctx, u)
+	resp.Diagnostics.Append(diags...)
+	state.ChangedBy = changedBy
+	resp.Diagnostics.Append(setLastModified(ctx, resp.Private, userLastModified(u))...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// changedBy returns the username of the user who last changed u, as the server only returns their ID.
+func (r *UserResource) changedBy(ctx context.Context, u *client.SupersetUserApiGet) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch u.ChangedBy.Id {
+	case 0:
+		return types.StringNull(), diags
+	case u.Id:
+		return types.StringValue(u.Username), diags
+	}
+
+	changedBy, err := r.client.UserName(ctx, u.ChangedBy.Id)
+	if client.IsNotFound(err) {
+		return types.StringNull(), diags
+	} else if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read user with ID %d: %s", u.ChangedBy.Id, err))
+		return types.StringNull(), diags
+	}
+	return types.StringValue(changedBy), diags
+}
+
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state userResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, Timeout5min)
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "user"))
 	defer cancel()
 
 	err := r.client.DeleteUser(ctx, int(state.Id.ValueInt64()))
@@ -348,11 +431,13 @@ func (r *UserResource) ImportState(ctx context.Context, req resource.ImportState
 
 	id, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			fmt.Sprintf("Expected numeric ID, got %q: %s", req.ID, err),
-		)
-		return
+		// A user identified by username.
+		user, err := r.client.FindUser(ctx, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user with username %s: %s", req.ID, err))
+			return
+		}
+		id = int64(user.Id)
 	}
 
 	resp.State.SetAttribute(ctx, path.Root("id"), id)
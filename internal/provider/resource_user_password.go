@@ -0,0 +1,227 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+var _ resource.Resource = &UserPasswordResource{}
+var _ resource.ResourceWithImportState = &UserPasswordResource{}
+
+func NewUserPasswordResource() resource.Resource {
+	return &UserPasswordResource{}
+}
+
+type UserPasswordResource struct {
+	client   *client.ClientWrapper
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type userPasswordResourceModel struct {
+	userPasswordBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *UserPasswordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_password"
+}
+
+func (r *UserPasswordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the password of an existing superset user, e.g. in another workspace than the `superset_user` resource, with credentials the identity workspace does not need. " +
+			"The password is write-only and never stored in the state, so it requires Terraform 1.11 or later; change `password_wo_version` to set it again. " +
+			"Leave `password` of the `superset_user` resource unset when using this resource. " +
+			"Destroying this resource does not change the password of the user.",
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The username of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The password of the user. It is only set when the resource is created and when `password_wo_version` changes.",
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The version of the password. Change it, e.g. by incrementing it, to set `password_wo` again.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *UserPasswordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWrapper)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+func (r *UserPasswordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data userPasswordResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Write-only values are only available in the configuration.
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &password)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "user_password"))
+	defer cancel()
+
+	user, err := r.client.FindUser(ctx, data.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user with username %s: %s", data.Username.ValueString(), err))
+		return
+	}
+
+	if err := r.client.SetUserPassword(ctx, user.Id, password.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set the password of user with ID %d: %s", user.Id, err))
+		return
+	}
+	tflog.Info(ctx, "Set user password", map[string]interface{}{
+		"user_id": user.Id,
+	})
+
+	u, err := r.client.GetUser(ctx, user.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user with ID %d: %s", user.Id, err))
+		return
+	}
+
+	data.updateState(u)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPasswordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data userPasswordResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "user_password"))
+	defer cancel()
+
+	if data.UserId.IsNull() {
+		// An imported resource, identified by the username.
+		user, err := r.client.FindUser(ctx, data.Username.ValueString())
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		} else if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user with username %s: %s", data.Username.ValueString(), err))
+			return
+		}
+		data.UserId = types.Int64Value(int64(user.Id))
+	}
+
+	u, err := r.client.GetUser(ctx, int(data.UserId.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user with ID %d: %s", data.UserId.ValueInt64(), err))
+		return
+	}
+
+	// The password cannot be read, so only the removal or the renaming of the user is detected.
+	data.updateState(u)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPasswordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state userPasswordResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &password)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := SetupTimeoutCreate(ctx, r.Timeouts, defaultTimeout(r.client, "user_password"))
+	defer cancel()
+
+	userId := int(state.UserId.ValueInt64())
+	if !plan.PasswordWoVersion.Equal(state.PasswordWoVersion) {
+		if err := r.client.SetUserPassword(ctx, userId, password.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set the password of user with ID %d: %s", userId, err))
+			return
+		}
+		tflog.Info(ctx, "Set user password", map[string]interface{}{
+			"user_id": userId,
+		})
+	}
+
+	u, err := r.client.GetUser(ctx, userId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user with ID %d: %s", userId, err))
+		return
+	}
+
+	plan.updateState(u)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserPasswordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The password is left as it is, the resource is only removed from the state.
+}
+
+func (r *UserPasswordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Starting ImportState method", map[string]interface{}{
+		"import_id": req.ID,
+	})
+
+	resp.State.SetAttribute(ctx, path.Root("username"), req.ID)
+}
@@ -0,0 +1,87 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUserPasswordSetsOnlyThePassword(t *testing.T) {
+	var puts []map[string]interface{}
+	deleted := false
+	server := testSupersetServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v1/security/users/":
+			writeTestJSON(t, w, map[string]interface{}{"count": 1, "result": []map[string]interface{}{
+				{"id": 5, "username": "alice", "email": "alice@example.com", "first_name": "Alice", "last_name": "Smith"},
+			}})
+		case "PUT /api/v1/security/users/5":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+			puts = append(puts, body)
+			writeTestJSON(t, w, map[string]interface{}{"id": 5, "result": map[string]interface{}{}})
+		case "GET /api/v1/security/users/5":
+			if deleted {
+				http.NotFound(w, r)
+				return
+			}
+			writeTestJSON(t, w, map[string]interface{}{"id": 5, "result": map[string]interface{}{
+				"id": 5, "username": "alice", "email": "alice@example.com", "first_name": "Alice", "last_name": "Smith",
+			}})
+		default:
+			t.Logf("unexpected request %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	})
+
+	provider, schemas := testProviderServer(t, server.URL)
+	config := func(password string, version int) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"username":            tftypes.NewValue(tftypes.String, "alice"),
+			"password_wo":         tftypes.NewValue(tftypes.String, password),
+			"password_wo_version": tftypes.NewValue(tftypes.Number, version),
+		}
+	}
+
+	res := testCreateResource(t, provider, schemas, "superset_user_password", config("first", 1))
+	checkTestDiagnostics(t, res.Diagnostics)
+	// A changed password alone is not set again.
+	res = testUpdateResource(t, provider, schemas, "superset_user_password", res, config("changed", 1))
+	checkTestDiagnostics(t, res.Diagnostics)
+	res = testUpdateResource(t, provider, schemas, "superset_user_password", res, config("second", 2))
+	checkTestDiagnostics(t, res.Diagnostics)
+
+	want := []map[string]interface{}{{"password": "first"}, {"password": "second"}}
+	if !reflect.DeepEqual(puts, want) {
+		t.Errorf("unexpected requests: %v, want %v", puts, want)
+	}
+
+	// The resource is removed when the user has been deleted.
+	deleted = true
+	read, err := provider.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:     "superset_user_password",
+		CurrentState: res.NewState,
+		Private:      res.Private,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkTestDiagnostics(t, read.Diagnostics)
+	state, err := read.NewState.Unmarshal(schemas.ResourceSchemas["superset_user_password"].ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !state.IsNull() {
+		t.Errorf("expected the resource to be removed, got %v", state)
+	}
+}
@@ -3,4 +3,96 @@
 
 package provider
 
-// TODO: Add tests for user resource
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUserAuditAttributes(t *testing.T) {
+	changedBy := 1
+	server := testSupersetServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v1/security/roles/":
+			writeTestJSON(t, w, map[string]interface{}{"count": 1, "result": []map[string]interface{}{{"id": 1, "name": "Gamma"}}})
+		case "GET /api/v1/security/users/":
+			// The user to create does not exist yet, and only admin changes users.
+			if strings.Contains(r.URL.Query().Get("q"), "alice") {
+				writeTestJSON(t, w, map[string]interface{}{"count": 0, "result": []interface{}{}})
+				return
+			}
+			writeTestJSON(t, w, map[string]interface{}{"count": 1, "result": []map[string]interface{}{
+				{"id": 1, "username": "admin", "email": "admin@example.com", "first_name": "Admin", "last_name": "User"},
+			}})
+		case "POST /api/v1/security/users/":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			writeTestJSON(t, w, map[string]interface{}{"id": 5})
+		case "GET /api/v1/security/users/5":
+			writeTestJSON(t, w, map[string]interface{}{"id": 5, "result": map[string]interface{}{
+				"id": 5, "username": "alice", "email": "alice@example.com", "first_name": "Alice", "last_name": "Smith",
+				"active": true, "roles": []map[string]interface{}{{"id": 1, "name": "Gamma"}},
+				"created_on": "2026-01-01T00:00:00", "changed_on": "2026-01-02T00:00:00",
+				"changed_by": map[string]interface{}{"id": changedBy},
+			}})
+		default:
+			// The usernames are resolved from the user list, not one user at a time.
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	})
+
+	provider, schemas := testProviderServer(t, server.URL)
+	res := testCreateResource(t, provider, schemas, "superset_user", map[string]tftypes.Value{
+		"username":   tftypes.NewValue(tftypes.String, "alice"),
+		"email":      tftypes.NewValue(tftypes.String, "alice@example.com"),
+		"first_name": tftypes.NewValue(tftypes.String, "Alice"),
+		"last_name":  tftypes.NewValue(tftypes.String, "Smith"),
+		"role_names": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "Gamma"),
+		}),
+	})
+	checkTestDiagnostics(t, res.Diagnostics)
+	checkUserStateAttributes(t, schemas, res.NewState, map[string]tftypes.Value{
+		"created_on": tftypes.NewValue(tftypes.String, "2026-01-01T00:00:00"),
+		"changed_on": tftypes.NewValue(tftypes.String, "2026-01-02T00:00:00"),
+		"changed_by": tftypes.NewValue(tftypes.String, "admin"),
+	})
+
+	// The user was last changed by an account that has been deleted since.
+	changedBy = 9
+	read, err := provider.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:     "superset_user",
+		CurrentState: res.NewState,
+		Private:      res.Private,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkTestDiagnostics(t, read.Diagnostics)
+	checkUserStateAttributes(t, schemas, read.NewState, map[string]tftypes.Value{
+		"changed_by": tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func checkUserStateAttributes(t *testing.T, schemas *tfprotov6.GetProviderSchemaResponse, state *tfprotov6.DynamicValue, want map[string]tftypes.Value) {
+	t.Helper()
+
+	value, err := state.Unmarshal(schemas.ResourceSchemas["superset_user"].ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, wantValue := range want {
+		got, _, err := tftypes.WalkAttributePath(value, tftypes.NewAttributePath().WithAttributeName(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gotValue, ok := got.(tftypes.Value); !ok || !gotValue.Equal(wantValue) {
+			t.Errorf("%s = %v, want %v", name, got, wantValue)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// serverDefaultFunc reports whether value is a default that Superset derives by itself for an element
+// with the given name.
+type serverDefaultFunc func(name string, value string) bool
+
+// serverDefaultModifier treats a server derived default as equal to a null config value.
+//
+// When the attribute is not configured and the prior state holds a value the server derived, the prior
+// state is planned so that the derived value does not show up as a change. Any other prior value was set
+// explicitly and is left unknown, so that it is cleared on apply and the server derives the default again.
+type serverDefaultModifier struct {
+	nameAttribute string
+	isDefault     serverDefaultFunc
+	description   string
+}
+
+// useServerDefault returns a plan modifier for Optional and Computed attributes of dataset columns and
+// metrics, whose sibling attribute nameAttribute holds the name the default is derived from.
+func useServerDefault(nameAttribute string, isDefault serverDefaultFunc, description string) planmodifier.String {
+	return serverDefaultModifier{
+		nameAttribute: nameAttribute,
+		isDefault:     isDefault,
+		description:   description,
+	}
+}
+
+func (m serverDefaultModifier) Description(ctx context.Context) string {
+	return m.description
+}
+
+func (m serverDefaultModifier) MarkdownDescription(ctx context.Context) string {
+	return m.description
+}
+
+func (m serverDefaultModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, req.Path.ParentPath().AtName(m.nameAttribute), &name)...)
+	if resp.Diagnostics.HasError() || name.IsUnknown() {
+		return
+	}
+
+	if m.isDefault(name.ValueString(), req.StateValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// isDefaultVerboseName reports whether verboseName is the label Superset derives from the column or metric
+// name, either the name itself or the name with underscores replaced by spaces and words capitalized.
+func isDefaultVerboseName(name string, verboseName string) bool {
+	return verboseName == name || verboseName == humanizeName(name)
+}
+
+// isDefaultD3format reports whether d3format is the number format Superset applies when none is set.
+func isDefaultD3format(_ string, d3format string) bool {
+	return d3format == "" || d3format == "SMART_NUMBER"
+}
+
+func humanizeName(name string) string {
+	words := strings.Fields(strings.ReplaceAll(name, "_", " "))
+	for i, w := range words {
+		r := []rune(w)
+		words[i] = strings.ToUpper(string(r[0])) + string(r[1:])
+	}
+
+	return strings.Join(words, " ")
+}
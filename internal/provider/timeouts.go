@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/htamakos/terraform-provider-superset/internal/client"
 )
 
 const (
@@ -20,6 +21,17 @@ const (
 	Timeout24hour = 24 * time.Hour
 )
 
+// defaultTimeout returns the default timeout of operations on resources of resourceType, which is the one
+// of timeouts_defaults in the provider configuration, or 5 minutes.
+func defaultTimeout(c *client.ClientWrapper, resourceType string) time.Duration {
+	if c != nil {
+		if d, ok := c.TimeoutDefault(resourceType); ok {
+			return d
+		}
+	}
+	return Timeout5min
+}
+
 func SetupTimeoutCreate(ctx context.Context, tov timeouts.Value, defaultTimeout time.Duration) (context.Context, context.CancelFunc) {
 	createTimeout, diags := tov.Create(ctx, defaultTimeout)
 
@@ -0,0 +1,150 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package supersetclient is the supported Go API of the Superset client used by the provider, for tools
+// that manage Superset next to Terraform.
+//
+// The package follows semantic versioning with the provider: within a major version, the functions,
+// types and methods of this package are not removed or changed in incompatible ways. The models, such as
+// User or Dataset, are aliases of the types generated from the Superset OpenAPI specification the provider
+// is built against, so their fields follow that specification: they gain fields when it does, and only
+// change incompatibly with a major version. Everything under internal/ may change in any release.
+package supersetclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/htamakos/terraform-provider-superset/internal/client"
+)
+
+// Client is an authenticated client of the Superset REST API. It is safe for concurrent use.
+type Client struct {
+	cw *client.ClientWrapper
+}
+
+// Credentials holds the username and password, or the access token, for authentication.
+type Credentials struct {
+	Username string
+	Password string
+	// AccessToken is a bearer token issued outside Superset. When set, the client does not log in.
+	AccessToken string
+}
+
+// IngressAuth holds the credentials of an ingress in front of Superset that requires its own
+// authentication: a bearer token, basic authentication credentials or cookies.
+type IngressAuth = client.IngressAuth
+
+// Option configures a Client.
+type Option func(*client.ClientOptions)
+
+// Tenant routing modes of WithTenant.
+const (
+	TenantRoutingHeader = client.TenantRoutingHeader
+	TenantRoutingPath   = client.TenantRoutingPath
+)
+
+// New logs in to the Superset server at serverBaseUrl and returns a client.
+func New(ctx context.Context, serverBaseUrl string, credentials Credentials, opts ...Option) (*Client, error) {
+	cw, err := client.NewClientWrapper(ctx, serverBaseUrl, client.ClientCredentials{
+		Username:    credentials.Username,
+		Password:    credentials.Password,
+		AccessToken: credentials.AccessToken,
+	}, func(o *client.ClientOptions) {
+		for _, opt := range opts {
+			opt(o)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Client{cw: cw}, nil
+}
+
+// WithPageSize sets the number of objects requested per page of list calls.
+func WithPageSize(pageSize int) Option {
+	return Option(client.WithPageSize(pageSize))
+}
+
+// WithPageConcurrency sets the number of pages of a list fetched at the same time.
+func WithPageConcurrency(pageConcurrency int) Option {
+	return Option(client.WithPageConcurrency(pageConcurrency))
+}
+
+// WithBasePath sets the path prefix under which Superset is hosted, e.g. "/analytics".
+func WithBasePath(basePath string) Option {
+	return Option(client.WithBasePath(basePath))
+}
+
+// WithTenant selects the tenant, or workspace, of a multi-tenant distribution. routing is
+// TenantRoutingHeader or TenantRoutingPath, and header the name of the header carrying the tenant with
+// header routing.
+func WithTenant(tenant string, routing string, header string) Option {
+	return Option(client.WithTenant(tenant, routing, header))
+}
+
+// WithAuthProvider sets the authentication provider used to log in, e.g. "db" or "ldap".
+func WithAuthProvider(authProvider string) Option {
+	return Option(client.WithAuthProvider(authProvider))
+}
+
+// WithTLS trusts the PEM encoded CA certificates of caCertPEM in addition to the system ones, or skips
+// the verification of the server certificate altogether.
+func WithTLS(caCertPEM string, insecureSkipVerify bool) Option {
+	return Option(client.WithTLS(caCertPEM, insecureSkipVerify))
+}
+
+// WithProxy sets the proxies of HTTP and HTTPS requests, and the hosts reached without them. Empty
+// values fall back to the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+func WithProxy(httpProxy string, httpsProxy string, noProxy string) Option {
+	return Option(client.WithProxy(httpProxy, httpsProxy, noProxy))
+}
+
+// WithCustomHeaders adds headers to every request, e.g. for an authenticating proxy.
+func WithCustomHeaders(headers map[string]string) Option {
+	return Option(client.WithCustomHeaders(headers))
+}
+
+// WithIngressAuth sends the credentials of auth with every request, for an ingress in front of Superset
+// requiring its own authentication.
+func WithIngressAuth(auth IngressAuth) Option {
+	return Option(client.WithIngressAuth(auth))
+}
+
+// WithFailOnConflict makes ChangeRolePermissions fail instead of applying the changes to the current
+// permissions when they were modified since they were read.
+func WithFailOnConflict(failOnConflict bool) Option {
+	return Option(client.WithFailOnConflict(failOnConflict))
+}
+
+// WithMaxConcurrentRequests caps the number of simultaneous requests to the server. Zero disables the
+// limit.
+func WithMaxConcurrentRequests(maxConcurrentRequests int) Option {
+	return Option(client.WithMaxConcurrentRequests(maxConcurrentRequests))
+}
+
+// WithRateLimit caps the requests sent per second, allowing bursts of burst requests.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return Option(client.WithRateLimit(requestsPerSecond, burst))
+}
+
+// WithRetryPolicy retries failed requests up to maxRetries times, waiting between minDelay and maxDelay.
+func WithRetryPolicy(maxRetries int, minDelay time.Duration, maxDelay time.Duration) Option {
+	return Option(client.WithRetryPolicy(maxRetries, minDelay, maxDelay))
+}
+
+// WithLookupCacheTTL sets the time the role, group, permission and user lists are cached. Zero disables the
+// cache.
+func WithLookupCacheTTL(ttl time.Duration) Option {
+	return Option(client.WithLookupCacheTTL(ttl))
+}
+
+// WithMaxResponseSize caps the size in bytes of a single response body.
+func WithMaxResponseSize(maxResponseSize int64) Option {
+	return Option(client.WithMaxResponseSize(maxResponseSize))
+}
+
+// FeatureFlags returns the boolean feature flags of the server.
+func (c *Client) FeatureFlags(ctx context.Context) (map[string]bool, error) {
+	return c.cw.GetFeatureFlags(ctx)
+}
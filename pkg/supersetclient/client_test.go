@@ -0,0 +1,45 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package supersetclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientWithAccessToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("unexpected authorization %q", got)
+		}
+		if cookie, err := r.Cookie("_oauth2_proxy"); err != nil || cookie.Value != "session" {
+			t.Errorf("expected the ingress cookie, got %v, %v", cookie, err)
+		}
+		if r.Method+" "+r.URL.Path != "GET /api/v1/security/roles/" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"count": 1, "result": [{"id": 1, "name": "Admin"}]}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c, err := New(ctx, server.URL, Credentials{AccessToken: "token"},
+		WithIngressAuth(IngressAuth{Cookies: map[string]string{"_oauth2_proxy": "session"}}))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	roles, err := c.ListRoles(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(roles) != 1 || roles[0].Name != "Admin" {
+		t.Errorf("unexpected roles: %v", roles)
+	}
+}
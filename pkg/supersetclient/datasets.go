@@ -0,0 +1,36 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package supersetclient
+
+import "context"
+
+// ListDatabases returns all databases.
+func (c *Client) ListDatabases(ctx context.Context) ([]Database, error) {
+	return c.cw.ListDatabases(ctx)
+}
+
+// GetDatabase returns the database with the ID databaseID.
+func (c *Client) GetDatabase(ctx context.Context, databaseID int) (*Database, error) {
+	return c.cw.GetDatabase(ctx, databaseID)
+}
+
+// FindDatabase returns the database named databaseName.
+func (c *Client) FindDatabase(ctx context.Context, databaseName string) (*Database, error) {
+	return c.cw.FindDatabase(ctx, databaseName)
+}
+
+// ListDatasets returns all datasets.
+func (c *Client) ListDatasets(ctx context.Context) ([]DatasetList, error) {
+	return c.cw.ListDatasets(ctx)
+}
+
+// GetDataset returns the dataset with the ID datasetID.
+func (c *Client) GetDataset(ctx context.Context, datasetID int) (*Dataset, error) {
+	return c.cw.GetDataset(ctx, datasetID)
+}
+
+// FindDataset returns the dataset whose table is named datasetName.
+func (c *Client) FindDataset(ctx context.Context, datasetName string) (*DatasetList, error) {
+	return c.cw.FindDataset(ctx, datasetName)
+}
@@ -0,0 +1,52 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package supersetclient
+
+import "github.com/htamakos/terraform-provider-superset/internal/client"
+
+// Models of users, roles and groups.
+type (
+	User       = client.SupersetUserApiGet
+	UserList   = client.SupersetUserApiGetList
+	UserCreate = client.SupersetUserApiPost
+	UserUpdate = client.SupersetUserApiPut
+
+	Role       = client.SupersetRoleApiGet
+	RoleList   = client.SupersetRoleApiGetList
+	RoleCreate = client.SupersetRoleApiPost
+	RoleUpdate = client.SupersetRoleApiPut
+
+	Group       = client.SupersetGroupApiGet
+	GroupList   = client.SupersetGroupApiGetList
+	GroupCreate = client.SupersetGroupApiPost
+	GroupUpdate = client.SupersetGroupApiPut
+)
+
+// Models of permissions: a Permission is a permission on a view menu, such as can_read on Dashboard, and
+// a RolePermission a permission granted to a role.
+type (
+	Permission     = client.SupersetPermissionApiGetList
+	RolePermission = client.SupersetRolePermissionApiGetList
+)
+
+// Models of databases and datasets.
+type (
+	Database    = client.DatabaseRestApiGetList
+	DatasetList = client.DatasetRestApiGetList
+	Dataset     = client.DatasetRestApiGet
+)
+
+// NotFoundError is returned when an object does not exist, or no object has the name looked up.
+type NotFoundError = client.NotFoundError
+
+// AmbiguousNameError is returned when a name looked up matches more than one object.
+type AmbiguousNameError = client.AmbiguousNameError
+
+// ResponseTooLargeError is returned when a response body exceeds the maximum size of WithMaxResponseSize.
+type ResponseTooLargeError = client.ResponseTooLargeError
+
+// IsNotFound checks if the error is a NotFoundError.
+func IsNotFound(err error) bool {
+	return client.IsNotFound(err)
+}
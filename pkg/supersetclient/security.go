@@ -0,0 +1,140 @@
+// Copyright Hironori Tamakoshi <tmkshrnr@gmail.com> 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package supersetclient
+
+import "context"
+
+// ListUsers returns all users.
+func (c *Client) ListUsers(ctx context.Context) ([]UserList, error) {
+	return c.cw.ListUsers(ctx)
+}
+
+// GetUser returns the user with the ID userID.
+func (c *Client) GetUser(ctx context.Context, userID int) (*User, error) {
+	return c.cw.GetUser(ctx, userID)
+}
+
+// FindUser returns the user with the username userName.
+func (c *Client) FindUser(ctx context.Context, userName string) (*UserList, error) {
+	return c.cw.FindUser(ctx, userName)
+}
+
+// FindUserByEmail returns the user with the email address email.
+func (c *Client) FindUserByEmail(ctx context.Context, email string) (*UserList, error) {
+	return c.cw.FindUserByEmail(ctx, email)
+}
+
+// CreateUser creates a user and returns it.
+func (c *Client) CreateUser(ctx context.Context, user UserCreate) (*User, error) {
+	return c.cw.CreateUser(ctx, user)
+}
+
+// UpdateUser updates the user with the ID userID and returns it.
+func (c *Client) UpdateUser(ctx context.Context, userID int, user UserUpdate) (*User, error) {
+	return c.cw.UpdateUser(ctx, userID, user)
+}
+
+// DeleteUser deletes the user with the ID userID.
+func (c *Client) DeleteUser(ctx context.Context, userID int) error {
+	return c.cw.DeleteUser(ctx, userID)
+}
+
+// ListRoles returns all roles.
+func (c *Client) ListRoles(ctx context.Context) ([]RoleList, error) {
+	return c.cw.ListRoles(ctx)
+}
+
+// GetRole returns the role with the ID roleID.
+func (c *Client) GetRole(ctx context.Context, roleID int) (*Role, error) {
+	return c.cw.GetRole(ctx, roleID)
+}
+
+// FindRole returns the role named roleName.
+func (c *Client) FindRole(ctx context.Context, roleName string) (*RoleList, error) {
+	return c.cw.FindRole(ctx, roleName)
+}
+
+// CreateRole creates a role and returns it.
+func (c *Client) CreateRole(ctx context.Context, role RoleCreate) (*Role, error) {
+	return c.cw.CreateRole(ctx, role)
+}
+
+// UpdateRole updates the role with the ID roleID and returns it.
+func (c *Client) UpdateRole(ctx context.Context, roleID int, role RoleUpdate) (*Role, error) {
+	return c.cw.UpdateRole(ctx, roleID, role)
+}
+
+// DeleteRole deletes the role with the ID roleID.
+func (c *Client) DeleteRole(ctx context.Context, roleID int) error {
+	return c.cw.DeleteRole(ctx, roleID)
+}
+
+// ListGroups returns all groups.
+func (c *Client) ListGroups(ctx context.Context) ([]GroupList, error) {
+	return c.cw.ListGroups(ctx)
+}
+
+// GetGroup returns the group with the ID groupID.
+func (c *Client) GetGroup(ctx context.Context, groupID int) (*Group, error) {
+	return c.cw.GetGroup(ctx, groupID)
+}
+
+// FindGroup returns the group named groupName.
+func (c *Client) FindGroup(ctx context.Context, groupName string) (*GroupList, error) {
+	return c.cw.FindGroup(ctx, groupName)
+}
+
+// CreateGroup creates a group and returns it.
+func (c *Client) CreateGroup(ctx context.Context, group GroupCreate) (*Group, error) {
+	return c.cw.CreateGroup(ctx, group)
+}
+
+// UpdateGroup updates the group with the ID groupID and returns it.
+func (c *Client) UpdateGroup(ctx context.Context, groupID int, group GroupUpdate) (*Group, error) {
+	return c.cw.UpdateGroup(ctx, groupID, group)
+}
+
+// DeleteGroup deletes the group with the ID groupID.
+func (c *Client) DeleteGroup(ctx context.Context, groupID int) error {
+	return c.cw.DeleteGroup(ctx, groupID)
+}
+
+// ListPermissions returns all permissions on view menus.
+func (c *Client) ListPermissions(ctx context.Context) ([]Permission, error) {
+	return c.cw.ListPermissions(ctx)
+}
+
+// ListRolePermissions returns the permissions granted to the role with the ID roleID.
+func (c *Client) ListRolePermissions(ctx context.Context, roleID int) ([]RolePermission, error) {
+	return c.cw.ListRolePermissions(ctx, roleID)
+}
+
+// SetRolePermissions replaces the permissions of the role with the ID roleID with the permissions of
+// permissionIDs.
+func (c *Client) SetRolePermissions(ctx context.Context, roleID int, permissionIDs []int) error {
+	return c.cw.AssignPermissionsToRole(ctx, roleID, permissionIDs)
+}
+
+// ChangeRolePermissions grants the permissions of grant to the role with the ID roleID and revokes the
+// ones of revoke, given current, the IDs of the permissions of the role when they were read. When the
+// permissions of the role changed since, the changes are applied to the permissions read again, or an
+// error is returned with WithFailOnConflict.
+func (c *Client) ChangeRolePermissions(ctx context.Context, roleID int, current []int, grant []int, revoke []int) error {
+	return c.cw.ChangeRolePermissions(ctx, roleID, current, grant, revoke)
+}
+
+// SetGroupRoles replaces the roles of the group with the ID groupID with the roles of roleIDs.
+func (c *Client) SetGroupRoles(ctx context.Context, groupID int, roleIDs []int) error {
+	return c.cw.AssignRolesToGroup(ctx, groupID, roleIDs)
+}
+
+// SetGroupUsers replaces the users of the group with the ID groupID with the users of userIDs.
+func (c *Client) SetGroupUsers(ctx context.Context, groupID int, userIDs []int) error {
+	return c.cw.AssignUsersToGroup(ctx, groupID, userIDs)
+}
+
+// SetRoleUsers replaces the users of the role with the ID roleID with the users of userIDs.
+func (c *Client) SetRoleUsers(ctx context.Context, roleID int, userIDs []int) error {
+	return c.cw.AssignUsersToRole(ctx, roleID, userIDs)
+}